@@ -0,0 +1,264 @@
+// Package client is the REST client shared by the Binance spot and USD-M futures connectors. It
+// signs requests, keeps within the request weight limit and backs off when Binance reports that
+// the limit was exceeded.
+package client
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	SpotBaseURL           = "https://api.binance.com"
+	SpotTestnetBaseURL    = "https://testnet.binance.vision"
+	FuturesBaseURL        = "https://fapi.binance.com"
+	FuturesTestnetBaseURL = "https://testnet.binancefuture.com"
+
+	// SpotWeightLimit and FuturesWeightLimit are the default request weights allowed per minute.
+	SpotWeightLimit    = 6000
+	FuturesWeightLimit = 2400
+
+	apiKeyHeader      = "X-MBX-APIKEY"
+	usedWeightHeader  = "X-MBX-USED-WEIGHT-1M"
+	defaultRecvWindow = 5000
+)
+
+// ErrRateLimited is returned without sending the request while Binance's Retry-After period is
+// running after a 429 or 418 response.
+var ErrRateLimited = errors.New("rate limited by Binance")
+
+// Security is the authentication an endpoint requires.
+type Security int
+
+const (
+	// None is for public market data endpoints.
+	None Security = iota
+	// APIKey sends the API key without a signature, e.g. for listen key endpoints.
+	APIKey
+	// Signed sends the API key and signs the request with the secret.
+	Signed
+)
+
+// Params are the parameters of a request. Values are formatted with %v.
+type Params map[string]any
+
+// Request is a REST request. Parameters are always sent in the query string, which Binance
+// accepts for every method.
+type Request struct {
+	Method   string
+	Path     string
+	Params   Params
+	Security Security
+	// Weight is the request weight of the endpoint; 1 when zero.
+	Weight int
+}
+
+// APIError is an error response of the Binance API.
+type APIError struct {
+	Status int    `json:"-"`
+	Code   int    `json:"code"`
+	Msg    string `json:"msg"`
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API returned error: %s (code %d)", e.Msg, e.Code)
+}
+
+// Config configures a Client.
+type Config struct {
+	APIKey    string
+	APISecret string
+	BaseURL   string
+	// TimePath is the server time endpoint used by SyncTime, e.g. /api/v3/time.
+	TimePath string
+	// WeightLimit is the request weight allowed per minute.
+	WeightLimit int
+}
+
+// Client sends requests to one Binance REST API. It is safe for concurrent use.
+type Client struct {
+	key        string
+	secret     string
+	baseURL    string
+	timePath   string
+	httpClient *http.Client
+	limiter    *rate.Limiter
+
+	// RecvWindow is the number of milliseconds a signed request stays valid after its timestamp.
+	RecvWindow int64
+
+	timeOffset atomic.Int64 // server minus local clock in nanoseconds, see SyncTime
+	usedWeight atomic.Int64
+
+	mu          sync.Mutex
+	bannedUntil time.Time
+}
+
+// New creates a client for cfg.
+func New(cfg Config) *Client {
+	weight := cfg.WeightLimit
+	if weight <= 0 {
+		weight = SpotWeightLimit
+	}
+	burst := weight / 10
+	if burst < 1 {
+		burst = 1
+	}
+	return &Client{
+		key:        cfg.APIKey,
+		secret:     cfg.APISecret,
+		baseURL:    cfg.BaseURL,
+		timePath:   cfg.TimePath,
+		httpClient: &http.Client{},
+		// Spread the per-minute weight evenly, allowing bursts of a tenth of it.
+		limiter:    rate.NewLimiter(rate.Limit(float64(weight)/60), burst),
+		RecvWindow: defaultRecvWindow,
+	}
+}
+
+// UsedWeight returns the request weight used in the current minute, as last reported by Binance.
+func (c *Client) UsedWeight() int {
+	return int(c.usedWeight.Load())
+}
+
+// Do sends req and decodes the JSON response into out, which may be nil.
+func (c *Client) Do(req *Request, out any) error {
+	c.mu.Lock()
+	bannedUntil := c.bannedUntil
+	c.mu.Unlock()
+	if time.Now().Before(bannedUntil) {
+		return fmt.Errorf("%w until %s", ErrRateLimited, bannedUntil.Format(time.RFC3339))
+	}
+
+	weight := req.Weight
+	if weight <= 0 {
+		weight = 1
+	}
+	if weight > c.limiter.Burst() {
+		weight = c.limiter.Burst()
+	}
+	if err := c.limiter.WaitN(context.Background(), weight); err != nil {
+		return fmt.Errorf("rate limiter error: %w", err)
+	}
+
+	httpReq, err := c.newRequest(req)
+	if err != nil {
+		return err
+	}
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("error reading response: %w", err)
+	}
+	if used, err := strconv.ParseInt(resp.Header.Get(usedWeightHeader), 10, 64); err == nil {
+		c.usedWeight.Store(used)
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusTeapot {
+		c.backOff(resp.Header.Get("Retry-After"))
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		apiErr := &APIError{Status: resp.StatusCode}
+		if err := json.Unmarshal(body, apiErr); err != nil || apiErr.Code == 0 {
+			return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, body)
+		}
+		return apiErr
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("error parsing response: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) newRequest(req *Request) (*http.Request, error) {
+	query := url.Values{}
+	for k, v := range req.Params {
+		query.Set(k, fmt.Sprintf("%v", v))
+	}
+	if req.Security == Signed {
+		query.Set("timestamp", strconv.FormatInt(c.timestamp(), 10))
+		query.Set("recvWindow", strconv.FormatInt(c.RecvWindow, 10))
+	}
+	queryString := query.Encode()
+	if req.Security == Signed {
+		queryString += "&signature=" + c.sign(queryString)
+	}
+
+	target := c.baseURL + req.Path
+	if queryString != "" {
+		target += "?" + queryString
+	}
+	httpReq, err := http.NewRequest(req.Method, target, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	if req.Security != None {
+		httpReq.Header.Set(apiKeyHeader, c.key)
+	}
+	return httpReq, nil
+}
+
+// sign returns the hex HMAC-SHA256 of the query string.
+func (c *Client) sign(query string) string {
+	mac := hmac.New(sha256.New, []byte(c.secret))
+	mac.Write([]byte(query))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// backOff stops requests for the Retry-After seconds, or a minute when the header is missing.
+// Binance bans IPs that keep sending requests after a 429.
+func (c *Client) backOff(retryAfter string) {
+	wait := time.Minute
+	if seconds, err := strconv.Atoi(retryAfter); err == nil {
+		wait = time.Duration(seconds) * time.Second
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if until := time.Now().Add(wait); until.After(c.bannedUntil) {
+		c.bannedUntil = until
+	}
+}
+
+// SyncTime measures the offset between the local clock and the server clock and applies it to
+// the timestamp of every signed request. It returns the measured offset; a positive value means
+// the server is ahead.
+func (c *Client) SyncTime() (time.Duration, error) {
+	var res struct {
+		ServerTime int64 `json:"serverTime"`
+	}
+	sent := time.Now()
+	if err := c.Do(&Request{Method: http.MethodGet, Path: c.timePath}, &res); err != nil {
+		return 0, fmt.Errorf("error fetching server time: %w", err)
+	}
+	received := time.Now()
+	midpoint := sent.Add(received.Sub(sent) / 2)
+	offset := time.UnixMilli(res.ServerTime).Sub(midpoint)
+	c.timeOffset.Store(int64(offset))
+	return offset, nil
+}
+
+// timestamp returns the request timestamp in milliseconds, corrected by the synced offset.
+func (c *Client) timestamp() int64 {
+	return time.Now().Add(time.Duration(c.timeOffset.Load())).UnixMilli()
+}
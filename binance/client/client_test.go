@@ -0,0 +1,73 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSign checks the signature against the example in the Binance API documentation.
+func TestSign(t *testing.T) {
+	c := New(Config{APISecret: "NhqPtmdSJYdKjVHjA7PZj4Mge3R5YNiP1e3UZjInClVN65XAbvqqM6A7H5fATj0j"})
+	query := "symbol=LTCBTC&side=BUY&type=LIMIT&timeInForce=GTC&quantity=1&price=0.1&recvWindow=5000&timestamp=1499827319559"
+	assert.Equal(t, "c8db56825ae71d6d79447849e617115f4a920fa2acdcab2b053c4b2838bd6b71", c.sign(query))
+}
+
+func TestDoSigned(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "key", r.Header.Get(apiKeyHeader))
+		query := r.URL.Query()
+		assert.Equal(t, "BTCUSDT", query.Get("symbol"))
+		assert.NotEmpty(t, query.Get("timestamp"))
+		assert.Equal(t, "5000", query.Get("recvWindow"))
+		raw := r.URL.RawQuery
+		i := len(raw) - len("&signature=") - 64
+		assert.Equal(t, New(Config{APISecret: "secret"}).sign(raw[:i]), query.Get("signature"))
+		w.Header().Set(usedWeightHeader, "12")
+		_, _ = w.Write([]byte(`{"orderId":42}`))
+	}))
+	defer server.Close()
+
+	c := New(Config{APIKey: "key", APISecret: "secret", BaseURL: server.URL})
+	var res struct {
+		OrderID int64 `json:"orderId"`
+	}
+	err := c.Do(&Request{Method: http.MethodPost, Path: "/api/v3/order", Params: Params{"symbol": "BTCUSDT"}, Security: Signed}, &res)
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), res.OrderID)
+	assert.Equal(t, 12, c.UsedWeight())
+}
+
+func TestDoErrors(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.URL.Path == "/limited" {
+			w.Header().Set("Retry-After", "60")
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"code":-1003,"msg":"Too many requests"}`))
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"code":-2013,"msg":"Order does not exist."}`))
+	}))
+	defer server.Close()
+	c := New(Config{BaseURL: server.URL})
+
+	err := c.Do(&Request{Method: http.MethodGet, Path: "/order"}, nil)
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, -2013, apiErr.Code)
+
+	err = c.Do(&Request{Method: http.MethodGet, Path: "/limited"}, nil)
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusTooManyRequests, apiErr.Status)
+
+	err = c.Do(&Request{Method: http.MethodGet, Path: "/order"}, nil)
+	assert.True(t, errors.Is(err, ErrRateLimited))
+	assert.Equal(t, 2, calls, "requests must not be sent while backing off")
+}
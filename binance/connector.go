@@ -0,0 +1,703 @@
+package binance
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cploutarchou/crypto-sdk-suite/binance/client"
+	"github.com/cploutarchou/crypto-sdk-suite/decimal"
+	"github.com/cploutarchou/crypto-sdk-suite/exchange"
+)
+
+// MarketType selects the Binance market a Connector trades.
+type MarketType string
+
+const (
+	Spot MarketType = "spot"
+	// USDM is USDⓈ-margined futures.
+	USDM MarketType = "usdm"
+)
+
+// endpoints are the REST paths and limits that differ between spot and USD-M futures.
+type endpoints struct {
+	ticker       string
+	bookTicker   string
+	depth        string
+	klines       string
+	exchangeInfo string
+	order        string
+	openOrders   string
+	balances     string
+	positions    string
+	listenKey    string
+	time         string
+	// depthLimits are the accepted order book limits; any limit up to maxDepth is accepted when nil.
+	depthLimits []int
+	maxDepth    int
+	maxKlines   int
+}
+
+var spotEndpoints = endpoints{
+	ticker:       "/api/v3/ticker/24hr",
+	bookTicker:   "/api/v3/ticker/bookTicker",
+	depth:        "/api/v3/depth",
+	klines:       "/api/v3/klines",
+	exchangeInfo: "/api/v3/exchangeInfo",
+	order:        "/api/v3/order",
+	openOrders:   "/api/v3/openOrders",
+	balances:     "/api/v3/account",
+	listenKey:    "/api/v3/userDataStream",
+	time:         "/api/v3/time",
+	maxDepth:     5000,
+	maxKlines:    1000,
+}
+
+var usdmEndpoints = endpoints{
+	ticker:       "/fapi/v1/ticker/24hr",
+	bookTicker:   "/fapi/v1/ticker/bookTicker",
+	depth:        "/fapi/v1/depth",
+	klines:       "/fapi/v1/klines",
+	exchangeInfo: "/fapi/v1/exchangeInfo",
+	order:        "/fapi/v1/order",
+	openOrders:   "/fapi/v1/openOrders",
+	balances:     "/fapi/v2/balance",
+	positions:    "/fapi/v2/positionRisk",
+	listenKey:    "/fapi/v1/listenKey",
+	time:         "/fapi/v1/time",
+	depthLimits:  []int{5, 10, 20, 50, 100, 500, 1000},
+	maxDepth:     1000,
+	maxKlines:    1500,
+}
+
+// Connector implements exchange.Exchange for Binance spot or USD-M futures. Request weights
+// follow the spot API, which are at least as high as the futures ones.
+type Connector struct {
+	market    MarketType
+	client    *client.Client
+	endpoints endpoints
+	stream    *Stream
+
+	mu      sync.Mutex
+	tickers map[string]exchange.Ticker
+}
+
+var _ exchange.Exchange = (*Connector)(nil)
+
+// NewConnector creates a connector for market. The API key and secret may be empty for market
+// data only.
+func NewConnector(apiKey, apiSecret string, isTestnet bool, market MarketType) *Connector {
+	cfg := client.Config{APIKey: apiKey, APISecret: apiSecret}
+	var streamURL string
+	if market == USDM {
+		cfg.BaseURL, cfg.WeightLimit, streamURL = client.FuturesBaseURL, client.FuturesWeightLimit, FuturesStreamURL
+		if isTestnet {
+			cfg.BaseURL, streamURL = client.FuturesTestnetBaseURL, FuturesTestnetStreamURL
+		}
+	} else {
+		cfg.BaseURL, cfg.WeightLimit, streamURL = client.SpotBaseURL, client.SpotWeightLimit, SpotStreamURL
+		if isTestnet {
+			cfg.BaseURL, streamURL = client.SpotTestnetBaseURL, SpotTestnetStreamURL
+		}
+	}
+	cfg.TimePath = endpointsFor(market).time
+	return newConnector(market, client.New(cfg), streamURL)
+}
+
+func newConnector(market MarketType, c *client.Client, streamURL string) *Connector {
+	e := endpointsFor(market)
+	return &Connector{
+		market:    market,
+		client:    c,
+		endpoints: e,
+		stream:    newStream(streamURL, c, e.listenKey, market == Spot),
+		tickers:   make(map[string]exchange.Ticker),
+	}
+}
+
+func endpointsFor(market MarketType) endpoints {
+	if market == USDM {
+		return usdmEndpoints
+	}
+	return spotEndpoints
+}
+
+// Client returns the REST client, e.g. to call SyncTime or endpoints the connector does not cover.
+func (c *Connector) Client() *client.Client {
+	return c.client
+}
+
+// Stream returns the websocket stream used by SubscribeTicker and SubscribeOrders.
+func (c *Connector) Stream() *Stream {
+	return c.stream
+}
+
+func (c *Connector) Name() string {
+	return "binance"
+}
+
+func (c *Connector) get(path string, params client.Params, weight int, out any) error {
+	return c.client.Do(&client.Request{Method: http.MethodGet, Path: path, Params: params, Weight: weight}, out)
+}
+
+func (c *Connector) signed(method, path string, params client.Params, weight int, out any) error {
+	return c.client.Do(&client.Request{Method: method, Path: path, Params: params, Security: client.Signed, Weight: weight}, out)
+}
+
+func (c *Connector) Ticker(symbol string) (*exchange.Ticker, error) {
+	var stats ticker24h
+	if err := c.get(c.endpoints.ticker, client.Params{"symbol": symbol}, 2, &stats); err != nil {
+		return nil, fmt.Errorf("error fetching ticker: %w", err)
+	}
+	if stats.BidPrice == "" {
+		// Futures tickers carry no bid and ask.
+		var book bookTicker
+		if err := c.get(c.endpoints.bookTicker, client.Params{"symbol": symbol}, 2, &book); err != nil {
+			return nil, fmt.Errorf("error fetching book ticker: %w", err)
+		}
+		stats.BidPrice, stats.AskPrice = book.BidPrice, book.AskPrice
+	}
+	var p exchange.FieldParser
+	t := exchange.Ticker{
+		Symbol:    symbol,
+		Last:      p.Decimal("lastPrice", stats.LastPrice),
+		Bid:       p.Decimal("bidPrice", stats.BidPrice),
+		Ask:       p.Decimal("askPrice", stats.AskPrice),
+		Volume24h: p.Decimal("volume", stats.Volume),
+		Time:      time.UnixMilli(stats.CloseTime),
+	}
+	return &t, p.Err
+}
+
+func (c *Connector) OrderBook(symbol string, depth int) (*exchange.OrderBook, error) {
+	limit := c.depthLimit(depth)
+	var res orderBook
+	if err := c.get(c.endpoints.depth, client.Params{"symbol": symbol, "limit": limit}, depthWeight(limit), &res); err != nil {
+		return nil, fmt.Errorf("error fetching order book: %w", err)
+	}
+	var p exchange.FieldParser
+	book := &exchange.OrderBook{
+		Symbol: symbol,
+		Bids:   levels(&p, res.Bids, depth),
+		Asks:   levels(&p, res.Asks, depth),
+		Time:   time.Now(),
+	}
+	if res.Time != 0 {
+		book.Time = time.UnixMilli(res.Time)
+	}
+	return book, p.Err
+}
+
+// depthLimit returns the smallest accepted order book limit of at least depth.
+func (c *Connector) depthLimit(depth int) int {
+	if depth <= 0 || depth > c.endpoints.maxDepth {
+		return c.endpoints.maxDepth
+	}
+	for _, limit := range c.endpoints.depthLimits {
+		if limit >= depth {
+			return limit
+		}
+	}
+	return depth
+}
+
+// depthWeight returns the spot request weight of an order book limit.
+func depthWeight(limit int) int {
+	switch {
+	case limit <= 100:
+		return 5
+	case limit <= 500:
+		return 25
+	case limit <= 1000:
+		return 50
+	}
+	return 250
+}
+
+func levels(p *exchange.FieldParser, rows [][2]string, depth int) []exchange.PriceLevel {
+	if depth > 0 && len(rows) > depth {
+		rows = rows[:depth]
+	}
+	out := make([]exchange.PriceLevel, len(rows))
+	for i, row := range rows {
+		out[i] = exchange.PriceLevel{Price: p.Decimal("price", row[0]), Qty: p.Decimal("qty", row[1])}
+	}
+	return out
+}
+
+// candleIntervals maps the durations Candles accepts to Binance kline intervals.
+var candleIntervals = map[time.Duration]string{
+	time.Minute:         "1m",
+	3 * time.Minute:     "3m",
+	5 * time.Minute:     "5m",
+	15 * time.Minute:    "15m",
+	30 * time.Minute:    "30m",
+	time.Hour:           "1h",
+	2 * time.Hour:       "2h",
+	4 * time.Hour:       "4h",
+	6 * time.Hour:       "6h",
+	8 * time.Hour:       "8h",
+	12 * time.Hour:      "12h",
+	24 * time.Hour:      "1d",
+	3 * 24 * time.Hour:  "3d",
+	7 * 24 * time.Hour:  "1w",
+	30 * 24 * time.Hour: "1M",
+}
+
+// Candles pages backwards through the klines endpoint when limit exceeds what one request returns.
+func (c *Connector) Candles(symbol string, interval time.Duration, limit int) ([]exchange.Candle, error) {
+	name, ok := candleIntervals[interval]
+	if !ok {
+		return nil, fmt.Errorf("%w: candle interval %s", exchange.ErrNotSupported, interval)
+	}
+	var (
+		p       exchange.FieldParser
+		out     []exchange.Candle
+		endTime int64
+	)
+	for len(out) < limit {
+		n := min(limit-len(out), c.endpoints.maxKlines)
+		params := client.Params{"symbol": symbol, "interval": name, "limit": n}
+		if endTime != 0 {
+			params["endTime"] = endTime
+		}
+		var rows []kline
+		if err := c.get(c.endpoints.klines, params, 2, &rows); err != nil {
+			return nil, fmt.Errorf("error fetching candles: %w", err)
+		}
+		page := make([]exchange.Candle, len(rows))
+		for i, row := range rows {
+			page[i] = exchange.Candle{
+				Start:  time.UnixMilli(row.OpenTime),
+				Open:   p.Decimal("open", row.Open),
+				High:   p.Decimal("high", row.High),
+				Low:    p.Decimal("low", row.Low),
+				Close:  p.Decimal("close", row.Close),
+				Volume: p.Decimal("volume", row.Volume),
+			}
+		}
+		// Binance returns the oldest candle first.
+		out = append(page, out...)
+		if len(rows) < n {
+			break
+		}
+		endTime = rows[0].OpenTime - 1
+	}
+	return out, p.Err
+}
+
+func (c *Connector) Instruments() ([]exchange.Instrument, error) {
+	var info exchangeInfo
+	if err := c.get(c.endpoints.exchangeInfo, nil, 20, &info); err != nil {
+		return nil, fmt.Errorf("error fetching exchange info: %w", err)
+	}
+	var p exchange.FieldParser
+	out := make([]exchange.Instrument, 0, len(info.Symbols))
+	for _, symbol := range info.Symbols {
+		if symbol.Status != "TRADING" {
+			continue
+		}
+		instrument := exchange.Instrument{Symbol: symbol.Symbol, Base: symbol.BaseAsset, Quote: symbol.QuoteAsset}
+		for _, filter := range symbol.Filters {
+			switch filter.FilterType {
+			case "PRICE_FILTER":
+				instrument.TickSize = p.Decimal("tickSize", filter.TickSize)
+			case "LOT_SIZE":
+				instrument.QtyStep = p.Decimal("stepSize", filter.StepSize)
+				instrument.MinQty = p.Decimal("minQty", filter.MinQty)
+			case "NOTIONAL", "MIN_NOTIONAL":
+				if filter.MinNotional != "" {
+					instrument.MinNotional = p.Decimal("minNotional", filter.MinNotional)
+				} else {
+					instrument.MinNotional = p.Decimal("notional", filter.Notional)
+				}
+			}
+		}
+		out = append(out, instrument)
+	}
+	return out, p.Err
+}
+
+func (c *Connector) PlaceOrder(req exchange.OrderRequest) (*exchange.Order, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	params := client.Params{
+		"symbol":   req.Symbol,
+		"side":     strings.ToUpper(string(req.Side)),
+		"type":     "MARKET",
+		"quantity": req.Qty.String(),
+	}
+	if req.Type == exchange.Limit {
+		params["type"] = "LIMIT"
+		params["price"] = req.Price.String()
+		switch {
+		case req.TimeInForce == "":
+			params["timeInForce"] = string(exchange.GTC)
+		case req.TimeInForce != exchange.PostOnly:
+			params["timeInForce"] = string(req.TimeInForce)
+		case c.market == Spot:
+			params["type"] = "LIMIT_MAKER"
+		default:
+			params["timeInForce"] = "GTX"
+		}
+	}
+	if req.ClientOrderID != "" {
+		params["newClientOrderId"] = req.ClientOrderID
+	}
+	if req.ReduceOnly {
+		if c.market == Spot {
+			return nil, fmt.Errorf("%w: reduce-only orders on spot", exchange.ErrNotSupported)
+		}
+		params["reduceOnly"] = "true"
+	}
+	if c.market == Spot {
+		params["newOrderRespType"] = "RESULT"
+	}
+	var res order
+	if err := c.signed(http.MethodPost, c.endpoints.order, params, 1, &res); err != nil {
+		return nil, fmt.Errorf("error placing order: %w", err)
+	}
+	return convertOrder(res)
+}
+
+func (c *Connector) CancelOrder(symbol, orderID string) error {
+	err := c.signed(http.MethodDelete, c.endpoints.order, client.Params{"symbol": symbol, "orderId": orderID}, 1, nil)
+	if err != nil {
+		return fmt.Errorf("error cancelling order: %w", neutralError(err))
+	}
+	return nil
+}
+
+func (c *Connector) GetOrder(symbol, orderID string) (*exchange.Order, error) {
+	var res order
+	if err := c.signed(http.MethodGet, c.endpoints.order, client.Params{"symbol": symbol, "orderId": orderID}, 4, &res); err != nil {
+		return nil, fmt.Errorf("error fetching order: %w", neutralError(err))
+	}
+	return convertOrder(res)
+}
+
+func (c *Connector) OpenOrders(symbol string) ([]exchange.Order, error) {
+	params, weight := client.Params{}, 80
+	if symbol != "" {
+		params["symbol"], weight = symbol, 6
+	}
+	var res []order
+	if err := c.signed(http.MethodGet, c.endpoints.openOrders, params, weight, &res); err != nil {
+		return nil, fmt.Errorf("error fetching open orders: %w", err)
+	}
+	out := make([]exchange.Order, 0, len(res))
+	for _, raw := range res {
+		o, err := convertOrder(raw)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, *o)
+	}
+	return out, nil
+}
+
+// neutralError adds exchange.ErrOrderNotFound to Binance's unknown order errors.
+func neutralError(err error) error {
+	var apiErr *client.APIError
+	if errors.As(err, &apiErr) && (apiErr.Code == -2011 || apiErr.Code == -2013) {
+		return fmt.Errorf("%w: %w", exchange.ErrOrderNotFound, err)
+	}
+	return err
+}
+
+func convertOrder(raw order) (*exchange.Order, error) {
+	var p exchange.FieldParser
+	o := &exchange.Order{
+		ID:            strconv.FormatInt(raw.OrderID, 10),
+		ClientOrderID: raw.ClientOrderID,
+		Symbol:        raw.Symbol,
+		Side:          convertSide(raw.Side),
+		Type:          convertType(raw.Type),
+		Status:        convertStatus(raw.Status),
+		Price:         p.Decimal("price", raw.Price),
+		Qty:           p.Decimal("origQty", raw.OrigQty),
+		FilledQty:     p.Decimal("executedQty", raw.ExecutedQty),
+		AvgPrice:      p.Decimal("avgPrice", raw.AvgPrice),
+		CreatedAt:     unixMilli(raw.Time, raw.TransactTime, raw.UpdateTime),
+		UpdatedAt:     unixMilli(raw.UpdateTime, raw.TransactTime, raw.Time),
+	}
+	if raw.AvgPrice == "" {
+		o.AvgPrice = averagePrice(p.Decimal("cummulativeQuoteQty", raw.CummulativeQuoteQty), o.FilledQty)
+	}
+	if p.Err != nil {
+		return nil, p.Err
+	}
+	return o, nil
+}
+
+// averagePrice divides the filled quote quantity of a spot order by its filled quantity, since
+// spot orders do not report an average price. Binance reports a negative quote quantity when it
+// is unknown.
+func averagePrice(quote, filled decimal.Decimal) decimal.Decimal {
+	if quote.Sign() <= 0 || filled.Sign() <= 0 {
+		return decimal.Zero
+	}
+	avg, _ := quote.DivRound(filled, 8)
+	return avg
+}
+
+// unixMilli returns the first non-zero millisecond timestamp as a time.
+func unixMilli(candidates ...int64) time.Time {
+	for _, ms := range candidates {
+		if ms != 0 {
+			return time.UnixMilli(ms)
+		}
+	}
+	return time.Time{}
+}
+
+func convertSide(side string) exchange.Side {
+	if side == "SELL" {
+		return exchange.Sell
+	}
+	return exchange.Buy
+}
+
+func convertType(orderType string) exchange.OrderType {
+	switch orderType {
+	case "LIMIT", "LIMIT_MAKER":
+		return exchange.Limit
+	case "MARKET":
+		return exchange.Market
+	}
+	// Stop and take-profit orders keep Binance's type.
+	return exchange.OrderType(orderType)
+}
+
+func convertStatus(status string) exchange.OrderStatus {
+	switch status {
+	case "PARTIALLY_FILLED":
+		return exchange.StatusPartiallyFilled
+	case "FILLED":
+		return exchange.StatusFilled
+	case "CANCELED", "EXPIRED", "EXPIRED_IN_MATCH":
+		return exchange.StatusCancelled
+	case "REJECTED":
+		return exchange.StatusRejected
+	}
+	// NEW and the pending states of spot order lists are all still waiting to fill.
+	return exchange.StatusNew
+}
+
+func (c *Connector) Balances() ([]exchange.Balance, error) {
+	var p exchange.FieldParser
+	var out []exchange.Balance
+	if c.market == Spot {
+		var res spotAccount
+		if err := c.signed(http.MethodGet, c.endpoints.balances, client.Params{"omitZeroBalances": "true"}, 20, &res); err != nil {
+			return nil, fmt.Errorf("error fetching balances: %w", err)
+		}
+		for _, b := range res.Balances {
+			free := p.Decimal("free", b.Free)
+			locked := p.Decimal("locked", b.Locked)
+			out = append(out, exchange.Balance{Asset: b.Asset, Total: free.Add(locked), Free: free, Locked: locked})
+		}
+		return out, p.Err
+	}
+
+	var res []futuresBalance
+	if err := c.signed(http.MethodGet, c.endpoints.balances, nil, 5, &res); err != nil {
+		return nil, fmt.Errorf("error fetching balances: %w", err)
+	}
+	for _, b := range res {
+		total := p.Decimal("balance", b.Balance)
+		if total.IsZero() {
+			continue
+		}
+		free := p.Decimal("availableBalance", b.AvailableBalance)
+		locked := total.Sub(free)
+		if locked.Sign() < 0 {
+			// Unrealised profit can make more available than the wallet holds.
+			locked = decimal.Zero
+		}
+		out = append(out, exchange.Balance{Asset: b.Asset, Total: total, Free: free, Locked: locked})
+	}
+	return out, p.Err
+}
+
+func (c *Connector) Positions(symbol string) ([]exchange.Position, error) {
+	if c.market == Spot {
+		return nil, fmt.Errorf("%w: positions on spot", exchange.ErrNotSupported)
+	}
+	params := client.Params{}
+	if symbol != "" {
+		params["symbol"] = symbol
+	}
+	var res []positionRisk
+	if err := c.signed(http.MethodGet, c.endpoints.positions, params, 5, &res); err != nil {
+		return nil, fmt.Errorf("error fetching positions: %w", err)
+	}
+	var p exchange.FieldParser
+	var out []exchange.Position
+	for _, risk := range res {
+		amount := p.Decimal("positionAmt", risk.PositionAmt)
+		if amount.IsZero() {
+			continue
+		}
+		side := exchange.Buy
+		if amount.Sign() < 0 {
+			side = exchange.Sell
+		}
+		out = append(out, exchange.Position{
+			Symbol:        risk.Symbol,
+			Side:          side,
+			Size:          amount.Abs(),
+			EntryPrice:    p.Decimal("entryPrice", risk.EntryPrice),
+			MarkPrice:     p.Decimal("markPrice", risk.MarkPrice),
+			UnrealizedPnL: p.Decimal("unRealizedProfit", risk.UnRealizedProfit),
+			Leverage:      p.Decimal("leverage", risk.Leverage),
+		})
+	}
+	return out, p.Err
+}
+
+// SubscribeTicker merges the 24 hour ticker with the book ticker on futures, whose tickers carry
+// no bid and ask, so handler always receives the full ticker.
+func (c *Connector) SubscribeTicker(symbol string, handler func(exchange.Ticker)) error {
+	merge := func(data json.RawMessage) {
+		var event tickerEvent
+		if err := json.Unmarshal(data, &event); err != nil {
+			c.stream.reportError(fmt.Errorf("failed to decode ticker: %w", err))
+			return
+		}
+		t, err := c.mergeTicker(symbol, event)
+		if err != nil {
+			c.stream.reportError(err)
+			return
+		}
+		handler(t)
+	}
+	name := strings.ToLower(symbol)
+	if err := c.stream.Subscribe(name+"@ticker", merge); err != nil {
+		return err
+	}
+	if c.market == USDM {
+		return c.stream.Subscribe(name+"@bookTicker", merge)
+	}
+	return nil
+}
+
+func (c *Connector) mergeTicker(symbol string, event tickerEvent) (exchange.Ticker, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	t := c.tickers[symbol]
+	t.Symbol = symbol
+	var p exchange.FieldParser
+	if event.Last != "" {
+		t.Last = p.Decimal("lastPrice", event.Last)
+	}
+	if event.Bid != "" {
+		t.Bid = p.Decimal("bidPrice", event.Bid)
+	}
+	if event.Ask != "" {
+		t.Ask = p.Decimal("askPrice", event.Ask)
+	}
+	if event.Volume != "" {
+		t.Volume24h = p.Decimal("volume", event.Volume)
+	}
+	if p.Err != nil {
+		return exchange.Ticker{}, fmt.Errorf("failed to decode ticker: %w", p.Err)
+	}
+	t.Time = unixMilli(event.EventTime, time.Now().UnixMilli())
+	c.tickers[symbol] = t
+	return t, nil
+}
+
+// SubscribeOrders decodes spot execution reports and futures order updates from the user data
+// stream. It replaces the handler of a previous call.
+func (c *Connector) SubscribeOrders(handler func(exchange.Order)) error {
+	return c.stream.SubscribeUserData(func(event string, data json.RawMessage) {
+		var (
+			o   *exchange.Order
+			err error
+		)
+		switch event {
+		case "executionReport":
+			o, err = convertExecutionReport(data)
+		case "ORDER_TRADE_UPDATE":
+			o, err = convertOrderTradeUpdate(data)
+		default:
+			return
+		}
+		if err != nil {
+			c.stream.reportError(fmt.Errorf("failed to decode order update: %w", err))
+			return
+		}
+		handler(*o)
+	})
+}
+
+func convertExecutionReport(data json.RawMessage) (*exchange.Order, error) {
+	var report executionReport
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, err
+	}
+	var p exchange.FieldParser
+	o := &exchange.Order{
+		ID:            strconv.FormatInt(report.OrderID, 10),
+		ClientOrderID: report.ClientOrderID,
+		Symbol:        report.Symbol,
+		Side:          convertSide(report.Side),
+		Type:          convertType(report.Type),
+		Status:        convertStatus(report.Status),
+		Price:         p.Decimal("p", report.Price),
+		Qty:           p.Decimal("q", report.Qty),
+		FilledQty:     p.Decimal("z", report.FilledQty),
+		CreatedAt:     unixMilli(report.CreatedTime),
+		UpdatedAt:     unixMilli(report.TransactionTime, report.EventTime),
+	}
+	if report.OrigClientOrderID != "" {
+		// Cancels report the id of the cancel request in c and the order's own id in C.
+		o.ClientOrderID = report.OrigClientOrderID
+	}
+	o.AvgPrice = averagePrice(p.Decimal("Z", report.FilledQuoteQty), o.FilledQty)
+	if p.Err != nil {
+		return nil, p.Err
+	}
+	return o, nil
+}
+
+func convertOrderTradeUpdate(data json.RawMessage) (*exchange.Order, error) {
+	var update orderTradeUpdate
+	if err := json.Unmarshal(data, &update); err != nil {
+		return nil, err
+	}
+	raw := update.Order
+	var p exchange.FieldParser
+	o := &exchange.Order{
+		ID:            strconv.FormatInt(raw.OrderID, 10),
+		ClientOrderID: raw.ClientOrderID,
+		Symbol:        raw.Symbol,
+		Side:          convertSide(raw.Side),
+		Type:          convertType(raw.Type),
+		Status:        convertStatus(raw.Status),
+		Price:         p.Decimal("p", raw.Price),
+		Qty:           p.Decimal("q", raw.Qty),
+		FilledQty:     p.Decimal("z", raw.FilledQty),
+		AvgPrice:      p.Decimal("ap", raw.AvgPrice),
+		UpdatedAt:     unixMilli(raw.TradeTime, update.TransactionTime, update.EventTime),
+	}
+	if p.Err != nil {
+		return nil, p.Err
+	}
+	return o, nil
+}
+
+func (c *Connector) Connect() error {
+	return c.stream.Connect()
+}
+
+func (c *Connector) Close() error {
+	return c.stream.Close()
+}
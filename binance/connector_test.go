@@ -0,0 +1,230 @@
+package binance
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cploutarchou/crypto-sdk-suite/binance/client"
+	"github.com/cploutarchou/crypto-sdk-suite/decimal"
+	"github.com/cploutarchou/crypto-sdk-suite/exchange"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestConnector serves the REST and websocket endpoints of market from mux.
+func newTestConnector(t *testing.T, market MarketType, mux *http.ServeMux) *Connector {
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	c := newConnector(market, client.New(client.Config{APIKey: "key", APISecret: "secret", BaseURL: server.URL}), "ws"+strings.TrimPrefix(server.URL, "http"))
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+func TestConnectorTickerFutures(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/fapi/v1/ticker/24hr", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"symbol":"BTCUSDT","lastPrice":"30000.1","volume":"1200.5","closeTime":1700000000000}`)
+	})
+	mux.HandleFunc("/fapi/v1/ticker/bookTicker", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"symbol":"BTCUSDT","bidPrice":"30000.0","bidQty":"3","askPrice":"30000.2","askQty":"1"}`)
+	})
+	c := newTestConnector(t, USDM, mux)
+
+	ticker, err := c.Ticker("BTCUSDT")
+	require.NoError(t, err)
+	assert.Equal(t, "30000.1", ticker.Last.String())
+	assert.Equal(t, "30000", ticker.Bid.String())
+	assert.Equal(t, "30000.2", ticker.Ask.String())
+	assert.Equal(t, time.UnixMilli(1700000000000), ticker.Time)
+}
+
+func TestConnectorCandlesPaging(t *testing.T) {
+	var endTimes []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v3/klines", func(w http.ResponseWriter, r *http.Request) {
+		endTimes = append(endTimes, r.URL.Query().Get("endTime"))
+		start := int64(3)
+		if r.URL.Query().Get("endTime") != "" {
+			start = 1
+		}
+		fmt.Fprintf(w, `[[%d,"1","2","0.5","1.5","10",0,"15",1,"5","7","0"],[%d,"1.5","2","1","1.8","12",0,"20",1,"6","9","0"]]`, start*60000, (start+1)*60000)
+	})
+	c := newTestConnector(t, Spot, mux)
+	c.endpoints.maxKlines = 2
+
+	candles, err := c.Candles("BTCUSDT", time.Minute, 4)
+	require.NoError(t, err)
+	require.Len(t, candles, 4)
+	assert.Equal(t, []string{"", "179999"}, endTimes)
+	for i, candle := range candles {
+		assert.Equal(t, time.UnixMilli(int64(i+1)*60000), candle.Start)
+	}
+	assert.Equal(t, "1.8", candles[3].Close.String())
+
+	_, err = c.Candles("BTCUSDT", 7*time.Minute, 2)
+	assert.True(t, errors.Is(err, exchange.ErrNotSupported))
+}
+
+func TestConnectorInstruments(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/fapi/v1/exchangeInfo", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"symbols":[
+			{"symbol":"BTCUSDT","status":"TRADING","baseAsset":"BTC","quoteAsset":"USDT","filters":[
+				{"filterType":"PRICE_FILTER","tickSize":"0.10"},
+				{"filterType":"LOT_SIZE","stepSize":"0.001","minQty":"0.001"},
+				{"filterType":"MIN_NOTIONAL","notional":"100"}]},
+			{"symbol":"OLDUSDT","status":"SETTLING","baseAsset":"OLD","quoteAsset":"USDT"}]}`)
+	})
+	c := newTestConnector(t, USDM, mux)
+
+	instruments, err := c.Instruments()
+	require.NoError(t, err)
+	require.Len(t, instruments, 1)
+	assert.Equal(t, "0.1", instruments[0].TickSize.String())
+	assert.Equal(t, "0.001", instruments[0].QtyStep.String())
+	assert.Equal(t, "100", instruments[0].MinNotional.String())
+}
+
+func TestConnectorPlaceOrder(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v3/order", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "LIMIT_MAKER", query.Get("type"))
+		assert.Equal(t, "SELL", query.Get("side"))
+		assert.Empty(t, query.Get("timeInForce"))
+		assert.Equal(t, "my-id", query.Get("newClientOrderId"))
+		fmt.Fprint(w, `{"symbol":"BTCUSDT","orderId":28,"clientOrderId":"my-id","transactTime":1700000000000,
+			"price":"31000.00","origQty":"0.01000000","executedQty":"0.00000000","cummulativeQuoteQty":"0.00000000",
+			"status":"NEW","timeInForce":"GTC","type":"LIMIT_MAKER","side":"SELL"}`)
+	})
+	c := newTestConnector(t, Spot, mux)
+
+	req := exchange.OrderRequest{
+		Symbol:        "BTCUSDT",
+		Side:          exchange.Sell,
+		Type:          exchange.Limit,
+		Qty:           decimal.RequireFromString("0.01"),
+		Price:         decimal.RequireFromString("31000"),
+		TimeInForce:   exchange.PostOnly,
+		ClientOrderID: "my-id",
+	}
+	order, err := c.PlaceOrder(req)
+	require.NoError(t, err)
+	assert.Equal(t, "28", order.ID)
+	assert.Equal(t, exchange.Limit, order.Type)
+	assert.Equal(t, exchange.StatusNew, order.Status)
+	assert.Equal(t, time.UnixMilli(1700000000000), order.CreatedAt)
+
+	req.ReduceOnly = true
+	_, err = c.PlaceOrder(req)
+	assert.True(t, errors.Is(err, exchange.ErrNotSupported))
+}
+
+func TestConnectorOrders(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v3/order", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"code":-2011,"msg":"Unknown order sent."}`)
+			return
+		}
+		fmt.Fprint(w, `{"symbol":"BTCUSDT","orderId":29,"price":"0","origQty":"0.3","executedQty":"0.3",
+			"cummulativeQuoteQty":"9000.3","status":"FILLED","type":"MARKET","side":"BUY","time":1,"updateTime":2}`)
+	})
+	c := newTestConnector(t, Spot, mux)
+
+	err := c.CancelOrder("BTCUSDT", "29")
+	assert.True(t, errors.Is(err, exchange.ErrOrderNotFound))
+
+	order, err := c.GetOrder("BTCUSDT", "29")
+	require.NoError(t, err)
+	assert.Equal(t, exchange.StatusFilled, order.Status)
+	assert.Equal(t, "30001", order.AvgPrice.String())
+	assert.Equal(t, time.UnixMilli(2), order.UpdatedAt)
+}
+
+func TestConnectorPositions(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/fapi/v2/positionRisk", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"symbol":"BTCUSDT","positionAmt":"-0.250","entryPrice":"30000","markPrice":"29000",
+			"unRealizedProfit":"250","leverage":"10"},{"symbol":"ETHUSDT","positionAmt":"0.000"}]`)
+	})
+	c := newTestConnector(t, USDM, mux)
+
+	positions, err := c.Positions("")
+	require.NoError(t, err)
+	require.Len(t, positions, 1)
+	assert.Equal(t, exchange.Sell, positions[0].Side)
+	assert.Equal(t, "0.25", positions[0].Size.String())
+
+	_, err = newConnector(Spot, nil, "").Positions("")
+	assert.True(t, errors.Is(err, exchange.ErrNotSupported))
+}
+
+func TestConnectorStream(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/fapi/v1/listenKey", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "key", r.Header.Get("X-MBX-APIKEY"))
+		fmt.Fprint(w, `{"listenKey":"lk1"}`)
+	})
+	mux.HandleFunc("/stream", func(w http.ResponseWriter, r *http.Request) {
+		streams := strings.Split(r.URL.Query().Get("streams"), "/")
+		assert.ElementsMatch(t, []string{"btcusdt@ticker", "btcusdt@bookTicker", "lk1"}, streams)
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for _, msg := range []string{
+			`{"stream":"btcusdt@ticker","data":{"e":"24hrTicker","E":1700000000000,"s":"BTCUSDT","c":"30000.1","v":"1200","C":1700000000000}}`,
+			`{"stream":"btcusdt@bookTicker","data":{"e":"bookTicker","E":1700000000001,"s":"BTCUSDT","b":"30000.0","B":"3","a":"30000.2","A":"1"}}`,
+			`{"stream":"lk1","data":{"e":"ORDER_TRADE_UPDATE","E":1700000000002,"T":1700000000002,"o":{"s":"BTCUSDT","c":"my-id",
+				"S":"BUY","o":"LIMIT","q":"0.01","p":"30000","ap":"30000","AP":"0","x":"TRADE","X":"FILLED","i":7,"z":"0.01","T":1700000000002}}}`,
+		} {
+			assert.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(msg)))
+		}
+		_, _, _ = conn.ReadMessage()
+	})
+	c := newTestConnector(t, USDM, mux)
+
+	tickers := make(chan exchange.Ticker, 2)
+	orders := make(chan exchange.Order, 1)
+	require.NoError(t, c.SubscribeTicker("BTCUSDT", func(ticker exchange.Ticker) { tickers <- ticker }))
+	require.NoError(t, c.SubscribeOrders(func(order exchange.Order) { orders <- order }))
+	require.NoError(t, c.Connect())
+
+	<-tickers
+	ticker := <-tickers
+	assert.Equal(t, "30000.1", ticker.Last.String(), "the last price must survive the book ticker")
+	assert.Equal(t, "30000", ticker.Bid.String(), "the bid quantity must not overwrite the bid price")
+	assert.Equal(t, "30000.2", ticker.Ask.String())
+
+	order := <-orders
+	assert.Equal(t, "7", order.ID)
+	assert.Equal(t, exchange.StatusFilled, order.Status)
+	assert.Equal(t, "30000", order.AvgPrice.String())
+}
+
+func TestConvertExecutionReport(t *testing.T) {
+	data := json.RawMessage(`{"e":"executionReport","E":1700000000001,"s":"BTCUSDT","c":"cancel-id","S":"SELL","o":"LIMIT",
+		"f":"GTC","q":"2.00","p":"100.5","P":"0","x":"CANCELED","X":"CANCELED","i":9,"l":"0","z":"1.00","L":"0",
+		"T":1700000000001,"t":-1,"I":12,"O":1700000000000,"Z":"100.50","C":"my-id","Q":"0"}`)
+	order, err := convertExecutionReport(data)
+	require.NoError(t, err)
+	assert.Equal(t, "my-id", order.ClientOrderID)
+	assert.Equal(t, exchange.Sell, order.Side)
+	assert.Equal(t, exchange.StatusCancelled, order.Status)
+	assert.Equal(t, "100.5", order.Price.String())
+	assert.Equal(t, "2", order.Qty.String())
+	assert.Equal(t, "100.5", order.AvgPrice.String())
+	assert.Equal(t, time.UnixMilli(1700000000000), order.CreatedAt)
+}
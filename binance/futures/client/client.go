@@ -1,18 +1,16 @@
+// Package client sends the requests of the futures package through the REST client shared with
+// the Binance connector, so both use the same signing, rate limiting and back-off.
+//
+// Deprecated: use binance/client directly, or binance.NewConnector with binance.USDM.
 package client
 
 import (
-	"bytes"
-	"crypto/hmac"
-	"crypto/sha256"
-	"encoding/hex"
-	"encoding/json"
 	"fmt"
-	"io"
-	"log"
-	"net/http"
+	"net/url"
+	"strings"
 	"sync"
-	"time"
 
+	rest "github.com/cploutarchou/crypto-sdk-suite/binance/client"
 	"github.com/cploutarchou/crypto-sdk-suite/binance/futures/constants"
 )
 
@@ -26,11 +24,14 @@ type Config struct {
 
 // Client represents a client for Binance's futures trading.
 type Client struct {
-	sync.Mutex
-	config Config
+	sync.Mutex // kept for compatibility; requests no longer hold it
+	config     Config
+	rest       *rest.Client
 }
 
 // NewFuturesClient creates a new client instance.
+//
+// Deprecated: use rest.New with rest.FuturesBaseURL, or binance.NewConnector.
 func NewFuturesClient(apiKey, apiSecret string, isTestnet bool) *Client {
 	var baseURL, wsBaseURL string
 	if isTestnet {
@@ -48,76 +49,44 @@ func NewFuturesClient(apiKey, apiSecret string, isTestnet bool) *Client {
 
 	return &Client{
 		config: config,
+		rest: rest.New(rest.Config{
+			APIKey:      apiKey,
+			APISecret:   apiSecret,
+			BaseURL:     baseURL,
+			TimePath:    constants.ServerTimeEndpoint,
+			WeightLimit: rest.FuturesWeightLimit,
+		}),
 	}
 }
 
-// MakeAuthenticatedRequest creates an authenticated request to the API.
-func (c *Client) MakeAuthenticatedRequest(method, endpoint, bodyData string, responseData any) error {
-	timestamp := time.Now().UnixNano() / int64(time.Millisecond)
-	var data, signature string
-	signature = c.createSignature(data)
-	if len(bodyData) > 0 {
-		data = fmt.Sprintf("%s&timestamp=%d", bodyData, timestamp)
-	}
-	reqURL := fmt.Sprintf("%s%s%s&signature=%s", c.config.BaseURL, endpoint, data, signature)
-
-	req, err := http.NewRequest(method, reqURL, bytes.NewBufferString(bodyData))
-	if err != nil {
-		log.Printf("Error creating new request: %v", err)
-		return err
-	}
-
-	req.Header.Set("X-MBX-APIKEY", c.config.APIKey)
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		log.Printf("Error sending request: %v", err)
-		return err
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		log.Printf("Error reading response body: %v", err)
-		return err
-	}
+// REST returns the shared client the requests are sent through.
+func (c *Client) REST() *rest.Client {
+	return c.rest
+}
 
-	return json.Unmarshal(body, &responseData)
+// MakeAuthenticatedRequest sends a signed request. endpoint may carry a query string and bodyData
+// holds further URL encoded parameters.
+func (c *Client) MakeAuthenticatedRequest(method, endpoint, bodyData string, responseData any) error {
+	return c.do(method, endpoint, bodyData, rest.Signed, responseData)
 }
 
 // MakeRequestWithoutSignature handles making a non-authenticated API request.
 func (c *Client) MakeRequestWithoutSignature(method, endpoint string, responseData any) error {
-	c.Lock()
-	defer c.Unlock()
-
-	reqURL := fmt.Sprintf("%s%s", c.config.BaseURL, endpoint)
-
-	req, err := http.NewRequest(method, reqURL, http.NoBody)
-	if err != nil {
-		log.Printf("Error creating new request: %v", err)
-		return err
-	}
-
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		log.Printf("Error sending request: %v", err)
-		return err
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		log.Printf("Error reading response body: %v", err)
-		return err
-	}
-
-	return json.Unmarshal(body, &responseData)
+	return c.do(method, endpoint, "", rest.None, responseData)
 }
 
-// createSignature generates the HMAC SHA256 signature for the request.
-func (c *Client) createSignature(data string) string {
-	h := hmac.New(sha256.New, []byte(c.config.APISecret))
-	h.Write([]byte(data))
-	return hex.EncodeToString(h.Sum(nil))
+// do splits endpoint into its path and parameters and sends it with the shared client.
+func (c *Client) do(method, endpoint, data string, security rest.Security, responseData any) error {
+	path, query, _ := strings.Cut(endpoint, "?")
+	params := rest.Params{}
+	for _, encoded := range []string{query, data} {
+		values, err := url.ParseQuery(encoded)
+		if err != nil {
+			return fmt.Errorf("error parsing parameters of %s: %w", path, err)
+		}
+		for key := range values {
+			params[key] = values.Get(key)
+		}
+	}
+	return c.rest.Do(&rest.Request{Method: method, Path: path, Params: params, Security: security}, responseData)
 }
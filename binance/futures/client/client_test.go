@@ -0,0 +1,41 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	rest "github.com/cploutarchou/crypto-sdk-suite/binance/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestsUseSharedClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		switch r.URL.Path {
+		case "/fapi/v1/positionSide/dual":
+			assert.Equal(t, "key", r.Header.Get("X-MBX-APIKEY"))
+			assert.Equal(t, "true", query.Get("dualSidePosition"))
+			assert.NotEmpty(t, query.Get("timestamp"))
+			assert.NotEmpty(t, query.Get("signature"))
+			w.Write([]byte(`{"code":200,"msg":"success"}`))
+		case "/fapi/v1/depth":
+			assert.Empty(t, r.Header.Get("X-MBX-APIKEY"))
+			assert.Equal(t, "BTCUSDT", query.Get("symbol"))
+			assert.Equal(t, "5", query.Get("limit"))
+			w.Write([]byte(`{"lastUpdateId":1}`))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+	c := &Client{rest: rest.New(rest.Config{APIKey: "key", APISecret: "secret", BaseURL: server.URL})}
+
+	require.NoError(t, c.MakeAuthenticatedRequest(http.MethodPost, "/fapi/v1/positionSide/dual", "dualSidePosition=true", nil))
+	var book struct {
+		LastUpdateID int64 `json:"lastUpdateId"`
+	}
+	require.NoError(t, c.MakeRequestWithoutSignature(http.MethodGet, "/fapi/v1/depth?symbol=BTCUSDT&limit=5", &book))
+	assert.Equal(t, int64(1), book.LastUpdateID)
+}
@@ -78,7 +78,7 @@ func (m *marketImpl) OrderBook(symbol string, limit int) (*OrderBookResponse, er
 
 // RecentTradesList retrieves the recent trades for a specific symbol.
 func (m *marketImpl) RecentTradesList(symbol string, limit int) ([]Trade, error) {
-	endpoint := buildEndpoint("/fapi/v1/trades?symbol=%s&limit=%d", symbol, fmt.Sprintf("limit=%d", limit))
+	endpoint := buildEndpoint("/fapi/v1/trades?symbol=%s", symbol, fmt.Sprintf("limit=%d", limit))
 	var trades []Trade
 	if err := m.MakeRequestWithoutSignature(http.MethodGet, endpoint, &trades); err != nil {
 		return nil, fmt.Errorf("failed to get recent trades: %w", err)
@@ -0,0 +1,201 @@
+package binance
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type ticker24h struct {
+	Symbol    string `json:"symbol"`
+	LastPrice string `json:"lastPrice"`
+	BidPrice  string `json:"bidPrice"` // spot only
+	AskPrice  string `json:"askPrice"` // spot only
+	Volume    string `json:"volume"`
+	CloseTime int64  `json:"closeTime"`
+}
+
+type bookTicker struct {
+	Symbol   string `json:"symbol"`
+	BidPrice string `json:"bidPrice"`
+	AskPrice string `json:"askPrice"`
+}
+
+type orderBook struct {
+	Bids [][2]string `json:"bids"`
+	Asks [][2]string `json:"asks"`
+	Time int64       `json:"T"` // futures only
+}
+
+// kline is one row of the klines endpoint, which Binance encodes as a JSON array.
+type kline struct {
+	OpenTime int64
+	Open     string
+	High     string
+	Low      string
+	Close    string
+	Volume   string
+}
+
+func (k *kline) UnmarshalJSON(data []byte) error {
+	var row []json.RawMessage
+	if err := json.Unmarshal(data, &row); err != nil {
+		return err
+	}
+	fields := []any{&k.OpenTime, &k.Open, &k.High, &k.Low, &k.Close, &k.Volume}
+	if len(row) < len(fields) {
+		return fmt.Errorf("kline has %d fields, expected at least %d", len(row), len(fields))
+	}
+	for i, field := range fields {
+		if err := json.Unmarshal(row[i], field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type exchangeInfo struct {
+	Symbols []struct {
+		Symbol     string `json:"symbol"`
+		Status     string `json:"status"`
+		BaseAsset  string `json:"baseAsset"`
+		QuoteAsset string `json:"quoteAsset"`
+		Filters    []struct {
+			FilterType  string `json:"filterType"`
+			TickSize    string `json:"tickSize"`
+			StepSize    string `json:"stepSize"`
+			MinQty      string `json:"minQty"`
+			MinNotional string `json:"minNotional"` // spot NOTIONAL and MIN_NOTIONAL filters
+			Notional    string `json:"notional"`    // futures MIN_NOTIONAL filter
+		} `json:"filters"`
+	} `json:"symbols"`
+}
+
+// order is an order as returned by the spot and futures order endpoints.
+type order struct {
+	Symbol              string `json:"symbol"`
+	OrderID             int64  `json:"orderId"`
+	ClientOrderID       string `json:"clientOrderId"`
+	Price               string `json:"price"`
+	OrigQty             string `json:"origQty"`
+	ExecutedQty         string `json:"executedQty"`
+	CummulativeQuoteQty string `json:"cummulativeQuoteQty"` // spot only
+	AvgPrice            string `json:"avgPrice"`            // futures only
+	Status              string `json:"status"`
+	Type                string `json:"type"`
+	Side                string `json:"side"`
+	Time                int64  `json:"time"`
+	TransactTime        int64  `json:"transactTime"` // spot order placement only
+	UpdateTime          int64  `json:"updateTime"`
+}
+
+type spotAccount struct {
+	Balances []struct {
+		Asset  string `json:"asset"`
+		Free   string `json:"free"`
+		Locked string `json:"locked"`
+	} `json:"balances"`
+}
+
+type futuresBalance struct {
+	Asset            string `json:"asset"`
+	Balance          string `json:"balance"`
+	AvailableBalance string `json:"availableBalance"`
+}
+
+type positionRisk struct {
+	Symbol           string `json:"symbol"`
+	PositionAmt      string `json:"positionAmt"`
+	EntryPrice       string `json:"entryPrice"`
+	MarkPrice        string `json:"markPrice"`
+	UnRealizedProfit string `json:"unRealizedProfit"`
+	Leverage         string `json:"leverage"`
+}
+
+type listenKey struct {
+	ListenKey string `json:"listenKey"`
+}
+
+// The websocket events below use single-letter keys that differ only in case, e.g. "b" for the
+// bid price and "B" for the bid quantity. encoding/json matches keys case-insensitively when
+// there is no exact match, so both keys of every such pair must be declared even when only one
+// is used.
+
+// combinedEvent is a message of the combined stream endpoint.
+type combinedEvent struct {
+	Stream string          `json:"stream"`
+	Data   json.RawMessage `json:"data"`
+}
+
+// tickerEvent covers the <symbol>@ticker and <symbol>@bookTicker streams. Futures tickers
+// carry no bid and ask, which come from the book ticker.
+type tickerEvent struct {
+	Event     string `json:"e"`
+	EventTime int64  `json:"E"`
+	Symbol    string `json:"s"`
+	Last      string `json:"c"`
+	CloseTime int64  `json:"C"`
+	Bid       string `json:"b"`
+	BidQty    string `json:"B"`
+	Ask       string `json:"a"`
+	AskQty    string `json:"A"`
+	Volume    string `json:"v"`
+}
+
+// userEvent is the common header of user data stream events.
+type userEvent struct {
+	Event     string `json:"e"`
+	EventTime int64  `json:"E"`
+}
+
+// executionReport is the spot order update event.
+type executionReport struct {
+	Event             string `json:"e"`
+	EventTime         int64  `json:"E"`
+	Symbol            string `json:"s"`
+	Side              string `json:"S"`
+	ClientOrderID     string `json:"c"`
+	OrigClientOrderID string `json:"C"` // set on cancels
+	Type              string `json:"o"`
+	CreatedTime       int64  `json:"O"`
+	Qty               string `json:"q"`
+	QuoteOrderQty     string `json:"Q"`
+	Price             string `json:"p"`
+	StopPrice         string `json:"P"`
+	ExecutionType     string `json:"x"`
+	Status            string `json:"X"`
+	OrderID           int64  `json:"i"`
+	Ignore            int64  `json:"I"`
+	FilledQty         string `json:"z"`
+	FilledQuoteQty    string `json:"Z"`
+	TradeID           int64  `json:"t"`
+	TransactionTime   int64  `json:"T"`
+	LastQty           string `json:"l"`
+	LastPrice         string `json:"L"`
+}
+
+// orderTradeUpdate is the futures order update event.
+type orderTradeUpdate struct {
+	Event           string `json:"e"`
+	EventTime       int64  `json:"E"`
+	TransactionTime int64  `json:"T"`
+	Order           struct {
+		Symbol          string `json:"s"`
+		Side            string `json:"S"`
+		ClientOrderID   string `json:"c"`
+		Type            string `json:"o"`
+		Qty             string `json:"q"`
+		Price           string `json:"p"`
+		AvgPrice        string `json:"ap"`
+		ActivationPrice string `json:"AP"`
+		ExecutionType   string `json:"x"`
+		Status          string `json:"X"`
+		OrderID         int64  `json:"i"`
+		FilledQty       string `json:"z"`
+		LastQty         string `json:"l"`
+		LastPrice       string `json:"L"`
+		TradeID         int64  `json:"t"`
+		TradeTime       int64  `json:"T"`
+		Commission      string `json:"n"`
+		CommissionAsset string `json:"N"`
+	} `json:"o"`
+}
@@ -0,0 +1,317 @@
+package binance
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cploutarchou/crypto-sdk-suite/binance/client"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	SpotStreamURL           = "wss://stream.binance.com:9443"
+	SpotTestnetStreamURL    = "wss://stream.testnet.binance.vision"
+	FuturesStreamURL        = "wss://fstream.binance.com"
+	FuturesTestnetStreamURL = "wss://stream.binancefuture.com"
+
+	reconnectDelay = 5 * time.Second
+	// Listen keys expire 60 minutes after they were created or last kept alive.
+	listenKeyKeepAlive = 30 * time.Minute
+)
+
+// Stream is a connection to Binance's combined stream endpoint. Every registered stream is
+// resubscribed after a reconnect, and the user data stream gets a listen key that is kept alive
+// while the stream is connected and replaced when it expires.
+type Stream struct {
+	url           string
+	client        *client.Client
+	listenKeyPath string
+	spot          bool
+
+	mu        sync.Mutex
+	conn      *websocket.Conn
+	handlers  map[string]func(json.RawMessage)
+	user      func(event string, data json.RawMessage)
+	listenKey string
+	connected bool
+	restart   bool // the connection was closed on purpose to reconnect
+	nextID    int
+
+	// OnError is called with connection and decoding errors. Errors are logged when it is nil.
+	OnError func(err error)
+
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func newStream(url string, c *client.Client, listenKeyPath string, spot bool) *Stream {
+	return &Stream{
+		url:           url,
+		client:        c,
+		listenKeyPath: listenKeyPath,
+		spot:          spot,
+		handlers:      make(map[string]func(json.RawMessage)),
+		closed:        make(chan struct{}),
+	}
+}
+
+// Connect opens the connection with every stream registered so far.
+func (s *Stream) Connect() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.connected {
+		return nil
+	}
+	if err := s.open(); err != nil {
+		return fmt.Errorf("failed to connect stream: %w", err)
+	}
+	s.connected = true
+	go s.run()
+	go s.keepAlive()
+	return nil
+}
+
+// open dials the combined stream endpoint with every registered stream in the URL, creating a
+// listen key first when the user data stream is subscribed. Callers must hold s.mu.
+func (s *Stream) open() error {
+	names := make([]string, 0, len(s.handlers)+1)
+	for name := range s.handlers {
+		names = append(names, name)
+	}
+	s.listenKey = ""
+	if s.user != nil {
+		key, err := s.createListenKey()
+		if err != nil {
+			return fmt.Errorf("failed to create listen key: %w", err)
+		}
+		s.listenKey = key
+		names = append(names, key)
+	}
+	target := s.url + "/stream"
+	if len(names) > 0 {
+		target += "?streams=" + strings.Join(names, "/")
+	}
+	conn, _, err := websocket.DefaultDialer.Dial(target, nil)
+	if err != nil {
+		return err
+	}
+	s.conn = conn
+	return nil
+}
+
+// run reads messages until the stream is closed, reconnecting on read errors.
+func (s *Stream) run() {
+	for {
+		s.mu.Lock()
+		conn := s.conn
+		s.mu.Unlock()
+		if conn == nil {
+			select {
+			case <-s.closed:
+				return
+			case <-time.After(reconnectDelay):
+			}
+			s.reconnect()
+			continue
+		}
+
+		_, raw, err := conn.ReadMessage()
+		if err == nil {
+			s.dispatch(raw)
+			continue
+		}
+		conn.Close()
+		select {
+		case <-s.closed:
+			return
+		default:
+		}
+		s.mu.Lock()
+		s.conn = nil
+		restart := s.restart
+		s.restart = false
+		s.mu.Unlock()
+		if restart {
+			s.reconnect()
+			continue
+		}
+		s.reportError(fmt.Errorf("read failed: %w", err))
+	}
+}
+
+func (s *Stream) reconnect() {
+	s.mu.Lock()
+	select {
+	case <-s.closed:
+		s.mu.Unlock()
+		return
+	default:
+	}
+	err := s.open()
+	s.mu.Unlock()
+	if err != nil {
+		s.reportError(fmt.Errorf("reconnect failed: %w", err))
+	}
+}
+
+// reopen closes the connection so run reconnects at once, e.g. to add a new listen key to the
+// URL. Callers must hold s.mu.
+func (s *Stream) reopen() {
+	if s.conn == nil {
+		return
+	}
+	s.restart = true
+	s.conn.Close()
+}
+
+func (s *Stream) dispatch(raw []byte) {
+	var msg combinedEvent
+	if err := json.Unmarshal(raw, &msg); err != nil {
+		s.reportError(fmt.Errorf("failed to unmarshal message: %w", err))
+		return
+	}
+	if msg.Stream == "" {
+		// Replies to SUBSCRIBE and UNSUBSCRIBE carry no stream.
+		return
+	}
+
+	s.mu.Lock()
+	handler := s.handlers[msg.Stream]
+	user := s.user
+	isUser := msg.Stream == s.listenKey
+	s.mu.Unlock()
+	switch {
+	case isUser:
+		s.dispatchUser(user, msg.Data)
+	case handler != nil:
+		handler(msg.Data)
+	}
+}
+
+func (s *Stream) dispatchUser(handler func(string, json.RawMessage), data json.RawMessage) {
+	var header userEvent
+	if err := json.Unmarshal(data, &header); err != nil {
+		s.reportError(fmt.Errorf("failed to decode user data event: %w", err))
+		return
+	}
+	if header.Event == "listenKeyExpired" {
+		s.mu.Lock()
+		s.reopen()
+		s.mu.Unlock()
+		return
+	}
+	handler(header.Event, data)
+}
+
+func (s *Stream) reportError(err error) {
+	if s.OnError != nil {
+		s.OnError(err)
+		return
+	}
+	log.Printf("Stream error: %v", err)
+}
+
+// Subscribe registers a handler for a raw stream name, e.g. "btcusdt@depth20@100ms". The
+// handler receives the event payload.
+func (s *Stream) Subscribe(name string, handler func(json.RawMessage)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[name] = handler
+	if s.conn == nil || s.restart {
+		// The stream is added to the URL when the connection is (re)opened.
+		return nil
+	}
+	return s.send("SUBSCRIBE", name)
+}
+
+// Unsubscribe removes the handler for a stream name.
+func (s *Stream) Unsubscribe(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.handlers[name]; !ok {
+		return fmt.Errorf("not subscribed to stream %s", name)
+	}
+	delete(s.handlers, name)
+	if s.conn == nil || s.restart {
+		return nil
+	}
+	return s.send("UNSUBSCRIBE", name)
+}
+
+// SubscribeUserData registers the handler for user data stream events, e.g. executionReport on
+// spot or ORDER_TRADE_UPDATE on futures. It replaces the handler of a previous call. The stream
+// requires an API key.
+func (s *Stream) SubscribeUserData(handler func(event string, data json.RawMessage)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	first := s.user == nil
+	s.user = handler
+	if first {
+		// The listen key is part of the connection URL.
+		s.reopen()
+	}
+	return nil
+}
+
+// send writes a live subscription change. Callers must hold s.mu.
+func (s *Stream) send(method string, names ...string) error {
+	s.nextID++
+	msg := map[string]any{"method": method, "params": names, "id": s.nextID}
+	if err := s.conn.WriteJSON(msg); err != nil {
+		return fmt.Errorf("failed to %s: %w", strings.ToLower(method), err)
+	}
+	return nil
+}
+
+func (s *Stream) createListenKey() (string, error) {
+	var res listenKey
+	err := s.client.Do(&client.Request{Method: http.MethodPost, Path: s.listenKeyPath, Security: client.APIKey}, &res)
+	return res.ListenKey, err
+}
+
+// keepAlive extends the listen key until the stream is closed.
+func (s *Stream) keepAlive() {
+	ticker := time.NewTicker(listenKeyKeepAlive)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.closed:
+			return
+		case <-ticker.C:
+		}
+		s.mu.Lock()
+		key := s.listenKey
+		s.mu.Unlock()
+		if key == "" {
+			continue
+		}
+		params := client.Params{}
+		if s.spot {
+			params["listenKey"] = key
+		}
+		err := s.client.Do(&client.Request{Method: http.MethodPut, Path: s.listenKeyPath, Params: params, Security: client.APIKey}, nil)
+		if err != nil {
+			s.reportError(fmt.Errorf("failed to keep listen key alive: %w", err))
+		}
+	}
+}
+
+// Close closes the connection and stops the read loop.
+func (s *Stream) Close() error {
+	s.closeOnce.Do(func() {
+		close(s.closed)
+	})
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	return err
+}
@@ -12,6 +12,8 @@ type Account interface {
 	Info() *Info
 	TransactionLog() *TransactionLog
 	Margin() *Margin
+	DemoFunds() *DemoFunds
+	DCPInfo() *DCPInfo
 }
 
 type account struct {
@@ -51,6 +53,12 @@ func (a *account) TransactionLog() *TransactionLog {
 func (a *account) Margin() *Margin {
 	return NewMargin(a.client)
 }
+func (a *account) DemoFunds() *DemoFunds {
+	return NewDemoFunds(a.client)
+}
+func (a *account) DCPInfo() *DCPInfo {
+	return NewDCPInfo(a.client)
+}
 func New(client_ *client.Client) Account {
 	return &account{client: client_}
 }
@@ -7,6 +7,9 @@ import (
 	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
 )
 
+// MaxBorrowHistoryLimit is the largest page size accepted by the borrow history endpoint.
+const MaxBorrowHistoryLimit = 50
+
 type Borrow struct {
 	client *client.Client
 }
@@ -43,9 +46,36 @@ func (b *Borrow) GetHistory(currency string, startTime, endTime, limit int, curs
 	if err != nil {
 		return nil, err
 	}
+	if borrowRes.RetCode != 0 {
+		return &borrowRes, fmt.Errorf("API returned error: %s", borrowRes.RetMsg)
+	}
 
 	return &borrowRes, nil
 }
+
+// GetAllHistory follows nextPageCursor until every borrow record between startTime and endTime
+// has been fetched, and returns them merged into a single response. Zero values leave the
+// currency and the time range to the API defaults.
+func (b *Borrow) GetAllHistory(currency string, startTime, endTime int) (*BorrowRes, error) {
+	var merged *BorrowRes
+	cursor := ""
+	for {
+		page, err := b.GetHistory(currency, startTime, endTime, MaxBorrowHistoryLimit, cursor)
+		if err != nil {
+			return page, err
+		}
+		if merged == nil {
+			merged = page
+		} else {
+			merged.Result.List = append(merged.Result.List, page.Result.List...)
+			merged.Time = page.Time
+		}
+		cursor = page.Result.NextPageCursor
+		if cursor == "" {
+			return merged, nil
+		}
+	}
+}
 func NewBorrow(client_ *client.Client) *Borrow {
 	if client_ == nil {
 		panic("client should not be nil")
@@ -1,6 +1,10 @@
 package account
 
-import "github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+import (
+	"fmt"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+)
 
 const twoHundred = 200
 
@@ -12,6 +16,8 @@ func NewCoinGreeks(client_ *client.Client) *CoinGreeks {
 	return &CoinGreeks{client: client_}
 }
 
+// Get returns the total delta, gamma, vega and theta of the options portfolio per base coin.
+// All base coins are returned when coin is empty.
 func (cg *CoinGreeks) Get(coin string) (*CoinGreekRes, error) {
 	params := client.Params{}
 
@@ -26,13 +32,16 @@ func (cg *CoinGreeks) Get(coin string) (*CoinGreekRes, error) {
 	}
 
 	if response.StatusCode() != twoHundred {
-		return nil, response.Error()
+		return nil, fmt.Errorf("unexpected status: %d, body: %s", response.StatusCode(), response.Status())
 	}
 	var coinGreekRes CoinGreekRes
 	err = response.Unmarshal(&coinGreekRes)
 	if err != nil {
 		return nil, err
 	}
+	if coinGreekRes.RetCode != 0 {
+		return &coinGreekRes, fmt.Errorf("API returned error: %s", coinGreekRes.RetMsg)
+	}
 
 	return &coinGreekRes, nil
 }
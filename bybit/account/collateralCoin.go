@@ -15,18 +15,14 @@ func NewSetCollateralCoin(c *client.Client) *CollateralCoin {
 	return &CollateralCoin{client: c}
 }
 
+// Set turns a coin on or off as margin collateral of the unified account.
 func (s *CollateralCoin) Set(coin string, collateralSwitch CollateralSwitch) (*CollateralInfoResponse, error) {
-	if coin == "USDT" || coin == "USDC" {
-		return nil, errors.New("USDT and USDC cannot be switched off")
+	if err := validateCollateralSwitch(coin, collateralSwitch); err != nil {
+		return nil, err
 	}
 	params := client.Params{
-		"coin": coin,
-	}
-	switch collateralSwitch {
-	case ON:
-		params["collateralSwitch"] = "ON"
-	case OFF:
-		params["collateralSwitch"] = "OFF"
+		"coin":             coin,
+		"collateralSwitch": string(collateralSwitch),
 	}
 
 	response, err := s.client.Post(Endpoints.Collateral, params)
@@ -44,16 +40,72 @@ func (s *CollateralCoin) Set(coin string, collateralSwitch CollateralSwitch) (*C
 	if err != nil {
 		return nil, err
 	}
+	if resp.RetCode != 0 {
+		return &resp, fmt.Errorf("API error: %s", resp.RetMsg)
+	}
+	return &resp, nil
+}
+
+// SetBatch switches several coins on or off as collateral in one request.
+func (s *CollateralCoin) SetBatch(switches map[string]CollateralSwitch) (*BatchCollateralResponse, error) {
+	if len(switches) == 0 {
+		return nil, errors.New("at least one coin is required")
+	}
+	request := make([]map[string]string, 0, len(switches))
+	for coin, collateralSwitch := range switches {
+		if err := validateCollateralSwitch(coin, collateralSwitch); err != nil {
+			return nil, err
+		}
+		request = append(request, map[string]string{
+			"coin":             coin,
+			"collateralSwitch": string(collateralSwitch),
+		})
+	}
+
+	response, err := s.client.Post(Endpoints.CollateralBatch, client.Params{"request": request})
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode() != 200 {
+		return nil, fmt.Errorf("HTTP error: %s", response.Status())
+	}
+
+	var resp BatchCollateralResponse
+	err = response.Unmarshal(&resp)
+	if err != nil {
+		return nil, err
+	}
+	if resp.RetCode != 0 {
+		return &resp, fmt.Errorf("API error: %s", resp.RetMsg)
+	}
 	return &resp, nil
 }
 
+func validateCollateralSwitch(coin string, collateralSwitch CollateralSwitch) error {
+	if coin == "" {
+		return errors.New("coin is required")
+	}
+	switch collateralSwitch {
+	case ON:
+	case OFF:
+		if coin == "USDT" || coin == "USDC" {
+			return errors.New("USDT and USDC cannot be switched off")
+		}
+	default:
+		return fmt.Errorf("invalid collateral switch %q", collateralSwitch)
+	}
+	return nil
+}
+
+// GetInfo returns the collateral information of a coin, or of every coin when currency is empty.
 func (s *CollateralCoin) GetInfo(currency string) (*CollateralInfoResponse, error) {
 	params := client.Params{}
 	if currency != "" {
 		params["currency"] = currency
 	}
 
-	response, err := s.client.Get("/v5/account/collateral-info", params)
+	response, err := s.client.Get(Endpoints.CollateralInfo, params)
 
 	if err != nil {
 		return nil, err
@@ -0,0 +1,33 @@
+package account
+
+import (
+	"fmt"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+)
+
+type DCPInfo struct {
+	client *client.Client
+}
+
+func NewDCPInfo(client *client.Client) *DCPInfo {
+	return &DCPInfo{client: client}
+}
+
+// Get queries the disconnection protection (DCP) configuration for each product. A product
+// only appears once DCP has been armed for it with trade.SetDisconnectCancelAll.
+func (d *DCPInfo) Get() ([]DCPSetting, error) {
+	resp, err := d.client.Get(Endpoints.DCPInfo, client.Params{})
+	if err != nil {
+		return nil, fmt.Errorf("error fetching DCP info: %w", err)
+	}
+
+	var dcpResponse DCPInfoResponse
+	if err := resp.Unmarshal(&dcpResponse); err != nil {
+		return nil, fmt.Errorf("error parsing DCP info response: %w", err)
+	}
+	if dcpResponse.RetCode != 0 {
+		return nil, fmt.Errorf("API returned error: %s", dcpResponse.RetMsg)
+	}
+	return dcpResponse.Result.DcpInfos, nil
+}
@@ -0,0 +1,85 @@
+package account
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+)
+
+// Values of the adjustType parameter of the demo funds endpoint.
+const (
+	DemoFundsAdd    = 0
+	DemoFundsReduce = 1
+)
+
+// MaxDemoFunds is the largest amount of each coin that can be requested at once.
+var MaxDemoFunds = map[string]string{
+	"BTC":  "15",
+	"ETH":  "200",
+	"USDT": "100000",
+	"USDC": "100000",
+}
+
+// ErrNotDemoClient is returned when demo funds are requested with a client that does not target
+// the demo trading environment.
+var ErrNotDemoClient = errors.New("demo funds can only be requested with a demo trading client")
+
+type DemoFunds struct {
+	client *client.Client
+}
+
+func NewDemoFunds(client_ *client.Client) *DemoFunds {
+	return &DemoFunds{client: client_}
+}
+
+// Add credits virtual funds to the demo trading account, e.g. {"USDT": "100000"}, so integration
+// tests can top themselves up. See MaxDemoFunds for the per-request limits.
+func (d *DemoFunds) Add(amounts map[string]string) (*DemoFundsResponse, error) {
+	return d.adjust(DemoFundsAdd, amounts)
+}
+
+// Reduce removes virtual funds from the demo trading account.
+func (d *DemoFunds) Reduce(amounts map[string]string) (*DemoFundsResponse, error) {
+	return d.adjust(DemoFundsReduce, amounts)
+}
+
+func (d *DemoFunds) adjust(adjustType int, amounts map[string]string) (*DemoFundsResponse, error) {
+	if !d.client.IsDemo {
+		return nil, ErrNotDemoClient
+	}
+	if len(amounts) == 0 {
+		return nil, errors.New("at least one coin amount is required")
+	}
+
+	coins := make([]string, 0, len(amounts))
+	for coin := range amounts {
+		if _, ok := MaxDemoFunds[coin]; !ok {
+			return nil, fmt.Errorf("demo funds are not available for %s", coin)
+		}
+		coins = append(coins, coin)
+	}
+	sort.Strings(coins)
+	list := make([]map[string]string, 0, len(coins))
+	for _, coin := range coins {
+		list = append(list, map[string]string{"coin": coin, "amountStr": amounts[coin]})
+	}
+	params := client.Params{
+		"adjustType":        adjustType,
+		"utaDemoApplyMoney": list,
+	}
+
+	response, err := d.client.Post(Endpoints.DemoApplyMoney, params)
+	if err != nil {
+		return nil, err
+	}
+	var demoRes DemoFundsResponse
+	if err := response.Unmarshal(&demoRes); err != nil {
+		return nil, err
+	}
+	if demoRes.RetCode != 0 {
+		return &demoRes, fmt.Errorf("API returned error: %s", demoRes.RetMsg)
+	}
+	return &demoRes, nil
+}
@@ -0,0 +1,16 @@
+package account
+
+import (
+	"testing"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDemoFundsRequiresDemoClient(t *testing.T) {
+	_, err := NewDemoFunds(client.NewClient("key", "secret", true)).Add(map[string]string{"USDT": "1000"})
+	assert.ErrorIs(t, err, ErrNotDemoClient)
+
+	_, err = NewDemoFunds(client.NewDemoClient("key", "secret")).Add(map[string]string{"DOGE": "1000"})
+	assert.EqualError(t, err, "demo funds are not available for DOGE")
+}
@@ -19,19 +19,33 @@ const (
 )
 
 type EndpointsStruct struct {
-	Borrow           string
-	CoinGreek        string
-	Collateral       string
-	UpgradeToUnified string
-	Wallet           string
+	Borrow                 string
+	CoinGreek              string
+	Collateral             string
+	CollateralBatch        string
+	CollateralInfo         string
+	Info                   string
+	UpgradeToUnified       string
+	Wallet                 string
+	TransactionLog         string
+	ContractTransactionLog string
+	DemoApplyMoney         string
+	DCPInfo                string
 }
 
 var Endpoints = EndpointsStruct{
-	Borrow:           "/v5/account/borrow-history",
-	CoinGreek:        "/v5/asset/coin-greeks",
-	Collateral:       "/v5/account/set-collateral-switch",
-	UpgradeToUnified: "/v5/account/upgrade-to-uta",
-	Wallet:           "/v5/account/wallet-balance",
+	Borrow:                 "/v5/account/borrow-history",
+	CoinGreek:              "/v5/asset/coin-greeks",
+	Collateral:             "/v5/account/set-collateral-switch",
+	CollateralBatch:        "/v5/account/set-collateral-switch-batch",
+	CollateralInfo:         "/v5/account/collateral-info",
+	Info:                   "/v5/account/info",
+	UpgradeToUnified:       "/v5/account/upgrade-to-uta",
+	Wallet:                 "/v5/account/wallet-balance",
+	TransactionLog:         "/v5/account/transaction-log",
+	ContractTransactionLog: "/v5/account/contract-transaction-log",
+	DemoApplyMoney:         "/v5/account/demo-apply-money",
+	DCPInfo:                "/v5/account/query-dcp-info",
 }
 
 func (a AccountCategory) String() string {
@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+	"github.com/cploutarchou/crypto-sdk-suite/decimal"
 )
 
 const FeeRatesEndpoint = "/v5/account/fee-rate"
@@ -17,7 +18,14 @@ func NewFeeRates(client_ *client.Client) *FeeRates {
 	return &FeeRates{client: client_}
 }
 
+// GetFeeRate returns the account's maker and taker fee rates for a category. symbol narrows the
+// result for spot and derivatives, baseCoin for options.
 func (fr *FeeRates) GetFeeRate(category string, symbol, baseCoin string) (*FeeRatesResponse, error) {
+	switch category {
+	case "spot", "linear", "inverse", "option":
+	default:
+		return nil, fmt.Errorf("invalid category: %q", category)
+	}
 	// Construct parameters
 	params := client.Params{
 		"category": category,
@@ -45,6 +53,29 @@ func (fr *FeeRates) GetFeeRate(category string, symbol, baseCoin string) (*FeeRa
 	if err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
+	if feeRatesResponse.RetCode != 0 {
+		return &feeRatesResponse, fmt.Errorf("API returned error: %s", feeRatesResponse.RetMsg)
+	}
 
 	return &feeRatesResponse, nil
 }
+
+// ForSymbol returns the fee rate of symbol from the response.
+func (r *FeeRatesResponse) ForSymbol(symbol string) (FeeRate, bool) {
+	for _, rate := range r.Result.List {
+		if rate.Symbol == symbol {
+			return rate, true
+		}
+	}
+	return FeeRate{}, false
+}
+
+// Maker returns the maker fee rate as a decimal, e.g. 0.0001 for 0.01%.
+func (f FeeRate) Maker() (decimal.Decimal, error) {
+	return decimal.NewFromString(f.MakerFeeRate)
+}
+
+// Taker returns the taker fee rate as a decimal.
+func (f FeeRate) Taker() (decimal.Decimal, error) {
+	return decimal.NewFromString(f.TakerFeeRate)
+}
@@ -2,6 +2,7 @@ package account
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
 
 	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
@@ -15,11 +16,10 @@ func NewInfo(client *client.Client) *Info {
 	return &Info{client: client}
 }
 
-// Get queries the margin mode configuration of the account.
+// Get queries the margin mode configuration of the account, its unified margin status and
+// its disconnection protection (DCP) settings.
 func (info *Info) Get() (*AccInfo, error) {
-	path := "/v5/account/info"
-	resp, err := info.client.Get(path, nil) // Assuming the Get method is as per your client package.
-
+	resp, err := info.client.Get(Endpoints.Info, client.Params{})
 	if err != nil {
 		return nil, err
 	}
@@ -28,10 +28,13 @@ func (info *Info) Get() (*AccInfo, error) {
 		return nil, errors.New("failed to get account info: non-200 status code received")
 	}
 
-	var accountInfo AccInfo
+	var accountInfo AccInfoResponse
 	err = resp.Unmarshal(&accountInfo)
 	if err != nil {
 		return nil, err
-	} // Assuming the Unmarshal method is as per your client package.
-	return &accountInfo, nil
+	}
+	if accountInfo.RetCode != 0 {
+		return nil, fmt.Errorf("API returned error: %s", accountInfo.RetMsg)
+	}
+	return &accountInfo.Result, nil
 }
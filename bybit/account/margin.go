@@ -49,14 +49,19 @@ func (m *Margin) SetMarginMode(mode string) (*SetMarginModeResponse, error) {
 	return &setMarginModeResponse, nil
 }
 
-// SetMMP sets the Market Maker Protection for the client.
+// SetMMP configures Market Maker Protection for an options base coin. Orders placed with the
+// mmp flag are cancelled and quoting is frozen once the quantity or delta limit is hit within
+// the window.
 func (m *Margin) SetMMP(params *MMPParams) (*MMPResponse, error) {
+	if params.BaseCoin == "" || params.Window <= 0 || params.QtyLimit == "" || params.DeltaLimit == "" {
+		return nil, fmt.Errorf("baseCoin, window, qtyLimit and deltaLimit are required")
+	}
 	response, err := m.client.Post(setMMPPath, client.Params{
 		"baseCoin":     params.BaseCoin,
 		"window":       strconv.Itoa(params.Window),
 		"frozenPeriod": strconv.Itoa(params.FrozenPeriod),
-		"qtyLimit":     strconv.Itoa(params.QtyLimit),
-		"deltaLimit":   strconv.Itoa(params.DeltaLimit),
+		"qtyLimit":     params.QtyLimit,
+		"deltaLimit":   params.DeltaLimit,
 	})
 	if err != nil {
 		return nil, err
@@ -79,7 +84,11 @@ func (m *Margin) SetMMP(params *MMPParams) (*MMPResponse, error) {
 	return &mmpResponse, nil
 }
 
+// ResetMMP unfreezes quoting for a base coin after MMP has been triggered.
 func (m *Margin) ResetMMP(baseCoin string) (*MMPResponse, error) {
+	if baseCoin == "" {
+		return nil, fmt.Errorf("baseCoin is required")
+	}
 	params := client.Params{
 		"baseCoin": baseCoin,
 	}
@@ -105,7 +114,11 @@ func (m *Margin) ResetMMP(baseCoin string) (*MMPResponse, error) {
 	return &mmpResponse, nil
 }
 
+// GetMMPState returns the MMP configuration of a base coin and whether it is currently frozen.
 func (m *Margin) GetMMPState(baseCoin string) (*MMPStateResponse, error) {
+	if baseCoin == "" {
+		return nil, fmt.Errorf("baseCoin is required")
+	}
 	params := client.Params{
 		"baseCoin": baseCoin,
 	}
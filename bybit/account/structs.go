@@ -26,6 +26,8 @@ type CoinDetails struct {
 	Locked              string `json:"locked"`
 	MarginCollateral    bool   `json:"marginCollateral"`
 	Coin                string `json:"coin"`
+	Free                string `json:"free"`           // Spot account only
+	SpotHedgingQty      string `json:"spotHedgingQty"` // Unified account only
 }
 
 type AccDetails struct {
@@ -51,13 +53,42 @@ type AccInfo struct {
 	TimeWindow          int    `json:"timeWindow"`
 	SmpGroup            int    `json:"smpGroup"`
 	IsMasterTrader      bool   `json:"isMasterTrader"`
+	SpotHedgingStatus   string `json:"spotHedgingStatus"`
 	UpdatedTime         string `json:"updatedTime"`
 }
+
+// DCPSetting is the disconnection protection configuration of one product.
+type DCPSetting struct {
+	Product    string `json:"product"`    // SPOT, DERIVATIVES or OPTIONS
+	DcpStatus  string `json:"dcpStatus"`  // ON or OFF
+	TimeWindow string `json:"timeWindow"` // seconds
+}
+
+// DCPInfoResponse represents the response from the /v5/account/query-dcp-info endpoint.
+type DCPInfoResponse struct {
+	BaseResponse
+	Result struct {
+		DcpInfos []DCPSetting `json:"dcpInfos"`
+	} `json:"result"`
+}
+
+// Status returns the unified margin status as a typed value.
+func (a AccInfo) Status() UnifiedMarginStatus {
+	return UnifiedMarginStatus(a.UnifiedMarginStatus)
+}
+
+// AccInfoResponse wraps AccInfo in the common response envelope.
+type AccInfoResponse struct {
+	BaseResponse
+	Result AccInfo `json:"result"`
+}
+
+// WalletBalance represents the response from the /v5/account/wallet-balance endpoint.
 type WalletBalance struct {
 	BaseResponse
 	Result struct {
 		List []AccDetails `json:"list"`
-	}
+	} `json:"result"`
 }
 
 type BorrowItem struct {
@@ -74,7 +105,7 @@ type BorrowRes struct {
 	Result struct {
 		NextPageCursor string       `json:"nextPageCursor"`
 		List           []BorrowItem `json:"list"`
-	}
+	} `json:"result"`
 }
 
 type CoinGreekItem struct {
@@ -89,7 +120,7 @@ type CoinGreekRes struct {
 	BaseResponse
 	Result struct {
 		List []CoinGreekItem `json:"list"`
-	}
+	} `json:"result"`
 }
 
 type UnifiedUpdateMsg struct {
@@ -101,7 +132,7 @@ type UpgradeToUnifiedResponse struct {
 	Result struct {
 		UnifiedUpdateStatus string           `json:"unifiedUpdateStatus"`
 		UnifiedUpdateMsg    UnifiedUpdateMsg `json:"unifiedUpdateMsg"`
-	}
+	} `json:"result"`
 }
 
 type CollateralData struct {
@@ -120,7 +151,18 @@ type CollateralData struct {
 
 type CollateralInfoResponse struct {
 	BaseResponse
-	Result CollateralResult
+	Result CollateralResult `json:"result"`
+}
+
+// BatchCollateralResponse represents the response from the batch set-collateral-switch endpoint.
+type BatchCollateralResponse struct {
+	BaseResponse
+	Result struct {
+		List []struct {
+			Coin             string `json:"coin"`
+			CollateralSwitch string `json:"collateralSwitch"`
+		} `json:"list"`
+	} `json:"result"`
 }
 
 type CollateralResult struct {
@@ -129,6 +171,7 @@ type CollateralResult struct {
 
 type FeeRate struct {
 	Symbol       string `json:"symbol"`
+	BaseCoin     string `json:"baseCoin"` // Option only
 	TakerFeeRate string `json:"takerFeeRate"`
 	MakerFeeRate string `json:"makerFeeRate"`
 }
@@ -136,8 +179,8 @@ type FeeRate struct {
 type FeeRatesResponse struct {
 	BaseResponse
 	Result struct {
-		List []FeeRate
-	}
+		List []FeeRate `json:"list"`
+	} `json:"result"`
 }
 
 type SetMarginModeResponse struct {
@@ -157,11 +200,11 @@ type MMPResponse struct {
 
 // MMPParams represents the parameters needed to set Market Maker Protection.
 type MMPParams struct {
-	BaseCoin     string `json:"baseCoin"`
-	Window       int    `json:"window"`       // Assuming Window is a number representing milliseconds
-	FrozenPeriod int    `json:"frozenPeriod"` // Assuming FrozenPeriod is a number representing milliseconds
-	QtyLimit     int    `json:"qtyLimit"`     // Assuming QtyLimit is an integer representing quantity
-	DeltaLimit   int    `json:"deltaLimit"`   // Assuming DeltaLimit is an integer representing delta
+	BaseCoin     string `json:"baseCoin"`     // Required: Options base coin, e.g. BTC
+	Window       int    `json:"window"`       // Required: Time window in milliseconds
+	FrozenPeriod int    `json:"frozenPeriod"` // Required: Frozen period in milliseconds, 0 keeps MMP frozen until reset
+	QtyLimit     string `json:"qtyLimit"`     // Required: Trade quantity limit, up to two decimal places
+	DeltaLimit   string `json:"deltaLimit"`   // Required: Delta limit, up to two decimal places
 }
 
 type MMPStateItem struct {
@@ -179,5 +222,11 @@ type MMPStateResponse struct {
 	BaseResponse
 	Result struct {
 		List []MMPStateItem `json:"result"`
-	}
+	} `json:"result"`
+}
+
+// DemoFundsResponse represents the response from the /v5/account/demo-apply-money endpoint.
+type DemoFundsResponse struct {
+	BaseResponse
+	Result any `json:"result"`
 }
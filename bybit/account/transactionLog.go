@@ -2,12 +2,42 @@ package account
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
-	"net/url"
+	"strconv"
+	"time"
 
 	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
 )
 
+// TransactionLogWindow is the longest startTime/endTime range accepted in one transaction log query.
+const TransactionLogWindow = 7 * 24 * time.Hour
+
+// MaxTransactionLogLimit is the largest page size accepted by the transaction log endpoints.
+const MaxTransactionLogLimit = 50
+
+// TransactionType is the type of a transaction log entry.
+type TransactionType string
+
+const (
+	TransactionTransferIn      TransactionType = "TRANSFER_IN"
+	TransactionTransferOut     TransactionType = "TRANSFER_OUT"
+	TransactionTrade           TransactionType = "TRADE"
+	TransactionSettlement      TransactionType = "SETTLEMENT" // Funding fees of perpetual contracts
+	TransactionDelivery        TransactionType = "DELIVERY"
+	TransactionLiquidation     TransactionType = "LIQUIDATION"
+	TransactionBonus           TransactionType = "BONUS"
+	TransactionFeeRefund       TransactionType = "FEE_REFUND"
+	TransactionInterest        TransactionType = "INTEREST"
+	TransactionCurrencyBuy     TransactionType = "CURRENCY_BUY"
+	TransactionCurrencySell    TransactionType = "CURRENCY_SELL"
+	TransactionAutoDeduction   TransactionType = "AUTO_DEDUCTION"
+	TransactionSpotRepayBuy    TransactionType = "SPOT_REPAYMENT_BUY"
+	TransactionSpotRepaySell   TransactionType = "SPOT_REPAYMENT_SELL"
+	TransactionTokenSubscribe  TransactionType = "TOKENS_SUBSCRIPTION"
+	TransactionTokenRedemption TransactionType = "TOKENS_REDEMPTION"
+)
+
 // TransactionLog holds a client instance
 type TransactionLog struct {
 	client *client.Client
@@ -25,48 +55,139 @@ func NewTransactionLog(client_ *client.Client) *TransactionLog {
 
 // LogEntry represents a single log entry returned by the API
 type LogEntry struct {
-	ID              string `json:"id"`
-	Symbol          string `json:"symbol"`
-	Category        string `json:"category"`
-	Side            string `json:"side"`
-	TransactionTime string `json:"transactionTime"`
-	Type            string `json:"type"`
-	Qty             string `json:"qty"`
-	Size            string `json:"size"`
-	Currency        string `json:"currency"`
-	TradePrice      string `json:"tradePrice"`
-	Funding         string `json:"funding"`
-	Fee             string `json:"fee"`
-	CashFlow        string `json:"cashFlow"`
-	Change          string `json:"change"`
-	CashBalance     string `json:"cashBalance"`
-	FeeRate         string `json:"feeRate"`
-	BonusChange     string `json:"bonusChange"`
-	TradeID         string `json:"tradeId"`
-	OrderID         string `json:"orderId"`
-	OrderLinkID     string `json:"orderLinkId"`
+	ID              string          `json:"id"`
+	Symbol          string          `json:"symbol"`
+	Category        string          `json:"category"`
+	Side            string          `json:"side"`
+	TransactionTime string          `json:"transactionTime"`
+	Type            TransactionType `json:"type"`
+	Qty             string          `json:"qty"`
+	Size            string          `json:"size"`
+	Currency        string          `json:"currency"`
+	TradePrice      string          `json:"tradePrice"`
+	Funding         string          `json:"funding"`
+	Fee             string          `json:"fee"`
+	CashFlow        string          `json:"cashFlow"`
+	Change          string          `json:"change"`
+	CashBalance     string          `json:"cashBalance"`
+	FeeRate         string          `json:"feeRate"`
+	BonusChange     string          `json:"bonusChange"`
+	TradeID         string          `json:"tradeId"`
+	OrderID         string          `json:"orderId"`
+	OrderLinkID     string          `json:"orderLinkId"`
 }
 
-// LogResponse represents the response from the /v5/account/transaction-log endpoint
+// LogResponse represents the result of the /v5/account/transaction-log endpoint
 type LogResponse struct {
 	List           []LogEntry `json:"list"`
 	NextPageCursor string     `json:"nextPageCursor"`
 }
 
-// Get sends a GET request to the /v5/account/transaction-log endpoint to retrieve transaction logs.
+// TransactionLogResponse wraps LogResponse in the common response envelope.
+type TransactionLogResponse struct {
+	BaseResponse
+	Result LogResponse `json:"result"`
+}
+
+// TransactionLogRequest holds the query parameters of GetLogs and GetContractLogs. Zero values are omitted.
+type TransactionLogRequest struct {
+	AccountType AccountType     // Unified account only, e.g. UNIFIED
+	Category    string          // Optional: spot, linear or option
+	Currency    string          // Optional
+	BaseCoin    string          // Optional
+	Type        TransactionType // Optional
+	StartTime   int64           // Optional: ms
+	EndTime     int64           // Optional: ms
+	Limit       int             // Optional: page size, defaults to MaxTransactionLogLimit when paging
+	Cursor      string          // Optional: fetches only this page when set
+}
+
+func (r *TransactionLogRequest) params() client.Params {
+	params := client.Params{}
+	if r.AccountType != "" {
+		params["accountType"] = string(r.AccountType)
+	}
+	if r.Category != "" {
+		params["category"] = r.Category
+	}
+	if r.Currency != "" {
+		params["currency"] = r.Currency
+	}
+	if r.BaseCoin != "" {
+		params["baseCoin"] = r.BaseCoin
+	}
+	if r.Type != "" {
+		params["type"] = string(r.Type)
+	}
+	if r.StartTime > 0 {
+		params["startTime"] = strconv.FormatInt(r.StartTime, 10)
+	}
+	if r.EndTime > 0 {
+		params["endTime"] = strconv.FormatInt(r.EndTime, 10)
+	}
+	if r.Limit > 0 {
+		params["limit"] = strconv.Itoa(r.Limit)
+	}
+	if r.Cursor != "" {
+		params["cursor"] = r.Cursor
+	}
+	return params
+}
+
+// Get sends a GET request to the /v5/account/transaction-log endpoint to retrieve one page of transaction logs.
 func (tl *TransactionLog) Get(params map[string]string) (*LogResponse, error) {
-	endpoint := "/v5/account/transaction-log"
+	query := client.Params{}
+	for key, value := range params {
+		query[key] = value
+	}
+	return tl.getPage(Endpoints.TransactionLog, query)
+}
+
+// GetLogs retrieves the unified account transaction log. Unless a cursor is given, every page is
+// fetched and a range longer than TransactionLogWindow is queried in consecutive windows, newest first.
+func (tl *TransactionLog) GetLogs(req *TransactionLogRequest) (*LogResponse, error) {
+	return tl.getAll(Endpoints.TransactionLog, req)
+}
 
-	// Add the optional query parameters if provided
-	if len(params) > 0 {
-		queryParams := url.Values{}
-		for key, value := range params {
-			queryParams.Add(key, value)
+// GetContractLogs retrieves the transaction log of a classic contract account, paging like GetLogs.
+func (tl *TransactionLog) GetContractLogs(req *TransactionLogRequest) (*LogResponse, error) {
+	return tl.getAll(Endpoints.ContractTransactionLog, req)
+}
+
+func (tl *TransactionLog) getAll(endpoint string, req *TransactionLogRequest) (*LogResponse, error) {
+	if req.Cursor != "" {
+		return tl.getPage(endpoint, req.params())
+	}
+
+	page := *req
+	if page.Limit == 0 {
+		page.Limit = MaxTransactionLogLimit
+	}
+	windows := [][2]int64{{req.StartTime, req.EndTime}}
+	if req.StartTime > 0 && req.EndTime > 0 {
+		windows = timeWindows(req.StartTime, req.EndTime, TransactionLogWindow)
+	}
+
+	merged := &LogResponse{}
+	for _, window := range windows {
+		page.StartTime, page.EndTime, page.Cursor = window[0], window[1], ""
+		for {
+			logs, err := tl.getPage(endpoint, page.params())
+			if err != nil {
+				return nil, err
+			}
+			merged.List = append(merged.List, logs.List...)
+			if logs.NextPageCursor == "" {
+				break
+			}
+			page.Cursor = logs.NextPageCursor
 		}
-		endpoint = endpoint + "?" + queryParams.Encode()
 	}
+	return merged, nil
+}
 
-	resp, err := tl.client.Get(endpoint, nil)
+func (tl *TransactionLog) getPage(endpoint string, params client.Params) (*LogResponse, error) {
+	resp, err := tl.client.Get(endpoint, params)
 	if err != nil {
 		return nil, err
 	}
@@ -75,11 +196,31 @@ func (tl *TransactionLog) Get(params map[string]string) (*LogResponse, error) {
 		return nil, errors.New("failed to get transaction logs: non-200 status code received")
 	}
 
-	var logResponse LogResponse
+	var logResponse TransactionLogResponse
 	err = resp.Unmarshal(&logResponse)
 	if err != nil {
 		return nil, err
 	}
+	if logResponse.RetCode != 0 {
+		return nil, fmt.Errorf("API returned error: %s", logResponse.RetMsg)
+	}
 
-	return &logResponse, nil
+	return &logResponse.Result, nil
+}
+
+// timeWindows splits [start, end] into consecutive windows of at most size, newest first.
+func timeWindows(start, end int64, size time.Duration) [][2]int64 {
+	var windows [][2]int64
+	step := size.Milliseconds()
+	for windowEnd := end; windowEnd >= start; windowEnd -= step + 1 {
+		windowStart := windowEnd - step
+		if windowStart < start {
+			windowStart = start
+		}
+		windows = append(windows, [2]int64{windowStart, windowEnd})
+		if windowStart == start {
+			break
+		}
+	}
+	return windows
 }
@@ -0,0 +1,21 @@
+package account
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeWindows(t *testing.T) {
+	day := (24 * time.Hour).Milliseconds()
+
+	windows := timeWindows(0, 3*day, 7*24*time.Hour)
+	assert.Equal(t, [][2]int64{{0, 3 * day}}, windows)
+
+	windows = timeWindows(0, 10*day, 7*24*time.Hour)
+	assert.Equal(t, [][2]int64{{3 * day, 10 * day}, {0, 3*day - 1}}, windows)
+
+	windows = timeWindows(0, 15*day, 7*24*time.Hour)
+	assert.Equal(t, [][2]int64{{8 * day, 15 * day}, {day - 1, 8*day - 1}, {0, day - 2}}, windows)
+}
@@ -1,6 +1,33 @@
 package account
 
-import "github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+import (
+	"fmt"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+)
+
+// Values of UpgradeToUnifiedResponse.Result.UnifiedUpdateStatus.
+const (
+	UpgradeStatusFail    = "FAIL"
+	UpgradeStatusProcess = "PROCESS"
+	UpgradeStatusSuccess = "SUCCESS"
+)
+
+// UnifiedMarginStatus is the account status reported by /v5/account/info.
+type UnifiedMarginStatus int
+
+const (
+	ClassicAccount UnifiedMarginStatus = 1
+	UTA1           UnifiedMarginStatus = 3
+	UTA1Pro        UnifiedMarginStatus = 4
+	UTA2           UnifiedMarginStatus = 5
+	UTA2Pro        UnifiedMarginStatus = 6
+)
+
+// IsUnified reports whether the account has been upgraded to a unified trading account.
+func (s UnifiedMarginStatus) IsUnified() bool {
+	return s >= UTA1
+}
 
 type UpgradeToUnified struct {
 	client *client.Client
@@ -10,6 +37,8 @@ func NewUpgradeToUnifiedRequest(c *client.Client) *UpgradeToUnified {
 	return &UpgradeToUnified{c}
 }
 
+// Upgrade requests the upgrade of the account to a unified trading account. The upgrade runs
+// asynchronously when the result is UpgradeStatusProcess; poll Status until it completes.
 func (r *UpgradeToUnified) Upgrade() (*UpgradeToUnifiedResponse, error) {
 	var ret UpgradeToUnifiedResponse
 	res, err := r.client.Post(Endpoints.UpgradeToUnified, client.Params{})
@@ -20,5 +49,24 @@ func (r *UpgradeToUnified) Upgrade() (*UpgradeToUnifiedResponse, error) {
 	if err != nil {
 		return nil, err
 	}
+	if ret.RetCode != 0 {
+		return &ret, fmt.Errorf("API returned error: %s", ret.RetMsg)
+	}
 	return &ret, nil
 }
+
+// Status returns the current unified margin status of the account.
+func (r *UpgradeToUnified) Status() (UnifiedMarginStatus, error) {
+	var ret AccInfoResponse
+	res, err := r.client.Get(Endpoints.Info, client.Params{})
+	if err != nil {
+		return 0, err
+	}
+	if err := res.Unmarshal(&ret); err != nil {
+		return 0, err
+	}
+	if ret.RetCode != 0 {
+		return 0, fmt.Errorf("API returned error: %s", ret.RetMsg)
+	}
+	return UnifiedMarginStatus(ret.Result.UnifiedMarginStatus), nil
+}
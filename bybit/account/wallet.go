@@ -20,9 +20,16 @@ func NewWallet(client_ *client.Client) *Wallet {
 	}
 }
 
-func (w Wallet) GetUnifiedWalletBalance(coins ...string) (*WalletBalance, error) {
+// GetWalletBalance returns the balance of an account type, optionally limited to the given coins.
+// Every account in the result carries per-coin equity, wallet balance, available balance and PnL.
+func (w Wallet) GetWalletBalance(accountType AccountType, coins ...string) (*WalletBalance, error) {
+	switch accountType {
+	case Unified, Contract, Spot:
+	default:
+		return nil, fmt.Errorf("unsupported account type: %s", accountType)
+	}
 	params := client.Params{}
-	params["accountType"] = string(Unified)
+	params["accountType"] = string(accountType)
 
 	// Construct the coin parameter string
 	if len(coins) > 0 {
@@ -45,133 +52,37 @@ func (w Wallet) GetUnifiedWalletBalance(coins ...string) (*WalletBalance, error)
 	if err := resp.Unmarshal(&balanceResp); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
+	if balanceResp.RetCode != 0 {
+		return &balanceResp, fmt.Errorf("API returned error: %s", balanceResp.RetMsg)
+	}
 
 	return &balanceResp, nil
 }
 
+func (w Wallet) GetUnifiedWalletBalance(coins ...string) (*WalletBalance, error) {
+	return w.GetWalletBalance(Unified, coins...)
+}
+
 func joinCoins(coins []string) string {
-	coinStr := ""
-	for _, coin := range coins {
-		coinStr += coin + ","
-	}
-	return strings.TrimRight(coinStr, ",") // Remove trailing comma
+	return strings.Join(coins, ",")
 }
 
 func (w Wallet) GetAllUnifiedWalletBalance() (*WalletBalance, error) {
-	params := client.Params{}
-	params["accountType"] = string(Unified)
-
-	resp, err := w.Get(Endpoints.Wallet, params)
-	if err != nil {
-		return nil, err
-	}
-	if resp.StatusCode() != 200 {
-		return nil, fmt.Errorf("unexpected status: %d, body: %s", resp.StatusCode(), resp.Status())
-	}
-
-	var balanceResp WalletBalance
-	err = resp.Unmarshal(&balanceResp)
-	if err != nil {
-		return nil, err
-	}
-
-	return &balanceResp, nil
+	return w.GetWalletBalance(Unified)
 }
 
 func (w Wallet) GetAllSpotWalletBalance() (*WalletBalance, error) {
-	params := client.Params{}
-	params["accountType"] = string(Spot)
-
-	resp, err := w.Get(Endpoints.Wallet, params)
-	if err != nil {
-		return nil, err
-	}
-	if resp.StatusCode() != 200 {
-		return nil, fmt.Errorf("unexpected status: %d, body: %s", resp.StatusCode(), resp.Status())
-	}
-
-	var balanceResp WalletBalance
-	err = resp.Unmarshal(&balanceResp)
-	if err != nil {
-		return nil, err
-	}
-
-	return &balanceResp, nil
+	return w.GetWalletBalance(Spot)
 }
 
 func (w Wallet) GetSpotWalletBalance(coins ...string) (*WalletBalance, error) {
-	params := client.Params{}
-	params["accountType"] = string(Spot)
-	coinStr := ""
-	for _, coin := range coins {
-		coinStr += coin + ","
-	}
-	if coinStr != "" {
-		coinStr = coinStr[:len(coinStr)-1]
-		params["coin"] = coinStr
-	}
-	resp, err := w.Get(Endpoints.Wallet, params)
-	if err != nil {
-		return nil, err
-	}
-	if resp.StatusCode() != 200 {
-		return nil, fmt.Errorf("unexpected status: %d, body: %s", resp.StatusCode(), resp.Status())
-	}
-
-	var balanceResp WalletBalance
-	err = resp.Unmarshal(&balanceResp)
-	if err != nil {
-		return nil, err
-	}
-
-	return &balanceResp, nil
+	return w.GetWalletBalance(Spot, coins...)
 }
 
 func (w Wallet) GetAllContractWalletBalance() (*WalletBalance, error) {
-	params := client.Params{}
-	params["accountType"] = string(Contract)
-
-	resp, err := w.Get(Endpoints.Wallet, params)
-	if err != nil {
-		return nil, err
-	}
-	if resp.StatusCode() != 200 {
-		return nil, fmt.Errorf("unexpected status: %d, body: %s", resp.StatusCode(), resp.Status())
-	}
-
-	var balanceResp WalletBalance
-	err = resp.Unmarshal(&balanceResp)
-	if err != nil {
-		return nil, err
-	}
-
-	return &balanceResp, nil
+	return w.GetWalletBalance(Contract)
 }
 
 func (w Wallet) GetContractWalletBalance(coins ...string) (*WalletBalance, error) {
-	params := client.Params{}
-	params["accountType"] = string(Contract)
-	coinStr := ""
-	for _, coin := range coins {
-		coinStr += coin + ","
-	}
-	if coinStr != "" {
-		coinStr = coinStr[:len(coinStr)-1]
-		params["coin"] = coinStr
-	}
-	resp, err := w.Get(Endpoints.Wallet, params)
-	if err != nil {
-		return nil, err
-	}
-	if resp.StatusCode() != 200 {
-		return nil, fmt.Errorf("unexpected status: %d, body: %s", resp.StatusCode(), resp.Status())
-	}
-
-	var balanceResp WalletBalance
-	err = resp.Unmarshal(&balanceResp)
-	if err != nil {
-		return nil, err
-	}
-
-	return &balanceResp, nil
+	return w.GetWalletBalance(Contract, coins...)
 }
@@ -0,0 +1,66 @@
+package announcement
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+)
+
+// Announcements defines the interface for the platform announcement endpoint of the Bybit API.
+type Announcements interface {
+	// Get retrieves the platform announcements, newest first.
+	// req: GetRequest - the locale, type, tag and page of the query.
+	// returns: *Response - the matching announcements.
+	//          error - an error if the request fails.
+	Get(req *GetRequest) (*Response, error)
+}
+
+type impl struct {
+	client *client.Client
+}
+
+// New creates a new instance of the Announcements interface, which can be used to interact with the Bybit API.
+func New(c *client.Client) Announcements {
+	return &impl{client: c}
+}
+
+// Get retrieves the platform announcements. Filter on TypeDelistings or TypeMaintenanceUpdates to
+// react to delistings and maintenance windows.
+func (i *impl) Get(req *GetRequest) (*Response, error) {
+	params := client.Params{"locale": DefaultLocale}
+	if req.Locale != "" {
+		params["locale"] = req.Locale
+	}
+	if req.Type != nil {
+		params["type"] = *req.Type
+	}
+	if req.Tag != nil {
+		params["tag"] = *req.Tag
+	}
+	if req.Page != nil {
+		if *req.Page < 1 {
+			return nil, fmt.Errorf("page must be at least 1")
+		}
+		params["page"] = strconv.Itoa(*req.Page)
+	}
+	if req.Limit != nil {
+		if *req.Limit < 1 {
+			return nil, fmt.Errorf("limit must be at least 1")
+		}
+		params["limit"] = strconv.Itoa(*req.Limit)
+	}
+
+	response, err := i.client.Get("/v5/announcements/index", params)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching announcements: %w", err)
+	}
+	var announcementResponse Response
+	if err := response.Unmarshal(&announcementResponse); err != nil {
+		return nil, fmt.Errorf("error parsing announcements response: %w", err)
+	}
+	if announcementResponse.RetCode != 0 {
+		return &announcementResponse, fmt.Errorf("API returned error: %s", announcementResponse.RetMsg)
+	}
+	return &announcementResponse, nil
+}
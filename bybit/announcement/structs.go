@@ -0,0 +1,63 @@
+package announcement
+
+// DefaultLocale is the locale used when a request does not specify one.
+const DefaultLocale = "en-US"
+
+// Announcement types.
+const (
+	TypeNewCrypto          = "new_crypto"
+	TypeLatestBybitNews    = "latest_bybit_news"
+	TypeDelistings         = "delistings"
+	TypeLatestActivities   = "latest_activities"
+	TypeProductUpdates     = "product_updates"
+	TypeMaintenanceUpdates = "maintenance_updates"
+	TypeNewFiatListings    = "new_fiat_listings"
+	TypeOther              = "other"
+)
+
+// GetRequest represents the query parameters for the announcements.
+type GetRequest struct {
+	Locale string  `json:"locale"`          // Required: Language, e.g. en-US; DefaultLocale when empty
+	Type   *string `json:"type,omitempty"`  // Optional: Announcement type, e.g. TypeDelistings
+	Tag    *string `json:"tag,omitempty"`   // Optional: Announcement tag
+	Page   *int    `json:"page,omitempty"`  // Optional: Page number, starting from 1
+	Limit  *int    `json:"limit,omitempty"` // Optional: Page size, 20 by default
+}
+
+// Announcement is a single platform announcement.
+type Announcement struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Type        struct {
+		Title string `json:"title"`
+		Key   string `json:"key"`
+	} `json:"type"`
+	Tags               []string `json:"tags"`
+	URL                string   `json:"url"`
+	DateTimestamp      int64    `json:"dateTimestamp"`
+	StartDateTimestamp int64    `json:"startDateTimestamp"` // Start of the event or maintenance window, when relevant
+	EndDateTimestamp   int64    `json:"endDateTimestamp"`   // End of the event or maintenance window, when relevant
+	PublishTime        int64    `json:"publishTime"`
+}
+
+// HasTag reports whether the announcement carries the tag.
+func (a Announcement) HasTag(tag string) bool {
+	for _, t := range a.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// Response represents the response from querying the announcements.
+type Response struct {
+	RetCode int    `json:"retCode"`
+	RetMsg  string `json:"retMsg"`
+	Result  struct {
+		Total int            `json:"total"`
+		List  []Announcement `json:"list"`
+	} `json:"result"`
+	RetExtInfo any   `json:"retExtInfo"`
+	Time       int64 `json:"time"`
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
 )
@@ -26,6 +27,8 @@ type Asset interface {
 	GetSingleCoinBalance(req *GetSingleCoinBalanceRequest) (*GetSingleCoinBalanceResponse, error)
 	// GetTransferableCoin queries the list of transferable coins between account types.
 	GetTransferableCoin(req *GetTransferableCoinRequest) (*GetTransferableCoinResponse, error)
+	// GetTransferableCoins returns the coins that can be transferred between two account types.
+	GetTransferableCoins(fromAccountType, toAccountType string) ([]string, error)
 	CreateInternalTransfer(req *CreateInternalTransferRequest) (*CreateInternalTransferResponse, error)
 	GetInternalTransferRecords(req *GetInternalTransferRecordsRequest) (*GetInternalTransferRecordsResponse, error)
 	GetSubUIDs() (*GetSubUIDsResponse, error)
@@ -283,7 +286,15 @@ func (i *impl) GetSingleCoinBalance(req *GetSingleCoinBalanceRequest) (*GetSingl
 
 	return &coinBalanceResponse, nil
 }
+
+// GetTransferableCoin queries the list of coins that can be transferred between two account types.
 func (i *impl) GetTransferableCoin(req *GetTransferableCoinRequest) (*GetTransferableCoinResponse, error) {
+	if req.FromAccountType == "" || req.ToAccountType == "" {
+		return nil, errors.New("fromAccountType and toAccountType are required")
+	}
+	if req.FromAccountType == req.ToAccountType {
+		return nil, errors.New("fromAccountType and toAccountType must differ")
+	}
 	// Prepare query parameters
 	queryParams := make(client.Params)
 	queryParams["fromAccountType"] = req.FromAccountType
@@ -294,18 +305,29 @@ func (i *impl) GetTransferableCoin(req *GetTransferableCoinRequest) (*GetTransfe
 	if err != nil {
 		return nil, fmt.Errorf("error fetching transferable coin list: %w", err)
 	}
-	data, err := json.Marshal(response)
-	if err != nil {
-		return nil, err
-	}
 	var transferableCoinResponse GetTransferableCoinResponse
-	if err := json.Unmarshal(data, &transferableCoinResponse); err != nil {
+	if err := response.Unmarshal(&transferableCoinResponse); err != nil {
 		return nil, fmt.Errorf("error parsing transferable coin list response: %w", err)
 	}
+	if transferableCoinResponse.RetCode != 0 {
+		return &transferableCoinResponse, fmt.Errorf("API returned error: %s", transferableCoinResponse.RetMsg)
+	}
 
 	return &transferableCoinResponse, nil
 }
 
+// GetTransferableCoins returns the coins that can be transferred from fromAccountType to toAccountType.
+func (i *impl) GetTransferableCoins(fromAccountType, toAccountType string) ([]string, error) {
+	response, err := i.GetTransferableCoin(&GetTransferableCoinRequest{
+		FromAccountType: fromAccountType,
+		ToAccountType:   toAccountType,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.Result.List, nil
+}
+
 func (i *impl) GetAllCoinsBalance(req *GetAllCoinsBalanceRequest) (*GetAllCoinsBalanceResponse, error) {
 	queryParams := make(client.Params)
 	if req.MemberID != nil {
@@ -332,7 +354,19 @@ func (i *impl) GetAllCoinsBalance(req *GetAllCoinsBalanceRequest) (*GetAllCoinsB
 
 	return &coinsBalanceResponse, nil
 }
+
+// CreateInternalTransfer moves funds between account types of the same UID, e.g. FUND to UNIFIED.
+// A transferId is generated when none is set; it is written back to req so the transfer can be
+// looked up with GetInternalTransferRecords.
 func (i *impl) CreateInternalTransfer(req *CreateInternalTransferRequest) (*CreateInternalTransferResponse, error) {
+	// Ensure all required fields are provided
+	if req.Coin == "" || req.Amount == "" || req.FromAccountType == "" || req.ToAccountType == "" {
+		return nil, errors.New("missing required fields in request")
+	}
+	if req.TransferID == "" {
+		req.TransferID = NewTransferID()
+	}
+
 	// Initialize Params and populate with request data
 	params := client.Params{
 		"transferId":      req.TransferID,
@@ -342,30 +376,26 @@ func (i *impl) CreateInternalTransfer(req *CreateInternalTransferRequest) (*Crea
 		"toAccountType":   req.ToAccountType,
 	}
 
-	// Ensure all required fields are provided
-	if req.TransferID == "" || req.Coin == "" || req.Amount == "" || req.FromAccountType == "" || req.ToAccountType == "" {
-		return nil, errors.New("missing required fields in request")
-	}
-
 	// Perform the POST request
 	response, err := i.client.Post("/v5/asset/transfer/inter-transfer", params)
 	if err != nil {
 		return nil, fmt.Errorf("error creating internal transfer: %w", err)
 	}
-	data, err := json.Marshal(response)
-	if err != nil {
-		return nil, err
-	}
 
 	// Unmarshal the response body into the CreateInternalTransferResponse struct
 	var transferResponse CreateInternalTransferResponse
-	if err := json.Unmarshal(data, &transferResponse); err != nil {
+	if err := response.Unmarshal(&transferResponse); err != nil {
 		return nil, fmt.Errorf("error parsing internal transfer response: %w", err)
 	}
+	if transferResponse.RetCode != 0 {
+		return &transferResponse, fmt.Errorf("API returned error: %s", transferResponse.RetMsg)
+	}
 
 	return &transferResponse, nil
 }
 
+// GetUniversalTransferRecords queries universal transfer records. When no cursor is supplied every
+// page is fetched and the records are merged into a single response.
 func (i *impl) GetUniversalTransferRecords(req *GetUniversalTransferRecordsRequest) (*GetUniversalTransferRecordsResponse, error) {
 	queryParams := client.Params{}
 	if req.TransferID != nil {
@@ -378,34 +408,26 @@ func (i *impl) GetUniversalTransferRecords(req *GetUniversalTransferRecordsReque
 		queryParams["status"] = *req.Status
 	}
 	if req.StartTime != nil {
-		queryParams["startTime"] = *req.StartTime
+		queryParams["startTime"] = strconv.FormatInt(*req.StartTime, 10)
 	}
 	if req.EndTime != nil {
-		queryParams["endTime"] = *req.EndTime
+		queryParams["endTime"] = strconv.FormatInt(*req.EndTime, 10)
 	}
 	if req.Limit != nil {
-		queryParams["limit"] = *req.Limit
+		queryParams["limit"] = strconv.Itoa(*req.Limit)
 	}
 	if req.Cursor != nil {
 		queryParams["cursor"] = *req.Cursor
 	}
 
-	// Perform the GET request
-	response, err := i.client.Get("/v5/asset/transfer/query-universal-transfer-list", queryParams)
-	if err != nil {
-		return nil, fmt.Errorf("error fetching universal transfer records: %w", err)
-	}
-	data, err := json.Marshal(response)
-	if err != nil {
-		return nil, err
-	}
-	var transferRecordsResponse GetUniversalTransferRecordsResponse
-	if err := json.Unmarshal(data, &transferRecordsResponse); err != nil {
-		return nil, fmt.Errorf("error parsing universal transfer records response: %w", err)
-	}
-
-	return &transferRecordsResponse, nil
+	return client.GetAllPages(i.client, "/v5/asset/transfer/query-universal-transfer-list", queryParams, "universal transfer records",
+		func(page *GetUniversalTransferRecordsResponse) client.Page[UniversalTransferRecordEntry] {
+			return client.Page[UniversalTransferRecordEntry]{RetCode: page.RetCode, RetMsg: page.RetMsg, Cursor: &page.Result.NextPageCursor, Rows: &page.Result.List}
+		})
 }
+
+// GetInternalTransferRecords queries internal transfer records. When no cursor is supplied every
+// page is fetched and the records are merged into a single response.
 func (i *impl) GetInternalTransferRecords(req *GetInternalTransferRecordsRequest) (*GetInternalTransferRecordsResponse, error) {
 	queryParams := make(client.Params)
 	if req.TransferID != nil {
@@ -418,54 +440,61 @@ func (i *impl) GetInternalTransferRecords(req *GetInternalTransferRecordsRequest
 		queryParams["status"] = *req.Status
 	}
 	if req.StartTime != nil {
-		queryParams["startTime"] = *req.StartTime
+		queryParams["startTime"] = strconv.FormatInt(*req.StartTime, 10)
 	}
 	if req.EndTime != nil {
-		queryParams["endTime"] = *req.EndTime
+		queryParams["endTime"] = strconv.FormatInt(*req.EndTime, 10)
 	}
 	if req.Limit != nil {
-		queryParams["limit"] = *req.Limit
+		queryParams["limit"] = strconv.Itoa(*req.Limit)
 	}
 	if req.Cursor != nil {
 		queryParams["cursor"] = *req.Cursor
 	}
 
-	// Perform the GET request
-	response, err := i.client.Get("/v5/asset/transfer/query-inter-transfer-list", queryParams)
-	if err != nil {
-		return nil, fmt.Errorf("error fetching internal transfer records: %w", err)
-	}
-	data, err := json.Marshal(response)
-	if err != nil {
-		return nil, err
-	}
-	var transferRecordsResponse GetInternalTransferRecordsResponse
-	err = json.Unmarshal(data, &transferRecordsResponse)
-	if err != nil {
-		return nil, fmt.Errorf("error parsing internal transfer records response: %w", err)
-	}
-
-	return &transferRecordsResponse, nil
+	return client.GetAllPages(i.client, "/v5/asset/transfer/query-inter-transfer-list", queryParams, "internal transfer records",
+		func(page *GetInternalTransferRecordsResponse) client.Page[InternalTransferRecordEntry] {
+			return client.Page[InternalTransferRecordEntry]{RetCode: page.RetCode, RetMsg: page.RetMsg, Cursor: &page.Result.NextPageCursor, Rows: &page.Result.List}
+		})
 }
+
+// GetSubUIDs lists the sub UIDs of the master account, including the ones with universal
+// transfer enabled that can be used as transfer destinations.
 func (i *impl) GetSubUIDs() (*GetSubUIDsResponse, error) {
 	// Perform the GET request
-	response, err := i.client.Get("/v5/asset/transfer/query-sub-member-list", nil)
+	response, err := i.client.Get("/v5/asset/transfer/query-sub-member-list", client.Params{})
 	if err != nil {
 		return nil, fmt.Errorf("error fetching sub UIDs: %w", err)
 	}
-	data, err := json.Marshal(response)
-	if err != nil {
-		return nil, err
-	}
 	var subUIDsResponse GetSubUIDsResponse
-	err = json.Unmarshal(data, &subUIDsResponse)
+	err = response.Unmarshal(&subUIDsResponse)
 	if err != nil {
 		return nil, fmt.Errorf("error parsing sub UIDs response: %w", err)
 	}
+	if subUIDsResponse.RetCode != 0 {
+		return &subUIDsResponse, fmt.Errorf("API returned error: %s", subUIDsResponse.RetMsg)
+	}
 
 	return &subUIDsResponse, nil
 }
+
+// retCodeTransferIDExists is the retCode of a transfer whose transferId was already used.
+const retCodeTransferIDExists = 131213
+
+// CreateUniversalTransfer moves funds between UIDs of the same master account. A transferId is
+// generated when none is set and written back to req. Because Bybit rejects a reused transferId,
+// retrying with the same request is safe: when the request itself fails or is rejected as a
+// duplicate, the transfer records are checked for the transferId and the transfer is reported as
+// created if Bybit already accepted it.
 func (i *impl) CreateUniversalTransfer(req *CreateUniversalTransferRequest) (*CreateUniversalTransferResponse, error) {
+	// Ensure all required fields are populated
+	if req.Coin == "" || req.Amount == "" || req.FromMemberID == 0 || req.ToMemberID == 0 || req.FromAccountType == "" || req.ToAccountType == "" {
+		return nil, errors.New("missing required fields in request")
+	}
+	if req.TransferID == "" {
+		req.TransferID = NewTransferID()
+	}
+
 	queryParams := make(client.Params)
 	queryParams["transferId"] = req.TransferID
 	queryParams["coin"] = req.Coin
@@ -475,28 +504,50 @@ func (i *impl) CreateUniversalTransfer(req *CreateUniversalTransferRequest) (*Cr
 	queryParams["fromAccountType"] = req.FromAccountType
 	queryParams["toAccountType"] = req.ToAccountType
 
-	// Ensure all required fields are populated
-	if req.TransferID == "" || req.Coin == "" || req.Amount == "" || req.FromMemberID == 0 || req.ToMemberID == 0 || req.FromAccountType == "" || req.ToAccountType == "" {
-		return nil, errors.New("missing required fields in request")
-	}
-
 	// Perform the POST request
 	response, err := i.client.Post("/v5/asset/transfer/universal-transfer", queryParams)
 	if err != nil {
+		if existing, lookupErr := i.findUniversalTransfer(req.TransferID); lookupErr == nil && existing != nil {
+			return existing, nil
+		}
 		return nil, fmt.Errorf("error creating universal transfer: %w", err)
 	}
-	data, err := json.Marshal(response)
-	if err != nil {
-		return nil, err
-	}
 	var transferResponse CreateUniversalTransferResponse
-	err = json.Unmarshal(data, &transferResponse)
+	err = response.Unmarshal(&transferResponse)
 	if err != nil {
 		return nil, fmt.Errorf("error parsing universal transfer response: %w", err)
 	}
+	if transferResponse.RetCode == retCodeTransferIDExists {
+		if existing, lookupErr := i.findUniversalTransfer(req.TransferID); lookupErr == nil && existing != nil {
+			return existing, nil
+		}
+	}
+	if transferResponse.RetCode != 0 {
+		return &transferResponse, fmt.Errorf("API returned error: %s", transferResponse.RetMsg)
+	}
 
 	return &transferResponse, nil
 }
+
+// findUniversalTransfer returns the transfer with the given id as a create response, or nil when
+// Bybit has no record of it.
+func (i *impl) findUniversalTransfer(transferID string) (*CreateUniversalTransferResponse, error) {
+	records, err := i.GetUniversalTransferRecords(&GetUniversalTransferRecordsRequest{TransferID: &transferID})
+	if err != nil {
+		return nil, err
+	}
+	for _, record := range records.Result.List {
+		if record.TransferID == transferID {
+			var existing CreateUniversalTransferResponse
+			existing.RetMsg = OK
+			existing.Result.TransferID = record.TransferID
+			existing.Result.Status = record.Status
+			existing.Time = records.Time
+			return &existing, nil
+		}
+	}
+	return nil, nil
+}
 func (i *impl) GetAllowedDepositCoinInfo(req *GetAllowedDepositCoinInfoRequest) (*GetAllowedDepositCoinInfoResponse, error) {
 	queryParams := make(client.Params)
 	if req.Coin != nil {
@@ -553,113 +604,53 @@ func (i *impl) SetDepositAccount(req *SetDepositAccountRequest) (*SetDepositAcco
 
 	return &response, nil
 }
-func (i *impl) GetDepositRecords(req *GetDepositRecordsRequest) (*GetDepositRecordsResponse, error) {
-	allDepositRecords := []DepositRecordEntry{}
-	var finalResponse GetDepositRecordsResponse
 
-	// Initial queryParams setup
-	queryParams := make(client.Params)
-	if req.Coin != nil {
-		queryParams["coin"] = *req.Coin
-	}
-	if req.StartTime != nil {
-		queryParams["startTime"] = *req.StartTime
-	}
-	if req.EndTime != nil {
-		queryParams["endTime"] = *req.EndTime
-	}
-	if req.Limit != nil {
-		queryParams["limit"] = *req.Limit
-	}
-
-	for {
-		// Perform the GET request
-		response, err := i.client.Get("/v5/asset/deposit/query-record", queryParams)
-		if err != nil {
-			return nil, fmt.Errorf("error fetching deposit records: %w", err)
-		}
-
-		data, err := json.Marshal(response)
-		if err != nil {
-			return nil, err
-		}
-
-		// Deserialize the current page of response
-		var currentPageResponse GetDepositRecordsResponse
-		err = json.Unmarshal(data, &currentPageResponse)
-		if err != nil {
-			return nil, fmt.Errorf("error parsing deposit records response: %w", err)
-		}
-
-		// Accumulate records from the current page
-		allDepositRecords = append(allDepositRecords, currentPageResponse.Result.Rows...)
+// GetDepositRecords queries on-chain deposit records of the master account. When no cursor is
+// supplied every page is fetched and the records are merged into a single response.
+func (i *impl) GetDepositRecords(req *GetDepositRecordsRequest) (*GetDepositRecordsResponse, error) {
+	queryParams := depositRecordParams(req.Coin, req.StartTime, req.EndTime, req.Limit, req.Cursor)
 
-		// Check if there's a next page. If not, break out of the loop
-		if currentPageResponse.Result.NextPageCursor == "" {
-			finalResponse = currentPageResponse // Use the last page's meta info for final response
-			break
-		}
+	return client.GetAllPages(i.client, "/v5/asset/deposit/query-record", queryParams, "deposit records",
+		func(page *GetDepositRecordsResponse) client.Page[DepositRecordEntry] {
+			return client.Page[DepositRecordEntry]{RetCode: page.RetCode, RetMsg: page.RetMsg, Cursor: &page.Result.NextPageCursor, Rows: &page.Result.Rows}
+		})
+}
 
-		// Set cursor for the next page
-		queryParams["cursor"] = currentPageResponse.Result.NextPageCursor
+// GetSubDepositRecords queries on-chain deposit records of a sub account. When no cursor is
+// supplied every page is fetched and the records are merged into a single response.
+func (i *impl) GetSubDepositRecords(req *GetSubDepositRecordsRequest) (*GetSubDepositRecordsResponse, error) {
+	if req.SubMemberID == "" {
+		return nil, errors.New("subMemberId is required")
 	}
+	queryParams := depositRecordParams(req.Coin, req.StartTime, req.EndTime, req.Limit, req.Cursor)
+	queryParams["subMemberId"] = req.SubMemberID
 
-	// Populate finalResponse with all accumulated records
-	finalResponse.Result.Rows = allDepositRecords
-	finalResponse.Result.NextPageCursor = ""
-	finalResponse.RetCode = 0
-
-	return &finalResponse, nil
+	return client.GetAllPages(i.client, "/v5/asset/deposit/query-sub-member-record", queryParams, "sub deposit records",
+		func(page *GetSubDepositRecordsResponse) client.Page[DepositRecordEntry] {
+			return client.Page[DepositRecordEntry]{RetCode: page.RetCode, RetMsg: page.RetMsg, Cursor: &page.Result.NextPageCursor, Rows: &page.Result.Rows}
+		})
 }
-func (i *impl) GetSubDepositRecords(req *GetSubDepositRecordsRequest) (*GetSubDepositRecordsResponse, error) {
-	var allRows []DepositRecordEntry
-	var finalResponse GetSubDepositRecordsResponse
 
+func depositRecordParams(coin *string, startTime, endTime *int64, limit *int, cursor *string) client.Params {
 	queryParams := make(client.Params)
-	queryParams["subMemberId"] = req.SubMemberID
-	if req.Coin != nil {
-		queryParams["coin"] = *req.Coin
+	if coin != nil {
+		queryParams["coin"] = *coin
 	}
-	if req.StartTime != nil {
-		queryParams["startTime"] = *req.StartTime
+	if startTime != nil {
+		queryParams["startTime"] = strconv.FormatInt(*startTime, 10)
 	}
-	if req.EndTime != nil {
-		queryParams["endTime"] = *req.EndTime
+	if endTime != nil {
+		queryParams["endTime"] = strconv.FormatInt(*endTime, 10)
 	}
-	if req.Limit != nil {
-		queryParams["limit"] = *req.Limit
+	if limit != nil {
+		queryParams["limit"] = strconv.Itoa(*limit)
 	}
-	queryParams["cursor"] = req.Cursor // Start with nil or provided cursor
-
-	for {
-		response, err := i.client.Get("/v5/asset/deposit/query-sub-member-record", queryParams)
-		if err != nil {
-			return nil, fmt.Errorf("error fetching sub deposit records: %w", err)
-		}
-
-		// Assuming the response is already unmarshaled into the appropriate struct
-		var currentPageResponse GetSubDepositRecordsResponse
-		data, err := json.Marshal(response)
-		if err != nil {
-			return nil, err
-		}
-		err = json.Unmarshal(data, &currentPageResponse)
-		if err != nil {
-			return nil, fmt.Errorf("error parsing sub deposit records response: %w", err)
-		}
-		allRows = append(allRows, currentPageResponse.Result.Rows...)
-		if currentPageResponse.Result.NextPageCursor == "" {
-			break
-		}
-		queryParams["cursor"] = currentPageResponse.Result.NextPageCursor // Prepare for the next iteration
+	if cursor != nil {
+		queryParams["cursor"] = *cursor
 	}
-
-	// Assign collected rows and last page's meta to finalResponse
-	finalResponse.Result.Rows = allRows
-	finalResponse.Result.NextPageCursor = ""
-	finalResponse.RetCode = 0
-	return &finalResponse, nil
+	return queryParams
 }
+
 func (i *impl) GetInternalDepositRecords(req *GetInternalDepositRecordsRequest) (*GetInternalDepositRecordsResponse, error) {
 	var allRows []InternalDepositRecordEntry
 	var finalResponse GetInternalDepositRecordsResponse
@@ -719,7 +710,12 @@ func (i *impl) GetInternalDepositRecords(req *GetInternalDepositRecordsRequest)
 	return &finalResponse, nil
 }
 
+// GetMasterDepositAddress returns the deposit addresses of a coin on the master account, for one
+// chain when ChainType is set or for every chain otherwise.
 func (i *impl) GetMasterDepositAddress(req *GetMasterDepositAddressRequest) (*GetMasterDepositAddressResponse, error) {
+	if req.Coin == "" {
+		return nil, errors.New("coin is required")
+	}
 	queryParams := make(client.Params)
 	queryParams["coin"] = req.Coin
 	if req.ChainType != nil {
@@ -727,49 +723,55 @@ func (i *impl) GetMasterDepositAddress(req *GetMasterDepositAddressRequest) (*Ge
 	}
 
 	// Perform the GET request
-	responseBytes, err := i.client.Get("/v5/asset/deposit/query-address", queryParams)
+	responseData, err := i.client.Get("/v5/asset/deposit/query-address", queryParams)
 	if err != nil {
 		return nil, fmt.Errorf("error querying master deposit address: %w", err)
 	}
 
-	data, err := json.Marshal(responseBytes)
-	if err != nil {
-		return nil, err
-	}
 	// Deserialize the response into the response struct
 	var response GetMasterDepositAddressResponse
-	err = json.Unmarshal(data, &response)
+	err = responseData.Unmarshal(&response)
 	if err != nil {
 		return nil, fmt.Errorf("error parsing master deposit address response: %w", err)
 	}
+	if response.RetCode != 0 {
+		return &response, fmt.Errorf("API returned error: %s", response.RetMsg)
+	}
 
 	return &response, nil
 }
+
+// GetSubDepositAddress returns the deposit address of a coin on one chain of a sub account.
 func (i *impl) GetSubDepositAddress(req *GetSubDepositAddressRequest) (*GetSubDepositAddressResponse, error) {
+	if req.Coin == "" || req.ChainType == "" || req.SubMemberID == "" {
+		return nil, errors.New("missing required fields in request")
+	}
 	queryParams := make(client.Params)
 	queryParams["coin"] = req.Coin
 	queryParams["chainType"] = req.ChainType
 	queryParams["subMemberId"] = req.SubMemberID
 
 	// Perform the GET request
-	responseBytes, err := i.client.Get("/v5/asset/deposit/query-sub-member-address", queryParams)
+	responseData, err := i.client.Get("/v5/asset/deposit/query-sub-member-address", queryParams)
 	if err != nil {
 		return nil, fmt.Errorf("error querying sub deposit address: %w", err)
 	}
-	data, err := json.Marshal(responseBytes)
 
-	if err != nil {
-		return nil, err
-	}
 	// Deserialize the response into the response struct
 	var response GetSubDepositAddressResponse
-	err = json.Unmarshal(data, &response)
+	err = responseData.Unmarshal(&response)
 	if err != nil {
 		return nil, fmt.Errorf("error parsing sub deposit address response: %w", err)
 	}
+	if response.RetCode != 0 {
+		return &response, fmt.Errorf("API returned error: %s", response.RetMsg)
+	}
 
 	return &response, nil
 }
+
+// GetCoinInfo returns the chains of a coin, or of every coin when coin is nil, together with their
+// withdrawal fees, minimum amounts, confirmation counts and whether deposits and withdrawals are open.
 func (i *impl) GetCoinInfo(coin *string) (*GetCoinInfoResponse, error) {
 	queryParams := make(client.Params)
 	if coin != nil {
@@ -777,29 +779,27 @@ func (i *impl) GetCoinInfo(coin *string) (*GetCoinInfoResponse, error) {
 	}
 
 	// Perform the GET request
-	responseBytes, err := i.client.Get("/v5/asset/coin/query-info", queryParams)
+	responseData, err := i.client.Get("/v5/asset/coin/query-info", queryParams)
 	if err != nil {
 		return nil, fmt.Errorf("error querying coin information: %w", err)
 	}
 
-	data, err := json.Marshal(responseBytes)
-	if err != nil {
-		return nil, err
-	}
 	// Deserialize the response into the response struct
 	var response GetCoinInfoResponse
-	err = json.Unmarshal(data, &response)
+	err = responseData.Unmarshal(&response)
 	if err != nil {
 		return nil, fmt.Errorf("error parsing coin information response: %w", err)
 	}
+	if response.RetCode != 0 {
+		return &response, fmt.Errorf("API returned error: %s", response.RetMsg)
+	}
 
 	return &response, nil
 }
 
+// GetWithdrawalRecords queries withdrawal records. When no cursor is supplied every page is fetched
+// and the records are merged into a single response.
 func (i *impl) GetWithdrawalRecords(req *GetWithdrawalRecordsRequest) (*GetWithdrawalRecordsResponse, error) {
-	allRecords := []WithdrawalRecord{}
-	var finalResponse GetWithdrawalRecordsResponse
-
 	queryParams := make(client.Params)
 	// Populate queryParams based on request
 	if req.WithdrawID != nil {
@@ -812,74 +812,69 @@ func (i *impl) GetWithdrawalRecords(req *GetWithdrawalRecordsRequest) (*GetWithd
 		queryParams["coin"] = *req.Coin
 	}
 	if req.WithdrawType != nil {
-		queryParams["withdrawType"] = *req.WithdrawType
+		queryParams["withdrawType"] = strconv.Itoa(*req.WithdrawType)
 	}
 	if req.StartTime != nil {
-		queryParams["startTime"] = *req.StartTime
+		queryParams["startTime"] = strconv.FormatInt(*req.StartTime, 10)
 	}
 	if req.EndTime != nil {
-		queryParams["endTime"] = *req.EndTime
+		queryParams["endTime"] = strconv.FormatInt(*req.EndTime, 10)
 	}
 	if req.Limit != nil {
-		queryParams["limit"] = *req.Limit
+		queryParams["limit"] = strconv.Itoa(*req.Limit)
 	}
-	queryParams["cursor"] = req.Cursor // Initialize cursor for pagination
-	var currentPageResponse GetWithdrawalRecordsResponse
-	for {
-		responseBytes, err := i.client.Get("/v5/asset/withdraw/query-record", queryParams)
-		if err != nil {
-			return nil, fmt.Errorf("error querying withdrawal records: %w", err)
-		}
-		data, err := json.Marshal(responseBytes)
-		if err != nil {
-			return nil, err
-		}
-		var currentPageResponse GetWithdrawalRecordsResponse
-		err = json.Unmarshal(data, &currentPageResponse)
-		if err != nil {
-			return nil, fmt.Errorf("error parsing withdrawal records response: %w", err)
-		}
-
-		// Aggregate records
-		allRecords = append(allRecords, currentPageResponse.Result.Rows...)
-
-		// Check for the next page
-		if currentPageResponse.Result.NextPageCursor == "" {
-			break
-		}
-		queryParams["cursor"] = currentPageResponse.Result.NextPageCursor
+	if req.Cursor != nil {
+		queryParams["cursor"] = *req.Cursor
 	}
 
-	// Set aggregated records to the final response
-	finalResponse.Result.Rows = allRecords
-	finalResponse.Result.NextPageCursor = currentPageResponse.Result.NextPageCursor
-	finalResponse.RetCode = currentPageResponse.RetCode
-	finalResponse.RetExtInfo = currentPageResponse.RetExtInfo
-
-	return &finalResponse, nil
+	return client.GetAllPages(i.client, "/v5/asset/withdraw/query-record", queryParams, "withdrawal records",
+		func(page *GetWithdrawalRecordsResponse) client.Page[WithdrawalRecord] {
+			return client.Page[WithdrawalRecord]{RetCode: page.RetCode, RetMsg: page.RetMsg, Cursor: &page.Result.NextPageCursor, Rows: &page.Result.Rows}
+		})
 }
+
+// GetWithdrawableAmount returns how much of a coin can be withdrawn right now per wallet. Funds
+// from recent deposits are excluded until they clear the delayed withdrawal period, so checking
+// this first avoids withdrawals that would be rejected.
 func (i *impl) GetWithdrawableAmount(req *GetWithdrawableAmountRequest) (*GetWithdrawableAmountResponse, error) {
+	if req.Coin == "" {
+		return nil, errors.New("coin is required")
+	}
 	queryParams := client.Params{
 		"coin": req.Coin,
 	}
 	// Perform the GET request
-	responseBytes, err := i.client.Get("/v5/asset/withdraw/withdrawable-amount", queryParams)
+	responseData, err := i.client.Get("/v5/asset/withdraw/withdrawable-amount", queryParams)
 	if err != nil {
 		return nil, fmt.Errorf("error querying withdrawable amount: %w", err)
 	}
-	data, err := json.Marshal(responseBytes)
-	if err != nil {
-		return nil, err
-	}
 	// Deserialize the response into the response struct
 	var response GetWithdrawableAmountResponse
-	err = json.Unmarshal(data, &response)
+	err = responseData.Unmarshal(&response)
 	if err != nil {
 		return nil, fmt.Errorf("error parsing withdrawable amount response: %w", err)
 	}
+	if response.RetCode != 0 {
+		return &response, fmt.Errorf("API returned error: %s", response.RetMsg)
+	}
 	return &response, nil
 }
+
+// Withdraw creates an on-chain withdrawal, or an off-chain transfer to another Bybit user when
+// ForceChain is 0 and the address belongs to Bybit. Coins on chains that need a memo must set Tag.
+// Timestamp defaults to the current time.
 func (i *impl) Withdraw(req *WithdrawRequest) (*WithdrawResponse, error) {
+	if req.Coin == "" || req.Address == "" || req.Amount == "" {
+		return nil, errors.New("missing required fields in request")
+	}
+	if req.ForceChain != nil && *req.ForceChain == 1 && req.Chain == nil {
+		return nil, errors.New("chain is required when forceChain is 1")
+	}
+	timestamp := req.Timestamp
+	if timestamp == 0 {
+		timestamp = time.Now().UnixMilli()
+	}
+
 	// Construct the queryParams from the WithdrawRequest struct
 	queryParams := make(client.Params)
 	queryParams["coin"] = req.Coin
@@ -891,7 +886,7 @@ func (i *impl) Withdraw(req *WithdrawRequest) (*WithdrawResponse, error) {
 		queryParams["tag"] = *req.Tag
 	}
 	queryParams["amount"] = req.Amount
-	queryParams["timestamp"] = req.Timestamp
+	queryParams["timestamp"] = timestamp
 	if req.ForceChain != nil {
 		queryParams["forceChain"] = *req.ForceChain
 	}
@@ -906,44 +901,46 @@ func (i *impl) Withdraw(req *WithdrawRequest) (*WithdrawResponse, error) {
 	}
 
 	// Perform the POST request
-	responseBytes, err := i.client.Post("/v5/asset/withdraw/create", queryParams)
+	responseData, err := i.client.Post("/v5/asset/withdraw/create", queryParams)
 	if err != nil {
 		return nil, fmt.Errorf("error creating withdraw request: %w", err)
 	}
-	data, err := json.Marshal(responseBytes)
-	if err != nil {
-		return nil, err
-	}
 	// Deserialize the response
 	var response WithdrawResponse
-	err = json.Unmarshal(data, &response)
+	err = responseData.Unmarshal(&response)
 	if err != nil {
 		return nil, fmt.Errorf("error parsing withdraw response: %w", err)
 	}
+	if response.RetCode != 0 {
+		return &response, fmt.Errorf("API returned error: %s", response.RetMsg)
+	}
 
 	return &response, nil
 }
 
+// CancelWithdrawal cancels a withdrawal that has not been processed yet.
 func (i *impl) CancelWithdrawal(req *CancelWithdrawalRequest) (*CancelWithdrawalResponse, error) {
+	if req.ID == "" {
+		return nil, errors.New("withdrawal id is required")
+	}
 	// Construct the queryParams from the CancelWithdrawalRequest struct
 	queryParams := make(client.Params)
 	queryParams["id"] = req.ID
 
 	// Perform the POST request
-	responseBytes, err := i.client.Post("/v5/asset/withdraw/cancel", queryParams)
+	responseData, err := i.client.Post("/v5/asset/withdraw/cancel", queryParams)
 	if err != nil {
 		return nil, fmt.Errorf("error cancelling withdrawal: %w", err)
 	}
-	data, err := json.Marshal(responseBytes)
-	if err != nil {
-		return nil, err
-	}
 	// Deserialize the response
 	var response CancelWithdrawalResponse
-	err = json.Unmarshal(data, &response)
+	err = responseData.Unmarshal(&response)
 	if err != nil {
 		return nil, fmt.Errorf("error parsing cancel withdrawal response: %w", err)
 	}
+	if response.RetCode != 0 {
+		return &response, fmt.Errorf("API returned error: %s", response.RetMsg)
+	}
 
 	return &response, nil
 }
@@ -0,0 +1,65 @@
+package asset
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDepositRecordsPaging(t *testing.T) {
+	var cursors []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v5/asset/deposit/query-record", r.URL.Path)
+		cursor := r.URL.Query().Get("cursor")
+		cursors = append(cursors, cursor)
+		if cursor == "" {
+			fmt.Fprint(w, `{"retCode":0,"result":{"rows":[{"coin":"USDT","txID":"a"},{"coin":"USDT","txID":"b"}],"nextPageCursor":"next"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"retCode":0,"result":{"rows":[{"coin":"USDT","txID":"c"}],"nextPageCursor":""}}`)
+	}))
+	defer server.Close()
+	a := New(client.NewClientWithBaseURL("key", "secret", server.URL))
+
+	coin := "USDT"
+	res, err := a.GetDepositRecords(&GetDepositRecordsRequest{Coin: &coin})
+	require.NoError(t, err)
+	require.Len(t, res.Result.Rows, 3)
+	assert.Equal(t, "c", res.Result.Rows[2].TxID)
+	assert.Equal(t, []string{"", "next"}, cursors)
+
+	// A supplied cursor fetches that page only.
+	cursors = nil
+	cursor := "next"
+	res, err = a.GetDepositRecords(&GetDepositRecordsRequest{Cursor: &cursor})
+	require.NoError(t, err)
+	assert.Len(t, res.Result.Rows, 1)
+	assert.Equal(t, []string{"next"}, cursors)
+}
+
+func TestCreateUniversalTransferDuplicate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v5/asset/transfer/universal-transfer":
+			fmt.Fprint(w, `{"retCode":131213,"retMsg":"transferId already exists"}`)
+		case "/v5/asset/transfer/query-universal-transfer-list":
+			assert.Equal(t, "retry-1", r.URL.Query().Get("transferId"))
+			fmt.Fprint(w, `{"retCode":0,"result":{"list":[{"transferId":"retry-1","status":"SUCCESS"}]}}`)
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+	a := New(client.NewClientWithBaseURL("key", "secret", server.URL))
+
+	req := &CreateUniversalTransferRequest{TransferID: "retry-1", Coin: "USDT", Amount: "10", FromMemberID: 1, ToMemberID: 2, FromAccountType: "UNIFIED", ToAccountType: "UNIFIED"}
+	res, err := a.CreateUniversalTransfer(req)
+	require.NoError(t, err)
+	assert.Equal(t, "retry-1", res.Result.TransferID)
+	assert.Equal(t, "SUCCESS", res.Result.Status)
+}
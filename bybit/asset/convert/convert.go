@@ -0,0 +1,208 @@
+package convert
+
+import (
+	"fmt"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+)
+
+// Convert defines the interface for the coin conversion endpoints of the Bybit API.
+type Convert interface {
+	// GetCoinList retrieves the coins that can be converted from or to in a wallet.
+	// accountType: string - the wallet, e.g. AccountTypeFunding.
+	// coin: string - the coin to query; empty returns every coin.
+	// side: int - SideFrom for the coins to convert from, SideTo for the coins to convert to.
+	// returns: *CoinListResponse - the coins and their conversion limits.
+	//          error - an error if the request fails.
+	GetCoinList(accountType, coin string, side int) (*CoinListResponse, error)
+
+	// RequestQuote requests a quote for a conversion.
+	// req: QuoteRequest - the coins, amount and wallet of the conversion.
+	// returns: *QuoteResponse - the quote, valid until its expiredTime.
+	//          error - an error if the request fails.
+	RequestQuote(req *QuoteRequest) (*QuoteResponse, error)
+
+	// ConfirmQuote executes the conversion of a quote.
+	// quoteTxID: string - the ID of the quote returned by RequestQuote.
+	// returns: *ConfirmResponse - the status of the conversion.
+	//          error - an error if the request fails.
+	ConfirmQuote(quoteTxID string) (*ConfirmResponse, error)
+
+	// GetResult retrieves the result of a conversion.
+	// quoteTxID: string - the ID of the quote.
+	// accountType: string - the wallet the conversion was made in.
+	// returns: *ResultResponse - the conversion.
+	//          error - an error if the request fails.
+	GetResult(quoteTxID, accountType string) (*ResultResponse, error)
+
+	// GetHistory retrieves the conversion history.
+	// req: HistoryRequest - the wallets and paging of the query.
+	// returns: *HistoryResponse - the conversions of all pages, or of one page when an index is given.
+	//          error - an error if any request fails.
+	GetHistory(req *HistoryRequest) (*HistoryResponse, error)
+}
+
+type impl struct {
+	client *client.Client
+}
+
+// New creates a new instance of the Convert interface, which can be used to interact with the Bybit API.
+func New(c *client.Client) Convert {
+	return &impl{client: c}
+}
+
+// GetCoinList retrieves the coins that can be converted in a wallet, along with the balance and
+// the single and daily conversion limits of each coin.
+func (i *impl) GetCoinList(accountType, coin string, side int) (*CoinListResponse, error) {
+	if !validAccountType(accountType) {
+		return nil, fmt.Errorf("invalid accountType %q", accountType)
+	}
+	if side != SideFrom && side != SideTo {
+		return nil, fmt.Errorf("invalid side %d", side)
+	}
+	params := client.Params{
+		"accountType": accountType,
+		"side":        fmt.Sprintf("%d", side),
+	}
+	if coin != "" {
+		params["coin"] = coin
+	}
+	response, err := i.client.Get("/v5/asset/exchange/query-coin-list", params)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching convert coin list: %w", err)
+	}
+	var coinResponse CoinListResponse
+	if err := response.Unmarshal(&coinResponse); err != nil {
+		return nil, fmt.Errorf("error parsing convert coin list response: %w", err)
+	}
+	if coinResponse.RetCode != 0 {
+		return &coinResponse, fmt.Errorf("API returned error: %s", coinResponse.RetMsg)
+	}
+	return &coinResponse, nil
+}
+
+// RequestQuote requests a quote for converting RequestAmount of FromCoin into ToCoin. The quote
+// must be confirmed with ConfirmQuote before it expires.
+func (i *impl) RequestQuote(req *QuoteRequest) (*QuoteResponse, error) {
+	if req.FromCoin == "" || req.ToCoin == "" || req.RequestCoin == "" || req.RequestAmount == "" {
+		return nil, fmt.Errorf("fromCoin, toCoin, requestCoin and requestAmount are required")
+	}
+	if req.FromCoin == req.ToCoin {
+		return nil, fmt.Errorf("fromCoin and toCoin must differ")
+	}
+	if !validAccountType(req.AccountType) {
+		return nil, fmt.Errorf("invalid accountType %q", req.AccountType)
+	}
+	response, err := i.client.Post("/v5/asset/exchange/quote-apply", ConvertQuoteRequestToParams(req))
+	if err != nil {
+		return nil, fmt.Errorf("error requesting convert quote: %w", err)
+	}
+	var quoteResponse QuoteResponse
+	if err := response.Unmarshal(&quoteResponse); err != nil {
+		return nil, fmt.Errorf("error parsing convert quote response: %w", err)
+	}
+	if quoteResponse.RetCode != 0 {
+		return &quoteResponse, fmt.Errorf("API returned error: %s", quoteResponse.RetMsg)
+	}
+	return &quoteResponse, nil
+}
+
+// ConfirmQuote executes the conversion of a quote. The conversion completes asynchronously; use
+// GetResult to follow it until its status is StatusSuccess or StatusFailure.
+func (i *impl) ConfirmQuote(quoteTxID string) (*ConfirmResponse, error) {
+	if quoteTxID == "" {
+		return nil, fmt.Errorf("quoteTxId is required")
+	}
+	response, err := i.client.Post("/v5/asset/exchange/convert-execute", client.Params{"quoteTxId": quoteTxID})
+	if err != nil {
+		return nil, fmt.Errorf("error confirming convert quote: %w", err)
+	}
+	var confirmResponse ConfirmResponse
+	if err := response.Unmarshal(&confirmResponse); err != nil {
+		return nil, fmt.Errorf("error parsing convert confirm response: %w", err)
+	}
+	if confirmResponse.RetCode != 0 {
+		return &confirmResponse, fmt.Errorf("API returned error: %s", confirmResponse.RetMsg)
+	}
+	return &confirmResponse, nil
+}
+
+// GetResult retrieves the result of a confirmed conversion.
+func (i *impl) GetResult(quoteTxID, accountType string) (*ResultResponse, error) {
+	if quoteTxID == "" {
+		return nil, fmt.Errorf("quoteTxId is required")
+	}
+	if !validAccountType(accountType) {
+		return nil, fmt.Errorf("invalid accountType %q", accountType)
+	}
+	params := client.Params{
+		"quoteTxId":   quoteTxID,
+		"accountType": accountType,
+	}
+	response, err := i.client.Get("/v5/asset/exchange/convert-result-query", params)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching convert result: %w", err)
+	}
+	var resultResponse ResultResponse
+	if err := response.Unmarshal(&resultResponse); err != nil {
+		return nil, fmt.Errorf("error parsing convert result response: %w", err)
+	}
+	if resultResponse.RetCode != 0 {
+		return &resultResponse, fmt.Errorf("API returned error: %s", resultResponse.RetMsg)
+	}
+	return &resultResponse, nil
+}
+
+// GetHistory retrieves the conversion history, newest first. When no index is supplied every page
+// is fetched, until a page comes back short, and the conversions are merged into a single response.
+func (i *impl) GetHistory(req *HistoryRequest) (*HistoryResponse, error) {
+	for _, accountType := range req.AccountTypes {
+		if !validAccountType(accountType) {
+			return nil, fmt.Errorf("invalid accountType %q", accountType)
+		}
+	}
+	limit := MaxHistoryLimit
+	if req.Limit != nil {
+		if *req.Limit < 1 || *req.Limit > MaxHistoryLimit {
+			return nil, fmt.Errorf("limit must be between 1 and %d", MaxHistoryLimit)
+		}
+		limit = *req.Limit
+	}
+
+	followIndex := req.Index == nil
+	page := *req
+	page.Limit = &limit
+	if followIndex {
+		index := 1
+		page.Index = &index
+	}
+	var merged *HistoryResponse
+
+	for {
+		response, err := i.client.Get("/v5/asset/exchange/query-convert-history", ConvertHistoryRequestToParams(&page))
+		if err != nil {
+			return nil, fmt.Errorf("error fetching convert history: %w", err)
+		}
+		var historyResponse HistoryResponse
+		if err := response.Unmarshal(&historyResponse); err != nil {
+			return nil, fmt.Errorf("error parsing convert history response: %w", err)
+		}
+		if historyResponse.RetCode != 0 {
+			return &historyResponse, fmt.Errorf("API returned error: %s", historyResponse.RetMsg)
+		}
+
+		if merged == nil {
+			merged = &historyResponse
+		} else {
+			merged.Result.List = append(merged.Result.List, historyResponse.Result.List...)
+			merged.Time = historyResponse.Time
+		}
+		if !followIndex || len(historyResponse.Result.List) < limit {
+			break
+		}
+		next := *page.Index + 1
+		page.Index = &next
+	}
+
+	return merged, nil
+}
@@ -0,0 +1,55 @@
+package convert
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+)
+
+func ConvertQuoteRequestToParams(req *QuoteRequest) client.Params {
+	params := make(client.Params)
+	params["fromCoin"] = req.FromCoin
+	params["toCoin"] = req.ToCoin
+	params["requestCoin"] = req.RequestCoin
+	params["requestAmount"] = req.RequestAmount
+	params["accountType"] = req.AccountType
+	if req.FromCoinType != nil {
+		params["fromCoinType"] = *req.FromCoinType
+	}
+	if req.ToCoinType != nil {
+		params["toCoinType"] = *req.ToCoinType
+	}
+	if req.ParamType != nil {
+		params["paramType"] = *req.ParamType
+	}
+	if req.ParamValue != nil {
+		params["paramValue"] = *req.ParamValue
+	}
+	if req.RequestID != nil {
+		params["requestId"] = *req.RequestID
+	}
+	return params
+}
+
+func ConvertHistoryRequestToParams(req *HistoryRequest) client.Params {
+	params := make(client.Params)
+	if len(req.AccountTypes) > 0 {
+		params["accountType"] = strings.Join(req.AccountTypes, ",")
+	}
+	if req.Index != nil {
+		params["index"] = strconv.Itoa(*req.Index)
+	}
+	if req.Limit != nil {
+		params["limit"] = strconv.Itoa(*req.Limit)
+	}
+	return params
+}
+
+func validAccountType(accountType string) bool {
+	switch accountType {
+	case AccountTypeFunding, AccountTypeUnified, AccountTypeSpot, AccountTypeContract, AccountTypeInverse:
+		return true
+	}
+	return false
+}
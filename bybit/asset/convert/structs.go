@@ -0,0 +1,156 @@
+package convert
+
+// MaxHistoryLimit is the largest page size accepted by the conversion history endpoint.
+const MaxHistoryLimit = 100
+
+// Wallets a conversion can be made from.
+const (
+	AccountTypeFunding  = "eb_convert_funding"
+	AccountTypeUnified  = "eb_convert_uta"
+	AccountTypeSpot     = "eb_convert_spot"
+	AccountTypeContract = "eb_convert_contract"
+	AccountTypeInverse  = "eb_convert_inverse"
+)
+
+// Sides of the coin list.
+const (
+	SideFrom = 0
+	SideTo   = 1
+)
+
+// Values of the exchangeStatus field.
+const (
+	StatusInit       = "init"
+	StatusProcessing = "processing"
+	StatusSuccess    = "success"
+	StatusFailure    = "failure"
+)
+
+// Coin describes a coin that can be converted and its conversion limits.
+type Coin struct {
+	Coin               string `json:"coin"`
+	FullName           string `json:"fullName"`
+	Icon               string `json:"icon"`
+	IconNight          string `json:"iconNight"`
+	AccuracyLength     int    `json:"accuracyLength"`
+	CoinType           string `json:"coinType"`
+	Balance            string `json:"balance"`
+	UBalance           string `json:"uBalance"`
+	SingleFromMinLimit string `json:"singleFromMinLimit"`
+	SingleFromMaxLimit string `json:"singleFromMaxLimit"`
+	DisableFrom        bool   `json:"disableFrom"`
+	DisableTo          bool   `json:"disableTo"`
+	TimePeriod         int    `json:"timePeriod"`
+	SingleToMinLimit   string `json:"singleToMinLimit"`
+	SingleToMaxLimit   string `json:"singleToMaxLimit"`
+	DailyFromMinLimit  string `json:"dailyFromMinLimit"`
+	DailyFromMaxLimit  string `json:"dailyFromMaxLimit"`
+	DailyToMinLimit    string `json:"dailyToMinLimit"`
+	DailyToMaxLimit    string `json:"dailyToMaxLimit"`
+}
+
+// CoinListResponse represents the response from querying the convertible coins.
+type CoinListResponse struct {
+	RetCode int    `json:"retCode"`
+	RetMsg  string `json:"retMsg"`
+	Result  struct {
+		Coins []Coin `json:"coins"`
+	} `json:"result"`
+	RetExtInfo any   `json:"retExtInfo"`
+	Time       int64 `json:"time"`
+}
+
+// QuoteRequest represents the payload for requesting a conversion quote.
+type QuoteRequest struct {
+	FromCoin      string  `json:"fromCoin"`               // Required: Coin to convert from
+	ToCoin        string  `json:"toCoin"`                 // Required: Coin to convert to
+	FromCoinType  *string `json:"fromCoinType,omitempty"` // Optional: "crypto"
+	ToCoinType    *string `json:"toCoinType,omitempty"`   // Optional: "crypto"
+	RequestCoin   string  `json:"requestCoin"`            // Required: The coin the amount is denominated in, fromCoin only
+	RequestAmount string  `json:"requestAmount"`          // Required: Amount to convert
+	AccountType   string  `json:"accountType"`            // Required: Wallet to convert in, e.g. AccountTypeFunding
+	ParamType     *string `json:"paramType,omitempty"`    // Optional: "opFrom", broker use only
+	ParamValue    *string `json:"paramValue,omitempty"`   // Optional: Broker ID, broker use only
+	RequestID     *string `json:"requestId,omitempty"`    // Optional: Customised request ID
+}
+
+// QuoteResponse represents the response from requesting a conversion quote.
+type QuoteResponse struct {
+	RetCode int    `json:"retCode"`
+	RetMsg  string `json:"retMsg"`
+	Result  struct {
+		QuoteTxID    string `json:"quoteTxId"`
+		ExchangeRate string `json:"exchangeRate"`
+		FromCoin     string `json:"fromCoin"`
+		FromCoinType string `json:"fromCoinType"`
+		ToCoin       string `json:"toCoin"`
+		ToCoinType   string `json:"toCoinType"`
+		FromAmount   string `json:"fromAmount"`
+		ToAmount     string `json:"toAmount"`
+		ExpiredTime  string `json:"expiredTime"`
+		RequestID    string `json:"requestId"`
+	} `json:"result"`
+	RetExtInfo any   `json:"retExtInfo"`
+	Time       int64 `json:"time"`
+}
+
+// ConfirmResponse represents the response from confirming a conversion quote.
+type ConfirmResponse struct {
+	RetCode int    `json:"retCode"`
+	RetMsg  string `json:"retMsg"`
+	Result  struct {
+		QuoteTxID      string `json:"quoteTxId"`
+		ExchangeStatus string `json:"exchangeStatus"`
+	} `json:"result"`
+	RetExtInfo any   `json:"retExtInfo"`
+	Time       int64 `json:"time"`
+}
+
+// Conversion is a confirmed conversion.
+type Conversion struct {
+	AccountType    string `json:"accountType"`
+	ExchangeTxID   string `json:"exchangeTxId"`
+	UserID         string `json:"userId"`
+	FromCoin       string `json:"fromCoin"`
+	FromCoinType   string `json:"fromCoinType"`
+	ToCoin         string `json:"toCoin"`
+	ToCoinType     string `json:"toCoinType"`
+	FromAmount     string `json:"fromAmount"`
+	ToAmount       string `json:"toAmount"`
+	ExchangeStatus string `json:"exchangeStatus"`
+	ExtInfo        struct {
+		ParamType  string `json:"paramType"`
+		ParamValue string `json:"paramValue"`
+	} `json:"extInfo"`
+	ConvertRate string `json:"convertRate"`
+	CreatedAt   string `json:"createdAt"`
+}
+
+// ResultResponse represents the response from querying the result of a conversion.
+type ResultResponse struct {
+	RetCode int    `json:"retCode"`
+	RetMsg  string `json:"retMsg"`
+	Result  struct {
+		Result Conversion `json:"result"`
+	} `json:"result"`
+	RetExtInfo any   `json:"retExtInfo"`
+	Time       int64 `json:"time"`
+}
+
+// HistoryRequest represents the query parameters for the conversion history.
+type HistoryRequest struct {
+	AccountTypes []string `json:"accountType,omitempty"` // Optional: Wallets to include; every wallet when empty
+	Index        *int     `json:"index,omitempty"`       // Optional: Page number starting from 1; disables automatic paging
+	Limit        *int     `json:"limit,omitempty"`       // Optional: Page size, at most 100
+}
+
+// HistoryResponse represents the response from querying the conversion history.
+type HistoryResponse struct {
+	RetCode int    `json:"retCode"`
+	RetMsg  string `json:"retMsg"`
+	Result  struct {
+		List []Conversion `json:"list"`
+	} `json:"result"`
+	RetExtInfo any   `json:"retExtInfo"`
+	Time       int64 `json:"time"`
+}
@@ -1 +1,25 @@
 package asset
+
+import (
+	"crypto/rand"
+	"fmt"
+)
+
+// Account types accepted by the transfer endpoints.
+const (
+	AccountTypeUnified    = "UNIFIED"
+	AccountTypeSpot       = "SPOT"
+	AccountTypeContract   = "CONTRACT"
+	AccountTypeFund       = "FUND"
+	AccountTypeOption     = "OPTION"
+	AccountTypeInvestment = "INVESTMENT"
+)
+
+// NewTransferID returns a random UUID (version 4) to be used as the transferId of a transfer.
+func NewTransferID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
@@ -0,0 +1,16 @@
+package asset
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTransferID(t *testing.T) {
+	uuid := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+	first, second := NewTransferID(), NewTransferID()
+	assert.Regexp(t, uuid, first)
+	assert.Regexp(t, uuid, second)
+	assert.NotEqual(t, first, second)
+}
@@ -214,11 +214,11 @@ type GetTransferableCoinResponse struct {
 
 // CreateInternalTransferRequest represents the payload for creating an internal transfer.
 type CreateInternalTransferRequest struct {
-	TransferID      string `json:"transferId"`      // Required: UUID, manually generate a UUID
+	TransferID      string `json:"transferId"`      // Optional: UUID, generated with NewTransferID when empty
 	Coin            string `json:"coin"`            // Required: Coin
 	Amount          string `json:"amount"`          // Required: Amount
-	FromAccountType string `json:"fromAccountType"` // Required: From account type
-	ToAccountType   string `json:"toAccountType"`   // Required: To account type
+	FromAccountType string `json:"fromAccountType"` // Required: From account type, e.g. AccountTypeFund
+	ToAccountType   string `json:"toAccountType"`   // Required: To account type, e.g. AccountTypeUnified
 }
 
 // CreateInternalTransferResponse represents the response from creating an internal transfer.
@@ -227,6 +227,7 @@ type CreateInternalTransferResponse struct {
 	RetMsg  string `json:"retMsg"`
 	Result  struct {
 		TransferID string `json:"transferId"` // UUID
+		Status     string `json:"status"`     // STATUS_UNKNOWN, SUCCESS or PENDING
 	} `json:"result"`
 	RetExtInfo any   `json:"retExtInfo"`
 	Time       int64 `json:"time"`
@@ -314,9 +315,19 @@ type GetSubUIDsResponse struct {
 	Time       int64 `json:"time"`
 }
 
+// IsTransferable reports whether uid is a sub UID with universal transfer enabled.
+func (r *GetSubUIDsResponse) IsTransferable(uid string) bool {
+	for _, id := range r.Result.TransferableSubMemberIds {
+		if id == uid {
+			return true
+		}
+	}
+	return false
+}
+
 // CreateUniversalTransferRequest represents the payload for creating a universal transfer.
 type CreateUniversalTransferRequest struct {
-	TransferID      string `json:"transferId"`      // Required: UUID, manually generate a UUID
+	TransferID      string `json:"transferId"`      // Optional: UUID, generated with NewTransferID when empty
 	Coin            string `json:"coin"`            // Required: Coin
 	Amount          string `json:"amount"`          // Required: Amount
 	FromMemberID    int    `json:"fromMemberId"`    // Required: From UID
@@ -331,10 +342,12 @@ type CreateUniversalTransferResponse struct {
 	RetMsg  string `json:"retMsg"`
 	Result  struct {
 		TransferID string `json:"transferId"` // UUID
+		Status     string `json:"status"`     // STATUS_UNKNOWN, SUCCESS or PENDING
 	} `json:"result"`
 	RetExtInfo any   `json:"retExtInfo"`
 	Time       int64 `json:"time"`
 }
+
 type GetAllowedDepositCoinInfoRequest struct {
 	Coin   *string `json:"coin,omitempty"`   // Optional: Coin. coin and chain must be paired if passed
 	Chain  *string `json:"chain,omitempty"`  // Optional: Chain. coin and chain must be paired if passed
@@ -469,6 +482,7 @@ type DepositChainInfo struct {
 	TagDeposit        string `json:"tagDeposit"`
 	Chain             string `json:"chain"`
 	BatchReleaseLimit string `json:"batchReleaseLimit"`
+	ContractAddress   string `json:"contractAddress"`
 }
 
 type GetMasterDepositAddressResponse struct {
@@ -499,8 +513,8 @@ type GetSubDepositAddressResponse struct {
 	RetCode int    `json:"retCode"`
 	RetMsg  string `json:"retMsg"`
 	Result  struct {
-		Coin   string                `json:"coin"`
-		Chains []SubDepositChainInfo `json:"chains"`
+		Coin   string              `json:"coin"`
+		Chains SubDepositChainInfo `json:"chains"` // A single chain, selected by chainType
 	} `json:"result"`
 	RetExtInfo any   `json:"retExtInfo"`
 	Time       int64 `json:"time"`
@@ -513,9 +527,21 @@ type CoinChainInfo struct {
 	DepositMin            string `json:"depositMin"`
 	WithdrawMin           string `json:"withdrawMin"`
 	MinAccuracy           string `json:"minAccuracy"`
-	ChainDeposit          string `json:"chainDeposit"`
-	ChainWithdraw         string `json:"chainWithdraw"`
+	ChainDeposit          string `json:"chainDeposit"`  // "1" when deposits are open
+	ChainWithdraw         string `json:"chainWithdraw"` // "1" when withdrawals are open
 	WithdrawPercentageFee string `json:"withdrawPercentageFee"`
+	SafeConfirmNumber     string `json:"safeConfirmNumber"`
+	ContractAddress       string `json:"contractAddress"`
+}
+
+// CanDeposit reports whether deposits are currently open on the chain.
+func (c CoinChainInfo) CanDeposit() bool {
+	return c.ChainDeposit == "1"
+}
+
+// CanWithdraw reports whether withdrawals are currently open on the chain.
+func (c CoinChainInfo) CanWithdraw() bool {
+	return c.ChainWithdraw == "1"
 }
 
 type CoinInfoEntry struct {
@@ -525,6 +551,16 @@ type CoinInfoEntry struct {
 	Chains       []CoinChainInfo `json:"chains"`
 }
 
+// Chain returns the chain of the coin with the given chain or chainType name.
+func (c CoinInfoEntry) Chain(name string) (CoinChainInfo, bool) {
+	for _, chain := range c.Chains {
+		if chain.Chain == name || chain.ChainType == name {
+			return chain, true
+		}
+	}
+	return CoinChainInfo{}, false
+}
+
 type GetCoinInfoResponse struct {
 	RetCode int    `json:"retCode"`
 	RetMsg  string `json:"retMsg"`
@@ -558,6 +594,7 @@ type WithdrawalRecord struct {
 	Tag          string `json:"tag"`
 	CreateTime   string `json:"createTime"`
 	UpdateTime   string `json:"updateTime"`
+	RequestID    string `json:"requestId"`
 }
 
 type GetWithdrawalRecordsResponse struct {
@@ -586,7 +623,7 @@ type GetWithdrawableAmountResponse struct {
 	RetMsg  string `json:"retMsg"`
 	Result  struct {
 		LimitAmountUsd     string                              `json:"limitAmountUsd"`
-		WithdrawableAmount map[string]WalletWithdrawableAmount `json:"withdrawableAmount"`
+		WithdrawableAmount map[string]WalletWithdrawableAmount `json:"withdrawableAmount"` // Keyed by wallet: SPOT, FUND or UTA
 	} `json:"result"`
 	RetExtInfo any   `json:"retExtInfo"`
 	Time       int64 `json:"time"`
@@ -597,8 +634,8 @@ type WithdrawRequest struct {
 	Address     string  `json:"address"`               // Required
 	Tag         *string `json:"tag,omitempty"`         // Optional based on the address
 	Amount      string  `json:"amount"`                // Required
-	Timestamp   int64   `json:"timestamp"`             // Required
-	ForceChain  *int    `json:"forceChain,omitempty"`  // Optional
+	Timestamp   int64   `json:"timestamp"`             // Optional: ms, defaults to now
+	ForceChain  *int    `json:"forceChain,omitempty"`  // Optional: 0 internal transfer allowed, 1 force on-chain, 2 UID withdrawal
 	AccountType *string `json:"accountType,omitempty"` // Optional
 	FeeType     *int    `json:"feeType,omitempty"`     // Optional
 	RequestID   *string `json:"requestId,omitempty"`   // Optional for idempotency
@@ -0,0 +1,74 @@
+package broker
+
+import (
+	"fmt"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+)
+
+// Broker defines the interface for the exchange broker endpoints of the Bybit API.
+type Broker interface {
+	// GetEarnings retrieves the broker earnings and the per-execution details of the sub accounts.
+	// req: GetEarningsRequest - the business type, date range, sub account and paging of the query.
+	// returns: *EarningsResponse - the earnings of all pages, or of one page when a cursor is given.
+	//          error - an error if any request fails.
+	GetEarnings(req *GetEarningsRequest) (*EarningsResponse, error)
+}
+
+type impl struct {
+	client *client.Client
+}
+
+// New creates a new instance of the Broker interface, which can be used to interact with the Bybit API.
+func New(c *client.Client) Broker {
+	return &impl{client: c}
+}
+
+// GetEarnings retrieves the broker earnings. When no cursor is supplied every page is fetched and
+// the details are merged into a single response; the totals are those of the first page, which
+// cover the whole query.
+func (i *impl) GetEarnings(req *GetEarningsRequest) (*EarningsResponse, error) {
+	if req.Limit != nil && (*req.Limit < 1 || *req.Limit > MaxEarningsLimit) {
+		return nil, fmt.Errorf("limit must be between 1 and %d", MaxEarningsLimit)
+	}
+	if req.BizType != nil {
+		switch *req.BizType {
+		case BizTypeSpot, BizTypeDerivatives, BizTypeOptions, BizTypeConvert:
+		default:
+			return nil, fmt.Errorf("invalid bizType %q", *req.BizType)
+		}
+	}
+
+	followCursor := req.Cursor == nil
+	page := *req
+	var merged *EarningsResponse
+
+	for {
+		response, err := i.client.Get("/v5/broker/earnings-info", ConvertGetEarningsRequestToParams(&page))
+		if err != nil {
+			return nil, fmt.Errorf("error fetching broker earnings: %w", err)
+		}
+		var earningsResponse EarningsResponse
+		if err := response.Unmarshal(&earningsResponse); err != nil {
+			return nil, fmt.Errorf("error parsing broker earnings response: %w", err)
+		}
+		if earningsResponse.RetCode != 0 {
+			return &earningsResponse, fmt.Errorf("API returned error: %s", earningsResponse.RetMsg)
+		}
+
+		if merged == nil {
+			merged = &earningsResponse
+		} else {
+			merged.Result.Details = append(merged.Result.Details, earningsResponse.Result.Details...)
+			merged.Result.NextPageCursor = earningsResponse.Result.NextPageCursor
+			merged.Time = earningsResponse.Time
+		}
+		if !followCursor || earningsResponse.Result.NextPageCursor == "" {
+			break
+		}
+		cursor := earningsResponse.Result.NextPageCursor
+		page.Cursor = &cursor
+	}
+
+	return merged, nil
+}
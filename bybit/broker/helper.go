@@ -0,0 +1,51 @@
+package broker
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+	"github.com/cploutarchou/crypto-sdk-suite/decimal"
+)
+
+func ConvertGetEarningsRequestToParams(req *GetEarningsRequest) client.Params {
+	params := make(client.Params)
+	if req.BizType != nil {
+		params["bizType"] = *req.BizType
+	}
+	if req.Begin != nil {
+		params["begin"] = *req.Begin
+	}
+	if req.End != nil {
+		params["end"] = *req.End
+	}
+	if req.UID != nil {
+		params["uid"] = *req.UID
+	}
+	if req.Limit != nil {
+		params["limit"] = strconv.Itoa(*req.Limit)
+	}
+	if req.Cursor != nil {
+		params["cursor"] = *req.Cursor
+	}
+	return params
+}
+
+// ByUID sums the earning details per sub account UID and coin, which is the breakdown rebate
+// reports are usually built from.
+func (r *EarningsResponse) ByUID() (map[string]map[string]decimal.Decimal, error) {
+	totals := make(map[string]map[string]decimal.Decimal)
+	for _, detail := range r.Result.Details {
+		earning, err := decimal.NewFromString(detail.Earning)
+		if err != nil {
+			return nil, fmt.Errorf("invalid earning %q for uid %s: %w", detail.Earning, detail.UserID, err)
+		}
+		coins, ok := totals[detail.UserID]
+		if !ok {
+			coins = make(map[string]decimal.Decimal)
+			totals[detail.UserID] = coins
+		}
+		coins[detail.Coin] = coins[detail.Coin].Add(earning)
+	}
+	return totals, nil
+}
@@ -0,0 +1,28 @@
+package broker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEarningsByUID(t *testing.T) {
+	var resp EarningsResponse
+	resp.Result.Details = []EarningDetail{
+		{UserID: "1001", Coin: "USDT", Earning: "0.25"},
+		{UserID: "1001", Coin: "USDT", Earning: "0.5"},
+		{UserID: "1001", Coin: "BTC", Earning: "0.00001"},
+		{UserID: "1002", Coin: "USDT", Earning: "1"},
+	}
+
+	totals, err := resp.ByUID()
+	require.NoError(t, err)
+	assert.Equal(t, "0.75", totals["1001"]["USDT"].String())
+	assert.Equal(t, "0.00001", totals["1001"]["BTC"].String())
+	assert.Equal(t, "1", totals["1002"]["USDT"].String())
+
+	resp.Result.Details = append(resp.Result.Details, EarningDetail{UserID: "1003", Earning: "n/a"})
+	_, err = resp.ByUID()
+	assert.Error(t, err)
+}
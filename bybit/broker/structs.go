@@ -0,0 +1,61 @@
+package broker
+
+// MaxEarningsLimit is the largest page size accepted by the earnings endpoint.
+const MaxEarningsLimit = 1000
+
+// Business types of broker earnings.
+const (
+	BizTypeSpot        = "SPOT"
+	BizTypeDerivatives = "DERIVATIVES"
+	BizTypeOptions     = "OPTIONS"
+	BizTypeConvert     = "CONVERT"
+)
+
+// GetEarningsRequest represents the query parameters for the broker earnings.
+type GetEarningsRequest struct {
+	BizType *string `json:"bizType,omitempty"` // Optional: BizTypeSpot, BizTypeDerivatives, BizTypeOptions or BizTypeConvert
+	Begin   *string `json:"begin,omitempty"`   // Optional: Begin date in UTC+0, format yyyymmdd
+	End     *string `json:"end,omitempty"`     // Optional: End date in UTC+0, format yyyymmdd; at most 30 days after begin
+	UID     *string `json:"uid,omitempty"`     // Optional: Sub account UID
+	Limit   *int    `json:"limit,omitempty"`   // Optional: Limit for data size per page, at most 1000
+	Cursor  *string `json:"cursor,omitempty"`  // Optional: Cursor of a previous page; disables automatic paging
+}
+
+// CoinEarning is the earning in a single coin.
+type CoinEarning struct {
+	Coin    string `json:"coin"`
+	Earning string `json:"earning"`
+}
+
+// EarningDetail is the earning from a single execution of a sub account.
+type EarningDetail struct {
+	UserID         string `json:"userId"`
+	BizType        string `json:"bizType"`
+	Symbol         string `json:"symbol"`
+	Coin           string `json:"coin"`
+	Earning        string `json:"earning"`
+	MarkupEarning  string `json:"markupEarning"`
+	BaseFeeEarning string `json:"baseFeeEarning"`
+	OrderID        string `json:"orderId"`
+	ExecID         string `json:"execId"`
+	ExecTime       string `json:"execTime"`
+}
+
+// EarningsResponse represents the response from querying the broker earnings.
+type EarningsResponse struct {
+	RetCode int    `json:"retCode"`
+	RetMsg  string `json:"retMsg"`
+	Result  struct {
+		TotalEarningCat struct {
+			Spot        []CoinEarning `json:"spot"`
+			Derivatives []CoinEarning `json:"derivatives"`
+			Options     []CoinEarning `json:"options"`
+			Convert     []CoinEarning `json:"convert"`
+			Total       []CoinEarning `json:"total"`
+		} `json:"totalEarningCat"`
+		Details        []EarningDetail `json:"details"`
+		NextPageCursor string          `json:"nextPageCursor"`
+	} `json:"result"`
+	RetExtInfo any   `json:"retExtInfo"`
+	Time       int64 `json:"time"`
+}
@@ -2,11 +2,23 @@ package bybit
 
 import (
 	"github.com/cploutarchou/crypto-sdk-suite/bybit/account"
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/announcement"
 	"github.com/cploutarchou/crypto-sdk-suite/bybit/asset"
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/asset/convert"
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/broker"
 	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/copytrading"
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/cryptoloan"
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/earn"
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/insloan"
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/lt"
 	"github.com/cploutarchou/crypto-sdk-suite/bybit/market"
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/p2p"
 	"github.com/cploutarchou/crypto-sdk-suite/bybit/position"
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/spotmargin"
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/system"
 	"github.com/cploutarchou/crypto-sdk-suite/bybit/trade"
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/user"
 	"github.com/cploutarchou/crypto-sdk-suite/bybit/ws"
 	wsCli "github.com/cploutarchou/crypto-sdk-suite/bybit/ws/client"
 )
@@ -18,20 +30,46 @@ type Bybit interface {
 	Trade() trade.Trade
 	Position() position.Position
 	Asset() asset.Asset
+	Convert() convert.Convert
+	User() user.User
+	LeverageToken() lt.LeverageToken
+	SpotMargin() spotmargin.SpotMargin
+	InsLoan() insloan.InsLoan
+	CryptoLoan() cryptoloan.CryptoLoan
+	Earn() earn.Earn
+	CopyTrading() copytrading.CopyTrading
+	P2P() p2p.P2P
+	System() system.System
+	Broker() broker.Broker
+	Announcements() announcement.Announcements
+	Stream() *Stream
 }
 
 type bybitImpl struct {
-	market     market.Market
-	client     *client.Client
-	isTestNet  bool
-	webSocketP ws.WebSocket
-	apiKey     string
-	secretKey  string
-	account    account.Account
-	trade      trade.Trade
-	position   position.Position
-	asset      asset.Asset
-	webSocket  ws.WebSocket
+	market        market.Market
+	client        *client.Client
+	isTestNet     bool
+	webSocketP    ws.WebSocket
+	apiKey        string
+	secretKey     string
+	account       account.Account
+	trade         trade.Trade
+	position      position.Position
+	asset         asset.Asset
+	convert       convert.Convert
+	user          user.User
+	lt            lt.LeverageToken
+	spotMargin    spotmargin.SpotMargin
+	insLoan       insloan.InsLoan
+	cryptoLoan    cryptoloan.CryptoLoan
+	earn          earn.Earn
+	copyTrading   copytrading.CopyTrading
+	p2p           p2p.P2P
+	system        system.System
+	broker        broker.Broker
+	announcements announcement.Announcements
+	webSocket     ws.WebSocket
+	stream        *Stream
 }
 
 func New(key, secretKey string, isTestNet bool, category string) Bybit {
@@ -46,16 +84,32 @@ func New(key, secretKey string, isTestNet bool, category string) Bybit {
 	}
 
 	by := &bybitImpl{
-		market:    market.New(c),
-		account:   account.New(c),
-		trade:     trade.New(c),
-		position:  position.New(c),
-		asset:     asset.New(c),
-		client:    c,
-		isTestNet: isTestNet,
-		apiKey:    key,
-		secretKey: secretKey,
-		webSocket: ws.New(publicClient, privateClient, isTestNet),
+		market:        market.New(c),
+		account:       account.New(c),
+		trade:         trade.New(c),
+		position:      position.New(c),
+		asset:         asset.New(c),
+		convert:       convert.New(c),
+		user:          user.New(c),
+		lt:            lt.New(c),
+		spotMargin:    spotmargin.New(c),
+		insLoan:       insloan.New(c),
+		cryptoLoan:    cryptoloan.New(c),
+		earn:          earn.New(c),
+		copyTrading:   copytrading.New(c),
+		p2p:           p2p.New(c),
+		system:        system.New(c),
+		broker:        broker.New(c),
+		announcements: announcement.New(c),
+		client:        c,
+		isTestNet:     isTestNet,
+		apiKey:        key,
+		secretKey:     secretKey,
+		webSocket:     ws.New(publicClient, privateClient, isTestNet),
+	}
+	by.stream, err = NewStream(key, secretKey, isTestNet, category)
+	if err != nil {
+		panic(err)
 	}
 	return by
 }
@@ -107,3 +161,107 @@ func (b *bybitImpl) Position() position.Position {
 func (b *bybitImpl) Asset() asset.Asset {
 	return b.asset
 }
+
+// Convert returns the Convert interface for coin conversion operations.
+//
+// No parameters.
+// Returns a convert.Convert interface.
+func (b *bybitImpl) Convert() convert.Convert {
+	return b.convert
+}
+
+// User returns the User interface for sub account and API key management.
+//
+// No parameters.
+// Returns a user.User interface.
+func (b *bybitImpl) User() user.User {
+	return b.user
+}
+
+// LeverageToken returns the LeverageToken interface for spot leverage token operations.
+//
+// No parameters.
+// Returns an lt.LeverageToken interface.
+func (b *bybitImpl) LeverageToken() lt.LeverageToken {
+	return b.lt
+}
+
+// SpotMargin returns the SpotMargin interface for spot margin trade operations.
+//
+// No parameters.
+// Returns a spotmargin.SpotMargin interface.
+func (b *bybitImpl) SpotMargin() spotmargin.SpotMargin {
+	return b.spotMargin
+}
+
+// InsLoan returns the InsLoan interface for institutional lending operations.
+//
+// No parameters.
+// Returns an insloan.InsLoan interface.
+func (b *bybitImpl) InsLoan() insloan.InsLoan {
+	return b.insLoan
+}
+
+// CryptoLoan returns the CryptoLoan interface for crypto loan operations.
+//
+// No parameters.
+// Returns a cryptoloan.CryptoLoan interface.
+func (b *bybitImpl) CryptoLoan() cryptoloan.CryptoLoan {
+	return b.cryptoLoan
+}
+
+// Earn returns the Earn interface for earn product operations.
+//
+// No parameters.
+// Returns an earn.Earn interface.
+func (b *bybitImpl) Earn() earn.Earn {
+	return b.earn
+}
+
+// CopyTrading returns the CopyTrading interface for copy trading master accounts.
+//
+// No parameters.
+// Returns a copytrading.CopyTrading interface.
+func (b *bybitImpl) CopyTrading() copytrading.CopyTrading {
+	return b.copyTrading
+}
+
+// P2P returns the P2P interface for P2P ads, orders and chat.
+//
+// No parameters.
+// Returns a p2p.P2P interface.
+func (b *bybitImpl) P2P() p2p.P2P {
+	return b.p2p
+}
+
+// System returns the System interface for platform status and maintenance windows.
+//
+// No parameters.
+// Returns a system.System interface.
+func (b *bybitImpl) System() system.System {
+	return b.system
+}
+
+// Broker returns the Broker interface for exchange broker operations.
+//
+// No parameters.
+// Returns a broker.Broker interface.
+func (b *bybitImpl) Broker() broker.Broker {
+	return b.broker
+}
+
+// Announcements returns the Announcements interface for platform announcements.
+//
+// No parameters.
+// Returns an announcement.Announcements interface.
+func (b *bybitImpl) Announcements() announcement.Announcements {
+	return b.announcements
+}
+
+// Stream returns the session manager that multiplexes public and private WebSocket topics.
+//
+// No parameters.
+// Returns a *Stream.
+func (b *bybitImpl) Stream() *Stream {
+	return b.stream
+}
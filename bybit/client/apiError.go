@@ -0,0 +1,13 @@
+package client
+
+import "fmt"
+
+// APIError is a non-zero retCode returned by the Bybit API.
+type APIError struct {
+	Code int
+	Msg  string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API returned error: %s (retCode %d)", e.Msg, e.Code)
+}
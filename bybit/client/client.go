@@ -12,6 +12,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"sync/atomic"
 	"time"
 
 	"golang.org/x/time/rate"
@@ -21,6 +22,7 @@ const (
 	recvWindow            = "5000"
 	BaseURL               = "https://api.bybit.com"
 	TestnetBaseURL        = "https://api-testnet.bybit.com"
+	DemoBaseURL           = "https://api-demo.bybit.com"
 	APIVersion            = "v5"
 	GET            Method = "GET"
 	POST           Method = "POST"
@@ -44,9 +46,10 @@ type Client struct {
 	secretKey       string
 	httpClient      *http.Client
 	IsTestNet       bool
-	params          []byte
-	QueryParams     url.Values
+	IsDemo          bool   // Demo trading keys only work against DemoBaseURL
+	baseURL         string // overrides the environment's base URL when set, see NewClientWithBaseURL
 	endpointLimiter *EndpointRateLimiter
+	timeOffset      atomic.Int64 // server minus local clock in nanoseconds, see SyncTime
 }
 
 // Define HTTP method types as strings
@@ -92,6 +95,22 @@ func NewClient(key, secretKey string, isTestnet bool) *Client {
 	return client
 }
 
+// NewDemoClient creates a client for the demo trading environment. Demo trading runs on mainnet
+// market data with virtual funds and needs API keys created in demo trading mode.
+func NewDemoClient(key, secretKey string) *Client {
+	client := NewClient(key, secretKey, false)
+	client.IsDemo = true
+	return client
+}
+
+// NewClientWithBaseURL creates a client that sends requests to baseURL instead of BaseURL, e.g.
+// through a proxy.
+func NewClientWithBaseURL(key, secretKey, baseURL string) *Client {
+	client := NewClient(key, secretKey, false)
+	client.baseURL = baseURL
+	return client
+}
+
 // Get method performs a GET request to the specified API path with params
 func (c *Client) Get(path string, params Params) (Response, error) {
 	return c.doRequest(GET, path, params)
@@ -134,25 +153,31 @@ func (c *Client) doRequest(method Method, path string, params Params) (Response,
 	return c.do(req)
 }
 
-// do handles the actual execution of the HTTP request
+// do handles the actual execution of the HTTP request. The query string or body is built per
+// request and passed to the signer, so the client is safe for concurrent use.
 func (c *Client) do(req *Request) (Response, error) {
-	c.QueryParams = make(url.Values)
 	baseURL := BaseURL
-	if c.IsTestNet {
+	switch {
+	case c.baseURL != "":
+		baseURL = c.baseURL
+	case c.IsDemo:
+		baseURL = DemoBaseURL
+	case c.IsTestNet:
 		baseURL = TestnetBaseURL
 	}
 
 	var (
 		httpReq *http.Request
+		payload string
 		err     error
 	)
 
 	// Prepare the GET or POST request based on the method
 	switch req.method {
 	case GET:
-		httpReq, err = c.newGETRequest(baseURL, req)
+		httpReq, payload, err = c.newGETRequest(baseURL, req)
 	case POST:
-		httpReq, err = c.newPOSTRequest(baseURL, req)
+		httpReq, payload, err = c.newPOSTRequest(baseURL, req)
 	default:
 		return nil, errors.New("unsupported method")
 	}
@@ -162,7 +187,7 @@ func (c *Client) do(req *Request) (Response, error) {
 	}
 
 	// Set common headers for the request
-	c.setCommonHeaders(httpReq)
+	c.setCommonHeaders(httpReq, payload)
 
 	// Execute the request
 	resp, err := c.httpClient.Do(httpReq)
@@ -174,41 +199,42 @@ func (c *Client) do(req *Request) (Response, error) {
 	// Process and return the response
 	return NewResponse(resp), nil
 }
-func (c *Client) newGETRequest(baseURL string, req *Request) (*http.Request, error) {
-	c.QueryParams = url.Values{}
+
+func (c *Client) newGETRequest(baseURL string, req *Request) (*http.Request, string, error) {
+	query := url.Values{}
 	for k, v := range req.params {
-		c.QueryParams.Set(k, fmt.Sprintf("%v", v))
+		query.Set(k, fmt.Sprintf("%v", v))
 	}
+	queryString := query.Encode() // Automatically sorts the parameters alphabetically
 
-	return http.NewRequest(string(GET), baseURL+req.path+"?"+c.QueryParams.Encode(), http.NoBody)
+	httpReq, err := http.NewRequest(string(GET), baseURL+req.path+"?"+queryString, http.NoBody)
+	return httpReq, queryString, err
 }
 
-func (c *Client) newPOSTRequest(baseURL string, req *Request) (*http.Request, error) {
+func (c *Client) newPOSTRequest(baseURL string, req *Request) (*http.Request, string, error) {
 	jsonData, err := json.Marshal(req.params)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
-	c.params = jsonData
-	return http.NewRequest(string(POST), baseURL+req.path, bytes.NewBuffer(jsonData))
+	httpReq, err := http.NewRequest(string(POST), baseURL+req.path, bytes.NewBuffer(jsonData))
+	return httpReq, string(jsonData), err
 }
-func (c *Client) setCommonHeaders(req *http.Request) {
-	timestamp := strconv.FormatInt(GetCurrentTime(), 10) // Get the current timestamp in milliseconds
+
+// setCommonHeaders signs the request. payload is the encoded query string for GET requests
+// and the JSON body for POST requests.
+func (c *Client) setCommonHeaders(req *http.Request, payload string) {
+	timestamp := strconv.FormatInt(c.timestamp(), 10) // Current timestamp in milliseconds, adjusted by SyncTime
 	req.Header.Set(signTypeKey, "2")
 	req.Header.Set(apiRequestKey, c.key)
 	req.Header.Set(timestampKey, timestamp)
-	req.Header.Set(recvWindowKey, "5000") // Match Bybit's recvWindow of 5000 ms
-
-	var signatureBase []byte
+	req.Header.Set(recvWindowKey, recvWindow) // Match Bybit's recvWindow of 5000 ms
 	if req.Method == "POST" {
 		req.Header.Set("Content-Type", "application/json")
-		// Concatenate timestamp, API key, recvWindow, and the request body for POST requests
-		signatureBase = []byte(timestamp + c.key + "5000" + string(c.params))
-	} else {
-		// Alphabetically sort query parameters and concatenate them with other fields for GET requests
-		queryString := c.QueryParams.Encode() // Automatically sorts the parameters alphabetically
-		signatureBase = []byte(timestamp + c.key + "5000" + queryString)
 	}
 
+	// Concatenate timestamp, API key, recvWindow and the query string or request body
+	signatureBase := []byte(timestamp + c.key + recvWindow + payload)
+
 	// Generate the HMAC-SHA256 signature
 	hmac256 := hmac.New(sha256.New, []byte(c.secretKey))
 	hmac256.Write(signatureBase)
@@ -216,11 +242,6 @@ func (c *Client) setCommonHeaders(req *http.Request) {
 
 	// Set the signature in the headers
 	req.Header.Set(signatureKey, signature)
-
-	// Debug logging for troubleshooting
-	// 	log.Printf("Signature Base String: %s", string(signatureBase))
-	// 	log.Printf("Generated Signature: %s", signature)
-	// 	log.Printf("Headers: X-BAPI-API-KEY=%s, X-BAPI-TIMESTAMP=%s, X-BAPI-SIGN=%s", c.key, timestamp, signature)
 }
 func GetCurrentTime() int64 {
 	return time.Now().UnixNano() / int64(time.Millisecond)
@@ -0,0 +1,51 @@
+package client
+
+import "fmt"
+
+// Page exposes the fields of a cursor paged response that GetAllPages needs.
+type Page[R any] struct {
+	RetCode int
+	RetMsg  string
+	Cursor  *string
+	Rows    *[]R
+}
+
+// GetAllPages fetches path and, unless params already hold a cursor, follows nextPageCursor to
+// the last page, appending the rows of every page to the first response. fields returns the
+// paging fields of a decoded response and what names the records in errors. A non-zero retCode
+// stops paging and is returned as an error together with that page.
+func GetAllPages[P, R any](r Requester, path string, params Params, what string, fields func(*P) Page[R]) (*P, error) {
+	query := make(Params, len(params))
+	for k, v := range params {
+		query[k] = v
+	}
+	_, single := query["cursor"]
+
+	var first *P
+	for {
+		response, err := r.Get(path, query)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching %s: %w", what, err)
+		}
+		var current P
+		if err := response.Unmarshal(&current); err != nil {
+			return nil, fmt.Errorf("error parsing %s response: %w", what, err)
+		}
+		page := fields(&current)
+		if page.RetCode != 0 {
+			return &current, fmt.Errorf("API returned error: %s", page.RetMsg)
+		}
+
+		if first == nil {
+			first = &current
+		} else {
+			merged := fields(first)
+			*merged.Rows = append(*merged.Rows, *page.Rows...)
+			*merged.Cursor = *page.Cursor
+		}
+		if single || *page.Cursor == "" {
+			return first, nil
+		}
+		query["cursor"] = *page.Cursor
+	}
+}
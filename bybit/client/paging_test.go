@@ -0,0 +1,63 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testPage struct {
+	RetCode int    `json:"retCode"`
+	RetMsg  string `json:"retMsg"`
+	Result  struct {
+		List           []string `json:"list"`
+		NextPageCursor string   `json:"nextPageCursor"`
+	} `json:"result"`
+}
+
+func testPageFields(p *testPage) Page[string] {
+	return Page[string]{RetCode: p.RetCode, RetMsg: p.RetMsg, Cursor: &p.Result.NextPageCursor, Rows: &p.Result.List}
+}
+
+func TestGetAllPages(t *testing.T) {
+	var cursors []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cursor := r.URL.Query().Get("cursor")
+		cursors = append(cursors, cursor)
+		switch cursor {
+		case "", "first":
+			fmt.Fprint(w, `{"retCode":0,"result":{"list":["a","b"],"nextPageCursor":"second"}}`)
+		case "second":
+			fmt.Fprint(w, `{"retCode":0,"result":{"list":["c"],"nextPageCursor":""}}`)
+		default:
+			fmt.Fprint(w, `{"retCode":10001,"retMsg":"bad cursor"}`)
+		}
+	}))
+	defer server.Close()
+	c := NewClientWithBaseURL("key", "secret", server.URL)
+
+	params := Params{"coin": "USDT"}
+	res, err := GetAllPages(c, "/v5/test", params, "test records", testPageFields)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, res.Result.List)
+	assert.Empty(t, res.Result.NextPageCursor)
+	assert.Equal(t, []string{"", "second"}, cursors)
+	assert.NotContains(t, params, "cursor", "the caller's params must not be modified")
+
+	// A supplied cursor fetches that page only and returns the next cursor.
+	cursors = nil
+	res, err = GetAllPages(c, "/v5/test", Params{"cursor": "first"}, "test records", testPageFields)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, res.Result.List)
+	assert.Equal(t, "second", res.Result.NextPageCursor)
+	assert.Equal(t, []string{"first"}, cursors)
+
+	res, err = GetAllPages(c, "/v5/test", Params{"cursor": "bogus"}, "test records", testPageFields)
+	assert.EqualError(t, err, "API returned error: bad cursor")
+	require.NotNil(t, res)
+	assert.Equal(t, 10001, res.RetCode)
+}
@@ -1,6 +1,9 @@
 package client
 
 import (
+	"strings"
+	"sync"
+
 	"golang.org/x/time/rate"
 )
 
@@ -77,26 +80,54 @@ var endpointLimits = map[string]rate.Limit{
 	"POST /v5/spot-cross-margin-trade/switch":       rate.Limit(twentyPerMinute),
 }
 
+// sharedLimits apply one limiter to every endpoint under a path prefix. Bybit limits public
+// market data per IP rather than per endpoint, so concurrent market calls share a budget.
+var sharedLimits = map[string]rate.Limit{
+	"GET /v5/market/": rate.Limit(marketDataPerSecond),
+}
+
+const (
+	marketDataPerSecond = 50
+	marketDataBurst     = 10
+)
+
 type EndpointRateLimiter struct {
+	mu       sync.RWMutex
 	limiters map[string]*rate.Limiter
+	shared   map[string]*rate.Limiter
 }
 
 func NewEndpointRateLimiter() *EndpointRateLimiter {
-	return &EndpointRateLimiter{
+	e := &EndpointRateLimiter{
 		limiters: make(map[string]*rate.Limiter),
+		shared:   make(map[string]*rate.Limiter),
 	}
+	for prefix, limit := range sharedLimits {
+		e.shared[prefix] = rate.NewLimiter(limit, marketDataBurst)
+	}
+	return e
 }
 
 // SetLimiter updates or creates a rate limiter for a specific endpoint
 func (e *EndpointRateLimiter) SetLimiter(endpointKey string, limiter *rate.Limiter) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
 	e.limiters[endpointKey] = limiter
 }
 
-// GetLimiter retrieves an existing rate limiter for an endpoint, returning nil if not found
+// GetLimiter retrieves the rate limiter for an endpoint. Endpoints without their own limiter
+// use the shared limiter of their path prefix, falling back to a default limiter.
 func (e *EndpointRateLimiter) GetLimiter(endpointKey string) *rate.Limiter {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
 	if limiter, ok := e.limiters[endpointKey]; ok {
 		return limiter
 	}
+	for prefix, limiter := range e.shared {
+		if strings.HasPrefix(endpointKey, prefix) {
+			return limiter
+		}
+	}
 
 	// Set default rate limiter to 30 requests per minute
 	defaultRate := rate.Limit(30.0 / 60.0) // 30 requests per minute (30/60 = 0.5 requests per second)
@@ -0,0 +1,62 @@
+package client
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+type serverTimeResponse struct {
+	RetCode int    `json:"retCode"`
+	RetMsg  string `json:"retMsg"`
+	Result  struct {
+		TimeSecond string `json:"timeSecond"`
+		TimeNano   string `json:"timeNano"`
+	} `json:"result"`
+}
+
+// Time returns the current Bybit server time from /v5/market/time.
+func (c *Client) Time() (time.Time, error) {
+	res, err := c.Get(fmt.Sprintf("/%s/market/time", APIVersion), Params{})
+	if err != nil {
+		return time.Time{}, err
+	}
+	var serverTime serverTimeResponse
+	if err := res.Unmarshal(&serverTime); err != nil {
+		return time.Time{}, fmt.Errorf("error parsing server time: %w", err)
+	}
+	if serverTime.RetCode != 0 {
+		return time.Time{}, fmt.Errorf("API returned error: %s", serverTime.RetMsg)
+	}
+	nanos, err := strconv.ParseInt(serverTime.Result.TimeNano, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("error parsing server time: %w", err)
+	}
+	return time.Unix(0, nanos), nil
+}
+
+// SyncTime measures the offset between the local clock and the server clock and applies it to
+// the timestamp of every signed request, which avoids recv_window rejections on hosts with a
+// drifting clock. It returns the measured offset; a positive value means the server is ahead.
+func (c *Client) SyncTime() (time.Duration, error) {
+	sent := time.Now()
+	serverTime, err := c.Time()
+	if err != nil {
+		return 0, err
+	}
+	received := time.Now()
+	midpoint := sent.Add(received.Sub(sent) / 2)
+	offset := serverTime.Sub(midpoint)
+	c.timeOffset.Store(int64(offset))
+	return offset, nil
+}
+
+// TimeOffset returns the server clock offset applied to signed requests, as measured by SyncTime.
+func (c *Client) TimeOffset() time.Duration {
+	return time.Duration(c.timeOffset.Load())
+}
+
+// timestamp returns the request timestamp in milliseconds, corrected by the synced offset.
+func (c *Client) timestamp() int64 {
+	return time.Now().Add(c.TimeOffset()).UnixMilli()
+}
@@ -0,0 +1,458 @@
+package bybit
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/account"
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/market"
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/position"
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/trade"
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/ws/public/ticker"
+	"github.com/cploutarchou/crypto-sdk-suite/decimal"
+	"github.com/cploutarchou/crypto-sdk-suite/exchange"
+)
+
+// Connector implements exchange.Exchange for one Bybit category, e.g. linear perpetuals or
+// spot. Balances are read from the unified trading account.
+type Connector struct {
+	category market.Category
+	market   market.Market
+	trade    trade.Trade
+	account  account.Account
+	position position.Position
+	stream   *Stream
+
+	mu      sync.Mutex
+	tickers map[string]exchange.Ticker
+
+	// SettleCoins are the settle coins OpenOrders and Positions query for linear contracts when no
+	// symbol is given, since Bybit requires one of symbol or settleCoin. DefaultSettleCoins is used
+	// when it is empty.
+	SettleCoins []string
+}
+
+// DefaultSettleCoins are the settle coins of Bybit's linear contracts.
+var DefaultSettleCoins = []string{"USDT", "USDC"}
+
+var _ exchange.Exchange = (*Connector)(nil)
+
+// NewConnector adapts b to the exchange-neutral interfaces for category. The category should
+// match the one b's stream was created with.
+func NewConnector(b Bybit, category market.Category) *Connector {
+	return &Connector{
+		category: category,
+		market:   b.Market(),
+		trade:    b.Trade(),
+		account:  b.Account(),
+		position: b.Position(),
+		stream:   b.Stream(),
+		tickers:  make(map[string]exchange.Ticker),
+	}
+}
+
+func (c *Connector) Name() string {
+	return "bybit"
+}
+
+func (c *Connector) Ticker(symbol string) (*exchange.Ticker, error) {
+	res, err := c.market.GetTickers(&market.TickersRequest{Category: c.category, Symbol: &symbol})
+	if err != nil {
+		return nil, err
+	}
+	for _, info := range res.Result.List {
+		if info.Symbol != symbol {
+			continue
+		}
+		var p exchange.FieldParser
+		t := exchange.Ticker{
+			Symbol:    symbol,
+			Last:      p.Decimal("lastPrice", info.LastPrice),
+			Bid:       p.Decimal("bid1Price", info.Bid1Price),
+			Ask:       p.Decimal("ask1Price", info.Ask1Price),
+			Volume24h: p.Decimal("volume24h", info.Volume24H),
+			Time:      time.UnixMilli(res.Time),
+		}
+		return &t, p.Err
+	}
+	return nil, fmt.Errorf("no ticker for %s", symbol)
+}
+
+func (c *Connector) OrderBook(symbol string, depth int) (*exchange.OrderBook, error) {
+	res, err := c.market.GetOrderbook(&market.OrderbookRequest{Category: c.category, Symbol: symbol, Limit: &depth})
+	if err != nil {
+		return nil, err
+	}
+	var p exchange.FieldParser
+	book := &exchange.OrderBook{
+		Symbol: symbol,
+		Bids:   levels(&p, res.Result.Bids),
+		Asks:   levels(&p, res.Result.Asks),
+		Time:   time.UnixMilli(res.Result.TS),
+	}
+	return book, p.Err
+}
+
+func levels(p *exchange.FieldParser, rows []market.OrderbookLevel) []exchange.PriceLevel {
+	out := make([]exchange.PriceLevel, len(rows))
+	for i, row := range rows {
+		out[i] = exchange.PriceLevel{Price: p.Decimal("price", row.Price), Qty: p.Decimal("size", row.Size)}
+	}
+	return out
+}
+
+// candleIntervals maps the durations Candles accepts to Bybit kline intervals.
+var candleIntervals = map[time.Duration]market.Interval{
+	time.Minute:         market.Interval1m,
+	3 * time.Minute:     market.Interval3m,
+	5 * time.Minute:     market.Interval5m,
+	15 * time.Minute:    market.Interval15m,
+	30 * time.Minute:    market.Interval30m,
+	time.Hour:           market.Interval1h,
+	2 * time.Hour:       market.Interval2h,
+	4 * time.Hour:       market.Interval4h,
+	6 * time.Hour:       market.Interval6h,
+	12 * time.Hour:      market.Interval12h,
+	24 * time.Hour:      market.Interval1d,
+	7 * 24 * time.Hour:  market.Interval1w,
+	30 * 24 * time.Hour: market.Interval1M,
+}
+
+func (c *Connector) Candles(symbol string, interval time.Duration, limit int) ([]exchange.Candle, error) {
+	bybitInterval, ok := candleIntervals[interval]
+	if !ok {
+		return nil, fmt.Errorf("%w: candle interval %s", exchange.ErrNotSupported, interval)
+	}
+	candles, err := c.market.GetCandles(&market.KlineRequest{Category: c.category, Symbol: symbol, Interval: bybitInterval, Limit: &limit})
+	if err != nil {
+		return nil, err
+	}
+	// Bybit returns the newest candle first.
+	out := make([]exchange.Candle, len(candles))
+	for i, candle := range candles {
+		out[len(candles)-1-i] = exchange.Candle{
+			Start:  candle.Start,
+			Open:   candle.Open,
+			High:   candle.High,
+			Low:    candle.Low,
+			Close:  candle.Close,
+			Volume: candle.Volume,
+		}
+	}
+	return out, nil
+}
+
+func (c *Connector) Instruments() ([]exchange.Instrument, error) {
+	res, err := c.market.GetInstrumentsInfo(&market.InstrumentsInfoRequest{Category: c.category})
+	if err != nil {
+		return nil, err
+	}
+	var p exchange.FieldParser
+	out := make([]exchange.Instrument, 0, len(res.Result.List))
+	for _, info := range res.Result.List {
+		lot := info.LotSizeFilter
+		instrument := exchange.Instrument{
+			Symbol:      info.Symbol,
+			Base:        info.BaseCoin,
+			Quote:       info.QuoteCoin,
+			TickSize:    p.Decimal("tickSize", info.PriceFilter.TickSize),
+			QtyStep:     p.Decimal("qtyStep", lot.QtyStep),
+			MinQty:      p.Decimal("minOrderQty", lot.MinOrderQty),
+			MinNotional: p.Decimal("minNotionalValue", lot.MinNotionalValue),
+		}
+		if c.category == market.CategorySpot {
+			instrument.QtyStep = p.Decimal("basePrecision", lot.BasePrecision)
+			instrument.MinNotional = p.Decimal("minOrderAmt", lot.MinOrderAmt)
+		}
+		out = append(out, instrument)
+	}
+	return out, p.Err
+}
+
+func (c *Connector) PlaceOrder(req exchange.OrderRequest) (*exchange.Order, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	var builder *trade.OrderBuilder
+	if req.Type == exchange.Limit {
+		builder = trade.NewLimitOrder(req.Symbol).PriceDecimal(req.Price)
+		if req.TimeInForce != "" {
+			builder.TimeInForce(trade.TimeInForce(req.TimeInForce))
+		}
+	} else {
+		builder = trade.NewMarketOrder(req.Symbol)
+		if c.category == market.CategorySpot {
+			builder.MarketUnit(trade.MarketUnitBaseCoin)
+		}
+	}
+	builder.Category(c.category).Side(trade.Side(req.Side)).QtyDecimal(req.Qty)
+	if req.ClientOrderID != "" {
+		builder.OrderLinkID(req.ClientOrderID)
+	}
+	if req.ReduceOnly {
+		builder.ReduceOnly()
+	}
+	order, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.trade.PlaceOrder(order)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	return &exchange.Order{
+		ID:            res.Result.OrderID,
+		ClientOrderID: res.Result.OrderLinkID,
+		Symbol:        req.Symbol,
+		Side:          req.Side,
+		Type:          req.Type,
+		Status:        exchange.StatusNew,
+		Price:         req.Price,
+		Qty:           req.Qty,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}, nil
+}
+
+func (c *Connector) CancelOrder(symbol, orderID string) error {
+	_, err := c.trade.CancelOrder(&trade.CancelOrderRequest{Category: c.category, Symbol: symbol, OrderID: &orderID})
+	return neutralError(err)
+}
+
+func (c *Connector) GetOrder(symbol, orderID string) (*exchange.Order, error) {
+	open, err := c.trade.GetOpenOrders(&trade.GetOpenOrdersRequest{Category: c.category, Symbol: &symbol, OrderID: &orderID})
+	if err != nil {
+		return nil, err
+	}
+	list := open.Result.List
+	if len(list) == 0 {
+		history, err := c.trade.GetOrderHistory(&trade.GetOrderHistoryRequest{Category: c.category, Symbol: &symbol, OrderID: &orderID})
+		if err != nil {
+			return nil, err
+		}
+		list = history.Result.List
+	}
+	for _, details := range list {
+		if details.OrderID == orderID {
+			return convertOrder(details)
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", exchange.ErrOrderNotFound, orderID)
+}
+
+func (c *Connector) OpenOrders(symbol string) ([]exchange.Order, error) {
+	out := make([]exchange.Order, 0)
+	for _, settleCoin := range c.settleCoins(symbol) {
+		req := &trade.GetOpenOrdersRequest{Category: c.category, SettleCoin: settleCoin}
+		if symbol != "" {
+			req.Symbol = &symbol
+		}
+		res, err := c.trade.GetOpenOrders(req)
+		if err != nil {
+			return nil, err
+		}
+		for _, details := range res.Result.List {
+			order, err := convertOrder(details)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, *order)
+		}
+	}
+	return out, nil
+}
+
+// settleCoins returns the settle coins to query for symbol, or a single nil when none is needed.
+func (c *Connector) settleCoins(symbol string) []*string {
+	if symbol != "" || c.category != market.CategoryLinear {
+		return []*string{nil}
+	}
+	coins := c.SettleCoins
+	if len(coins) == 0 {
+		coins = DefaultSettleCoins
+	}
+	out := make([]*string, len(coins))
+	for i := range coins {
+		out[i] = &coins[i]
+	}
+	return out
+}
+
+// neutralError adds exchange.ErrOrderNotFound to Bybit's order-not-found errors.
+func neutralError(err error) error {
+	if errors.Is(err, trade.ErrOrderNotFound) || errors.Is(err, trade.ErrOrderAlreadyClosed) {
+		return fmt.Errorf("%w: %w", exchange.ErrOrderNotFound, err)
+	}
+	return err
+}
+
+func convertOrder(details trade.OrderDetails) (*exchange.Order, error) {
+	var p exchange.FieldParser
+	order := &exchange.Order{
+		ID:            details.OrderID,
+		ClientOrderID: details.OrderLinkID,
+		Symbol:        details.Symbol,
+		Side:          exchange.Side(details.Side),
+		Type:          exchange.OrderType(details.OrderType),
+		Status:        convertStatus(details.OrderStatus),
+		Price:         p.Decimal("price", details.Price),
+		Qty:           p.Decimal("qty", details.Qty),
+		FilledQty:     p.Decimal("cumExecQty", details.CumExecQty),
+		AvgPrice:      p.Decimal("avgPrice", details.AvgPrice),
+		CreatedAt:     p.UnixMilli("createdTime", details.CreatedTime),
+		UpdatedAt:     p.UnixMilli("updatedTime", details.UpdatedTime),
+	}
+	if p.Err != nil {
+		return nil, p.Err
+	}
+	return order, nil
+}
+
+func convertStatus(status trade.OrderStatus) exchange.OrderStatus {
+	switch status {
+	case trade.OrderStatusPartiallyFilled:
+		return exchange.StatusPartiallyFilled
+	case trade.OrderStatusFilled:
+		return exchange.StatusFilled
+	case trade.OrderStatusCancelled, trade.OrderStatusPartiallyFilledCanceled, trade.OrderStatusDeactivated:
+		return exchange.StatusCancelled
+	case trade.OrderStatusRejected:
+		return exchange.StatusRejected
+	}
+	// New, Untriggered and Triggered orders are all still waiting to fill.
+	return exchange.StatusNew
+}
+
+func (c *Connector) Balances() ([]exchange.Balance, error) {
+	res, err := c.account.Wallet().GetWalletBalance(account.Unified)
+	if err != nil {
+		return nil, err
+	}
+	var p exchange.FieldParser
+	var out []exchange.Balance
+	for _, acc := range res.Result.List {
+		for _, coin := range acc.Coin {
+			total := p.Decimal("walletBalance", coin.WalletBalance)
+			free := availableBalance(&p, coin, total)
+			out = append(out, exchange.Balance{Asset: coin.Coin, Total: total, Free: free, Locked: total.Sub(free)})
+		}
+	}
+	return out, p.Err
+}
+
+// availableBalance returns the part of a coin's wallet balance that is not held by orders or as
+// margin, between zero and total. availableToWithdraw already accounts for both; when Bybit
+// leaves it empty the spot order locks and the initial margin of orders and positions are
+// subtracted instead.
+func availableBalance(p *exchange.FieldParser, coin account.CoinDetails, total decimal.Decimal) decimal.Decimal {
+	var free decimal.Decimal
+	if coin.AvailableToWithdraw != "" {
+		free = p.Decimal("availableToWithdraw", coin.AvailableToWithdraw)
+	} else {
+		free = total.Sub(p.Decimal("locked", coin.Locked)).
+			Sub(p.Decimal("totalOrderIM", coin.TotalOrderIM)).
+			Sub(p.Decimal("totalPositionIM", coin.TotalPositionIM))
+	}
+	switch {
+	case free.Sign() < 0:
+		return decimal.Zero
+	case free.GreaterThan(total):
+		// Unrealised profit can make more available than the wallet holds.
+		return total
+	}
+	return free
+}
+
+func (c *Connector) Positions(symbol string) ([]exchange.Position, error) {
+	if c.category == market.CategorySpot {
+		return nil, fmt.Errorf("%w: positions on spot", exchange.ErrNotSupported)
+	}
+	var p exchange.FieldParser
+	var out []exchange.Position
+	for _, settleCoin := range c.settleCoins(symbol) {
+		req := &position.RequestParams{Category: c.category, Symbol: symbol, SettleCoin: settleCoin}
+		res, err := c.position.GetPositionInfo(req)
+		if err != nil {
+			return nil, err
+		}
+		for _, details := range res.Result.List {
+			size := p.Decimal("size", details.Size)
+			if size.IsZero() {
+				continue
+			}
+			out = append(out, exchange.Position{
+				Symbol:        details.Symbol,
+				Side:          exchange.Side(details.Side),
+				Size:          size,
+				EntryPrice:    p.Decimal("avgPrice", details.AvgPrice),
+				MarkPrice:     p.Decimal("markPrice", details.MarkPrice),
+				UnrealizedPnL: p.Decimal("unrealisedPnl", details.UnrealisedPnl),
+				Leverage:      p.Decimal("leverage", details.Leverage),
+			})
+		}
+	}
+	return out, p.Err
+}
+
+// SubscribeTicker merges Bybit's ticker snapshots and deltas, so handler always receives the
+// full ticker.
+func (c *Connector) SubscribeTicker(symbol string, handler func(exchange.Ticker)) error {
+	return c.stream.SubscribeTicker(symbol, func(data ticker.Data) {
+		t, err := c.mergeTicker(data)
+		if err != nil {
+			c.stream.reportError(err)
+			return
+		}
+		handler(t)
+	})
+}
+
+func (c *Connector) mergeTicker(data ticker.Data) (exchange.Ticker, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	t := c.tickers[data.Symbol]
+	t.Symbol = data.Symbol
+	// Deltas only carry the fields that changed.
+	var p exchange.FieldParser
+	if data.LastPrice != "" {
+		t.Last = p.Decimal("lastPrice", data.LastPrice)
+	}
+	if data.Bid1Price != "" {
+		t.Bid = p.Decimal("bid1Price", data.Bid1Price)
+	}
+	if data.Ask1Price != "" {
+		t.Ask = p.Decimal("ask1Price", data.Ask1Price)
+	}
+	if data.Volume24H != "" {
+		t.Volume24h = p.Decimal("volume24h", data.Volume24H)
+	}
+	if p.Err != nil {
+		return exchange.Ticker{}, fmt.Errorf("failed to decode ticker: %w", p.Err)
+	}
+	t.Time = time.Now()
+	c.tickers[data.Symbol] = t
+	return t, nil
+}
+
+func (c *Connector) SubscribeOrders(handler func(exchange.Order)) error {
+	return c.stream.SubscribeOrderUpdates(func(details trade.OrderDetails) {
+		order, err := convertOrder(details)
+		if err != nil {
+			c.stream.reportError(fmt.Errorf("failed to decode order update: %w", err))
+			return
+		}
+		handler(*order)
+	})
+}
+
+func (c *Connector) Connect() error {
+	return c.stream.Connect()
+}
+
+func (c *Connector) Close() error {
+	c.stream.Close()
+	return nil
+}
@@ -0,0 +1,167 @@
+package bybit
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/account"
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/market"
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/trade"
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/ws/public/ticker"
+	"github.com/cploutarchou/crypto-sdk-suite/decimal"
+	"github.com/cploutarchou/crypto-sdk-suite/exchange"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeMarket struct {
+	market.Market
+	candles []market.Candle
+}
+
+func (f *fakeMarket) GetCandles(*market.KlineRequest) ([]market.Candle, error) {
+	return f.candles, nil
+}
+
+type fakeTrade struct {
+	trade.Trade
+	placed      *trade.PlaceOrderRequest
+	cancelErr   error
+	settleCoins []string
+}
+
+func (f *fakeTrade) PlaceOrder(req *trade.PlaceOrderRequest) (*trade.PlaceOrderResponse, error) {
+	f.placed = req
+	res := &trade.PlaceOrderResponse{}
+	res.Result.OrderID, res.Result.OrderLinkID = "1", req.OrderLinkID
+	return res, nil
+}
+
+func (f *fakeTrade) CancelOrder(*trade.CancelOrderRequest) (*trade.CancelOrderResponse, error) {
+	return nil, f.cancelErr
+}
+
+// GetOpenOrders returns one order per settle coin it is asked for.
+func (f *fakeTrade) GetOpenOrders(req *trade.GetOpenOrdersRequest) (*trade.GetOpenOrdersResponse, error) {
+	res := &trade.GetOpenOrdersResponse{}
+	if req.SettleCoin != nil {
+		f.settleCoins = append(f.settleCoins, *req.SettleCoin)
+		res.Result.List = []trade.OrderDetails{{OrderID: *req.SettleCoin, Symbol: "BTC" + *req.SettleCoin, OrderStatus: trade.OrderStatusNew}}
+	}
+	return res, nil
+}
+
+func TestConnectorCandlesOldestFirst(t *testing.T) {
+	start := time.UnixMilli(1700000000000)
+	c := &Connector{category: market.CategoryLinear, market: &fakeMarket{candles: []market.Candle{
+		{Start: start.Add(time.Minute)},
+		{Start: start},
+	}}}
+	candles, err := c.Candles("BTCUSDT", time.Minute, 2)
+	require.NoError(t, err)
+	assert.Equal(t, start, candles[0].Start)
+
+	_, err = c.Candles("BTCUSDT", 7*time.Minute, 2)
+	assert.True(t, errors.Is(err, exchange.ErrNotSupported))
+}
+
+func TestConnectorPlaceOrder(t *testing.T) {
+	fake := &fakeTrade{}
+	c := &Connector{category: market.CategorySpot, trade: fake}
+	order, err := c.PlaceOrder(exchange.OrderRequest{
+		Symbol:        "BTCUSDT",
+		Side:          exchange.Buy,
+		Type:          exchange.Limit,
+		Qty:           decimal.RequireFromString("0.01"),
+		Price:         decimal.RequireFromString("30000"),
+		TimeInForce:   exchange.PostOnly,
+		ClientOrderID: "neutral-1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1", order.ID)
+	assert.Equal(t, "neutral-1", order.ClientOrderID)
+	assert.Equal(t, exchange.StatusNew, order.Status)
+	assert.Equal(t, market.CategorySpot, fake.placed.Category)
+	assert.Equal(t, trade.TimeInForcePostOnly, fake.placed.TimeInForce)
+	assert.Equal(t, "30000", fake.placed.Price)
+
+	fake.cancelErr = trade.ErrOrderNotFound
+	assert.True(t, errors.Is(c.CancelOrder("BTCUSDT", "1"), exchange.ErrOrderNotFound))
+}
+
+func TestConvertOrder(t *testing.T) {
+	order, err := convertOrder(trade.OrderDetails{
+		OrderID:     "1",
+		Symbol:      "BTCUSDT",
+		Side:        trade.SideSell,
+		OrderType:   trade.OrderTypeLimit,
+		OrderStatus: trade.OrderStatusPartiallyFilledCanceled,
+		Price:       "30000",
+		Qty:         "0.02",
+		CumExecQty:  "0.01",
+		AvgPrice:    "",
+		CreatedTime: "1700000000000",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, exchange.StatusCancelled, order.Status)
+	assert.Equal(t, exchange.Sell, order.Side)
+	assert.Equal(t, "0.01", order.FilledQty.String())
+	assert.True(t, order.AvgPrice.IsZero())
+	assert.Equal(t, int64(1700000000000), order.CreatedAt.UnixMilli())
+}
+
+func TestConnectorMergesTickerDeltas(t *testing.T) {
+	c := &Connector{tickers: make(map[string]exchange.Ticker)}
+	_, err := c.mergeTicker(ticker.Data{Symbol: "BTCUSDT", LastPrice: "30000", Bid1Price: "29999", Ask1Price: "30001"})
+	require.NoError(t, err)
+	merged, err := c.mergeTicker(ticker.Data{Symbol: "BTCUSDT", LastPrice: "30002"})
+	require.NoError(t, err)
+	assert.Equal(t, "30002", merged.Last.String())
+	assert.Equal(t, "29999", merged.Bid.String())
+}
+
+func TestConnectorOpenOrdersQueriesEverySettleCoin(t *testing.T) {
+	fake := &fakeTrade{}
+	c := &Connector{category: market.CategoryLinear, trade: fake}
+	orders, err := c.OpenOrders("")
+	require.NoError(t, err)
+	require.Len(t, orders, 2)
+	assert.Equal(t, []string{"USDT", "USDC"}, fake.settleCoins)
+	assert.Equal(t, "BTCUSDC", orders[1].Symbol)
+
+	fake.settleCoins = nil
+	c.SettleCoins = []string{"USDC"}
+	_, err = c.OpenOrders("")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"USDC"}, fake.settleCoins)
+
+	fake.settleCoins = nil
+	_, err = c.OpenOrders("BTCUSDT")
+	require.NoError(t, err)
+	assert.Empty(t, fake.settleCoins)
+}
+
+func TestConnectorBalancesExcludeMargin(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"retCode":0,"result":{"list":[{"accountType":"UNIFIED","coin":[
+			{"coin":"USDT","walletBalance":"1000","locked":"0","availableToWithdraw":"700"},
+			{"coin":"USDC","walletBalance":"500","locked":"50","availableToWithdraw":"","totalOrderIM":"100","totalPositionIM":"200"},
+			{"coin":"BTC","walletBalance":"1","locked":"0","availableToWithdraw":"","totalPositionIM":"2"}]}]}}`))
+	}))
+	defer server.Close()
+
+	c := &Connector{category: market.CategoryLinear, account: account.New(client.NewClientWithBaseURL("key", "secret", server.URL))}
+	balances, err := c.Balances()
+	require.NoError(t, err)
+	require.Len(t, balances, 3)
+	assert.Equal(t, "700", balances[0].Free.String())
+	assert.Equal(t, "300", balances[0].Locked.String())
+	assert.Equal(t, "150", balances[1].Free.String())
+	assert.Equal(t, "350", balances[1].Locked.String())
+	assert.True(t, balances[2].Free.IsZero(), "free must not go negative")
+	assert.Equal(t, "1", balances[2].Locked.String())
+}
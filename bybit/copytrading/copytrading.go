@@ -0,0 +1,156 @@
+package copytrading
+
+import (
+	"fmt"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/market"
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/position"
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/trade"
+)
+
+// Values of market.InstrumentInfo.CopyTrading.
+const (
+	SupportNone       = "none"
+	SupportBoth       = "both"
+	SupportUTAOnly    = "utaOnly"
+	SupportNormalOnly = "normalOnly"
+)
+
+// SettleCoin is the settle coin of the USDT perpetuals copy trading is offered on.
+const SettleCoin = "USDT"
+
+// CopyTrading defines the interface for running a copy trading master account. Bybit V5 has no
+// dedicated copy trading endpoints: master traders use the linear order and position endpoints on
+// symbols that support copy trading, and the orders are copied automatically.
+type CopyTrading interface {
+	// GetSymbols retrieves the linear symbols that support copy trading.
+	// returns: []market.InstrumentInfo - the instruments whose CopyTrading field is not SupportNone.
+	//          error - an error if the request fails.
+	GetSymbols() ([]market.InstrumentInfo, error)
+
+	// PlaceOrder places a copy trading order after checking that the symbol supports copy trading.
+	// req: trade.PlaceOrderRequest - the order; the category must be linear.
+	// returns: *trade.PlaceOrderResponse - the IDs of the order.
+	//          error - an error if the symbol does not support copy trading or the request fails.
+	PlaceOrder(req *trade.PlaceOrderRequest) (*trade.PlaceOrderResponse, error)
+
+	// ClosePosition closes every open position of a symbol with reduce-only market orders.
+	// symbol: string - the symbol to close.
+	// returns: []*trade.PlaceOrderResponse - one response per closed position.
+	//          error - an error if any request fails.
+	ClosePosition(symbol string) ([]*trade.PlaceOrderResponse, error)
+
+	// GetPositions retrieves the open copy trading positions.
+	// returns: *position.Response - the open USDT perpetual positions.
+	//          error - an error if the request fails.
+	GetPositions() (*position.Response, error)
+
+	// GetClosedPnL retrieves the closed profit and loss of the copy trading positions.
+	// req: position.GetClosedPnLRequest - the symbol and time range; the category is always linear.
+	// returns: *position.ClosedPnLResponse - the closed PnL records.
+	//          error - an error if the request fails.
+	GetClosedPnL(req *position.GetClosedPnLRequest) (*position.ClosedPnLResponse, error)
+}
+
+type impl struct {
+	market   market.Market
+	trade    trade.Trade
+	position position.Position
+}
+
+// New creates a new instance of the CopyTrading interface, which can be used to interact with the Bybit API.
+func New(c *client.Client) CopyTrading {
+	return &impl{
+		market:   market.New(c),
+		trade:    trade.New(c),
+		position: position.New(c),
+	}
+}
+
+// GetSymbols retrieves the linear symbols that support copy trading.
+func (i *impl) GetSymbols() ([]market.InstrumentInfo, error) {
+	res, err := i.market.GetInstrumentsInfo(&market.InstrumentsInfoRequest{Category: market.CategoryLinear})
+	if err != nil {
+		return nil, err
+	}
+	var symbols []market.InstrumentInfo
+	for _, instrument := range res.Result.List {
+		if supportsCopyTrading(instrument) {
+			symbols = append(symbols, instrument)
+		}
+	}
+	return symbols, nil
+}
+
+// PlaceOrder places a copy trading order. Orders on symbols without copy trading support would be
+// accepted by Bybit as ordinary orders and silently not copied, so they are rejected here.
+func (i *impl) PlaceOrder(req *trade.PlaceOrderRequest) (*trade.PlaceOrderResponse, error) {
+	if req.Category != market.CategoryLinear {
+		return nil, fmt.Errorf("copy trading orders must use category %s", market.CategoryLinear)
+	}
+	symbol := req.Symbol
+	res, err := i.market.GetInstrumentsInfo(&market.InstrumentsInfoRequest{Category: market.CategoryLinear, Symbol: &symbol})
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Result.List) == 0 || !supportsCopyTrading(res.Result.List[0]) {
+		return nil, fmt.Errorf("symbol %s does not support copy trading", req.Symbol)
+	}
+	return i.trade.PlaceOrder(req)
+}
+
+// ClosePosition closes every open position of a symbol, both sides in hedge mode, with reduce-only
+// market orders of the full position size.
+func (i *impl) ClosePosition(symbol string) ([]*trade.PlaceOrderResponse, error) {
+	if symbol == "" {
+		return nil, fmt.Errorf("symbol is required")
+	}
+	positions, err := i.position.GetPositionInfo(&position.RequestParams{
+		Category: market.CategoryLinear,
+		Symbol:   symbol,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var responses []*trade.PlaceOrderResponse
+	for _, p := range positions.Result.List {
+		order, ok := closeOrder(p)
+		if !ok {
+			continue
+		}
+		res, err := i.trade.PlaceOrder(order)
+		if err != nil {
+			return responses, fmt.Errorf("error closing %s %s position: %w", p.Symbol, p.Side, err)
+		}
+		responses = append(responses, res)
+	}
+	return responses, nil
+}
+
+// GetPositions retrieves the open USDT perpetual positions.
+func (i *impl) GetPositions() (*position.Response, error) {
+	settleCoin := SettleCoin
+	return i.position.GetPositionInfo(&position.RequestParams{
+		Category:   market.CategoryLinear,
+		SettleCoin: &settleCoin,
+	})
+}
+
+// GetClosedPnL retrieves the closed profit and loss of the USDT perpetual positions.
+func (i *impl) GetClosedPnL(req *position.GetClosedPnLRequest) (*position.ClosedPnLResponse, error) {
+	linear := *req
+	linear.Category = market.CategoryLinear
+	return i.position.GetClosedPnL(&linear)
+}
+
+func supportsCopyTrading(instrument market.InstrumentInfo) bool {
+	return instrument.CopyTrading != "" && instrument.CopyTrading != SupportNone
+}
+
+// closeOrder builds the reduce-only market order that closes a linear position. It reports false
+// for empty position slots.
+func closeOrder(p position.Details) (*trade.PlaceOrderRequest, bool) {
+	return trade.CloseOrder(market.CategoryLinear, p)
+}
@@ -0,0 +1,26 @@
+package copytrading
+
+import (
+	"testing"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/position"
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/trade"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloseOrder(t *testing.T) {
+	order, ok := closeOrder(position.Details{Symbol: "BTCUSDT", Side: "Buy", Size: "0.5", PositionIdx: 1})
+	require.True(t, ok)
+	assert.Equal(t, trade.SideSell, order.Side)
+	assert.Equal(t, "0.5", order.Qty)
+	assert.Equal(t, 1, *order.PositionIdx)
+	assert.True(t, *order.ReduceOnly)
+
+	order, ok = closeOrder(position.Details{Symbol: "BTCUSDT", Side: "Sell", Size: "2"})
+	require.True(t, ok)
+	assert.Equal(t, trade.SideBuy, order.Side)
+
+	_, ok = closeOrder(position.Details{Symbol: "BTCUSDT", Side: "", Size: "0"})
+	assert.False(t, ok)
+}
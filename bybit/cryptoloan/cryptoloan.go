@@ -0,0 +1,218 @@
+package cryptoloan
+
+import (
+	"fmt"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+)
+
+// CryptoLoan defines the interface for the crypto loan endpoints of the Bybit API.
+type CryptoLoan interface {
+	// GetCollateralCoins retrieves the coins that can be pledged and their LTV thresholds.
+	// vipLevel: string - the VIP level; empty returns every level.
+	// currency: string - the coin; empty returns every coin.
+	// returns: *CollateralCoinsResponse - the collateral coins per VIP level.
+	//          error - an error if the request fails.
+	GetCollateralCoins(vipLevel, currency string) (*CollateralCoinsResponse, error)
+
+	// GetLoanableCoins retrieves the coins that can be borrowed and their interest rates.
+	// vipLevel: string - the VIP level; empty returns every level.
+	// currency: string - the coin; empty returns every coin.
+	// returns: *LoanableCoinsResponse - the loanable coins per VIP level.
+	//          error - an error if the request fails.
+	GetLoanableCoins(vipLevel, currency string) (*LoanableCoinsResponse, error)
+
+	// Borrow borrows a coin against collateral.
+	// req: BorrowRequest - the coins, amounts and term of the loan.
+	// returns: *BorrowResponse - the ID of the loan order.
+	//          error - an error if the request fails.
+	Borrow(req *BorrowRequest) (*BorrowResponse, error)
+
+	// Repay repays a loan, fully or partially.
+	// orderID: string - the loan order ID.
+	// amount: string - the amount to repay.
+	// returns: *RepayResponse - the ID of the repayment.
+	//          error - an error if the request fails.
+	Repay(orderID, amount string) (*RepayResponse, error)
+
+	// AdjustLTV adds collateral to or removes collateral from the loans in a collateral coin.
+	// currency: string - the collateral coin.
+	// amount: string - the amount to add or remove.
+	// direction: string - DirectionAddCollateral or DirectionReduceCollateral.
+	// returns: *AdjustLTVResponse - the ID of the adjustment.
+	//          error - an error if the request fails.
+	AdjustLTV(currency, amount, direction string) (*AdjustLTVResponse, error)
+
+	// GetLoanOrders retrieves the ongoing loan orders.
+	// req: GetLoanOrdersRequest - the filters and paging of the query.
+	// returns: *LoanOrdersResponse - the orders of all pages, or of one page when a cursor is given.
+	//          error - an error if any request fails.
+	GetLoanOrders(req *GetLoanOrdersRequest) (*LoanOrdersResponse, error)
+}
+
+type impl struct {
+	client *client.Client
+}
+
+// New creates a new instance of the CryptoLoan interface, which can be used to interact with the Bybit API.
+func New(c *client.Client) CryptoLoan {
+	return &impl{client: c}
+}
+
+// GetCollateralCoins retrieves the collateral coins with their initial, margin call and
+// liquidation LTV per VIP level.
+func (i *impl) GetCollateralCoins(vipLevel, currency string) (*CollateralCoinsResponse, error) {
+	response, err := i.client.Get("/v5/crypto-loan/collateral-data", vipParams(vipLevel, currency))
+	if err != nil {
+		return nil, fmt.Errorf("error fetching collateral coins: %w", err)
+	}
+	var coinsResponse CollateralCoinsResponse
+	if err := response.Unmarshal(&coinsResponse); err != nil {
+		return nil, fmt.Errorf("error parsing collateral coins response: %w", err)
+	}
+	if coinsResponse.RetCode != 0 {
+		return &coinsResponse, fmt.Errorf("API returned error: %s", coinsResponse.RetMsg)
+	}
+	return &coinsResponse, nil
+}
+
+// GetLoanableCoins retrieves the loanable coins with their flexible and fixed term hourly
+// interest rates per VIP level.
+func (i *impl) GetLoanableCoins(vipLevel, currency string) (*LoanableCoinsResponse, error) {
+	response, err := i.client.Get("/v5/crypto-loan/loanable-data", vipParams(vipLevel, currency))
+	if err != nil {
+		return nil, fmt.Errorf("error fetching loanable coins: %w", err)
+	}
+	var coinsResponse LoanableCoinsResponse
+	if err := response.Unmarshal(&coinsResponse); err != nil {
+		return nil, fmt.Errorf("error parsing loanable coins response: %w", err)
+	}
+	if coinsResponse.RetCode != 0 {
+		return &coinsResponse, fmt.Errorf("API returned error: %s", coinsResponse.RetMsg)
+	}
+	return &coinsResponse, nil
+}
+
+// Borrow borrows a coin against collateral. Bybit derives the missing amount from the initial LTV
+// of the collateral coin, so exactly one of LoanAmount and CollateralAmount is needed.
+func (i *impl) Borrow(req *BorrowRequest) (*BorrowResponse, error) {
+	if req.LoanCurrency == "" || req.CollateralCurrency == "" {
+		return nil, fmt.Errorf("loanCurrency and collateralCurrency are required")
+	}
+	if req.LoanAmount == nil && req.CollateralAmount == nil {
+		return nil, fmt.Errorf("either loanAmount or collateralAmount is required")
+	}
+	if req.LoanTerm != nil && !validLoanTerm(*req.LoanTerm) {
+		return nil, fmt.Errorf("invalid loanTerm %q", *req.LoanTerm)
+	}
+	response, err := i.client.Post("/v5/crypto-loan/borrow", ConvertBorrowRequestToParams(req))
+	if err != nil {
+		return nil, fmt.Errorf("error borrowing: %w", err)
+	}
+	var borrowResponse BorrowResponse
+	if err := response.Unmarshal(&borrowResponse); err != nil {
+		return nil, fmt.Errorf("error parsing borrow response: %w", err)
+	}
+	if borrowResponse.RetCode != 0 {
+		return &borrowResponse, fmt.Errorf("API returned error: %s", borrowResponse.RetMsg)
+	}
+	return &borrowResponse, nil
+}
+
+// Repay repays a loan. Repaying the total debt closes the loan and releases the collateral.
+func (i *impl) Repay(orderID, amount string) (*RepayResponse, error) {
+	if orderID == "" || amount == "" {
+		return nil, fmt.Errorf("orderId and amount are required")
+	}
+	params := client.Params{
+		"orderId": orderID,
+		"amount":  amount,
+	}
+	response, err := i.client.Post("/v5/crypto-loan/repay", params)
+	if err != nil {
+		return nil, fmt.Errorf("error repaying loan: %w", err)
+	}
+	var repayResponse RepayResponse
+	if err := response.Unmarshal(&repayResponse); err != nil {
+		return nil, fmt.Errorf("error parsing repay response: %w", err)
+	}
+	if repayResponse.RetCode != 0 {
+		return &repayResponse, fmt.Errorf("API returned error: %s", repayResponse.RetMsg)
+	}
+	return &repayResponse, nil
+}
+
+// AdjustLTV adds or removes collateral. Adding collateral lowers the LTV and moves the loans away
+// from the margin call and liquidation thresholds.
+func (i *impl) AdjustLTV(currency, amount, direction string) (*AdjustLTVResponse, error) {
+	if currency == "" || amount == "" {
+		return nil, fmt.Errorf("currency and amount are required")
+	}
+	if direction != DirectionAddCollateral && direction != DirectionReduceCollateral {
+		return nil, fmt.Errorf("invalid direction %q", direction)
+	}
+	params := client.Params{
+		"currency":  currency,
+		"amount":    amount,
+		"direction": direction,
+	}
+	response, err := i.client.Post("/v5/crypto-loan/adjust-ltv", params)
+	if err != nil {
+		return nil, fmt.Errorf("error adjusting LTV: %w", err)
+	}
+	var adjustResponse AdjustLTVResponse
+	if err := response.Unmarshal(&adjustResponse); err != nil {
+		return nil, fmt.Errorf("error parsing adjust LTV response: %w", err)
+	}
+	if adjustResponse.RetCode != 0 {
+		return &adjustResponse, fmt.Errorf("API returned error: %s", adjustResponse.RetMsg)
+	}
+	return &adjustResponse, nil
+}
+
+// GetLoanOrders retrieves the ongoing loan orders. When no cursor is supplied every page is
+// fetched and the orders are merged into a single response.
+func (i *impl) GetLoanOrders(req *GetLoanOrdersRequest) (*LoanOrdersResponse, error) {
+	if req.Limit != nil && (*req.Limit < 1 || *req.Limit > MaxOrderLimit) {
+		return nil, fmt.Errorf("limit must be between 1 and %d", MaxOrderLimit)
+	}
+	if req.LoanTermType != nil && *req.LoanTermType != LoanTermTypeFlexible && *req.LoanTermType != LoanTermTypeFixed {
+		return nil, fmt.Errorf("invalid loanTermType %d", *req.LoanTermType)
+	}
+	if req.LoanTerm != nil && (req.LoanTermType == nil || *req.LoanTermType != LoanTermTypeFixed) {
+		return nil, fmt.Errorf("loanTerm requires loanTermType %d", LoanTermTypeFixed)
+	}
+
+	followCursor := req.Cursor == nil
+	page := *req
+	var merged *LoanOrdersResponse
+
+	for {
+		response, err := i.client.Get("/v5/crypto-loan/ongoing-orders", ConvertGetLoanOrdersRequestToParams(&page))
+		if err != nil {
+			return nil, fmt.Errorf("error fetching loan orders: %w", err)
+		}
+		var ordersResponse LoanOrdersResponse
+		if err := response.Unmarshal(&ordersResponse); err != nil {
+			return nil, fmt.Errorf("error parsing loan orders response: %w", err)
+		}
+		if ordersResponse.RetCode != 0 {
+			return &ordersResponse, fmt.Errorf("API returned error: %s", ordersResponse.RetMsg)
+		}
+
+		if merged == nil {
+			merged = &ordersResponse
+		} else {
+			merged.Result.List = append(merged.Result.List, ordersResponse.Result.List...)
+			merged.Result.NextPageCursor = ordersResponse.Result.NextPageCursor
+			merged.Time = ordersResponse.Time
+		}
+		if !followCursor || ordersResponse.Result.NextPageCursor == "" {
+			break
+		}
+		cursor := ordersResponse.Result.NextPageCursor
+		page.Cursor = &cursor
+	}
+
+	return merged, nil
+}
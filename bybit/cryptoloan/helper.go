@@ -0,0 +1,68 @@
+package cryptoloan
+
+import (
+	"strconv"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+)
+
+func ConvertBorrowRequestToParams(req *BorrowRequest) client.Params {
+	params := make(client.Params)
+	params["loanCurrency"] = req.LoanCurrency
+	params["collateralCurrency"] = req.CollateralCurrency
+	if req.LoanAmount != nil {
+		params["loanAmount"] = *req.LoanAmount
+	}
+	if req.LoanTerm != nil {
+		params["loanTerm"] = *req.LoanTerm
+	}
+	if req.CollateralAmount != nil {
+		params["collateralAmount"] = *req.CollateralAmount
+	}
+	return params
+}
+
+func ConvertGetLoanOrdersRequestToParams(req *GetLoanOrdersRequest) client.Params {
+	params := make(client.Params)
+	if req.OrderID != nil {
+		params["orderId"] = *req.OrderID
+	}
+	if req.LoanCurrency != nil {
+		params["loanCurrency"] = *req.LoanCurrency
+	}
+	if req.CollateralCurrency != nil {
+		params["collateralCurrency"] = *req.CollateralCurrency
+	}
+	if req.LoanTermType != nil {
+		params["loanTermType"] = strconv.Itoa(*req.LoanTermType)
+	}
+	if req.LoanTerm != nil {
+		params["loanTerm"] = *req.LoanTerm
+	}
+	if req.Limit != nil {
+		params["limit"] = strconv.Itoa(*req.Limit)
+	}
+	if req.Cursor != nil {
+		params["cursor"] = *req.Cursor
+	}
+	return params
+}
+
+func validLoanTerm(term string) bool {
+	switch term {
+	case LoanTerm7Days, LoanTerm14Days, LoanTerm30Days, LoanTerm90Days, LoanTerm180Days:
+		return true
+	}
+	return false
+}
+
+func vipParams(vipLevel, currency string) client.Params {
+	params := client.Params{}
+	if vipLevel != "" {
+		params["vipLevel"] = vipLevel
+	}
+	if currency != "" {
+		params["currency"] = currency
+	}
+	return params
+}
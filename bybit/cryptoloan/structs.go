@@ -0,0 +1,158 @@
+package cryptoloan
+
+// MaxOrderLimit is the largest page size accepted by the loan order endpoint.
+const MaxOrderLimit = 100
+
+// Fixed loan terms in days. A borrow request without a term creates a flexible loan.
+const (
+	LoanTerm7Days   = "7"
+	LoanTerm14Days  = "14"
+	LoanTerm30Days  = "30"
+	LoanTerm90Days  = "90"
+	LoanTerm180Days = "180"
+)
+
+// Directions of an LTV adjustment.
+const (
+	DirectionAddCollateral    = "0"
+	DirectionReduceCollateral = "1"
+)
+
+// Values of the loanTermType filter.
+const (
+	LoanTermTypeFlexible = 1
+	LoanTermTypeFixed    = 2
+)
+
+// CollateralCoin describes a coin that can be pledged as collateral.
+type CollateralCoin struct {
+	CollateralAccuracy int    `json:"collateralAccuracy"`
+	InitialLTV         string `json:"initialLTV"`
+	MarginCallLTV      string `json:"marginCallLTV"`
+	LiquidationLTV     string `json:"liquidationLTV"`
+	MaxLimit           string `json:"maxLimit"`
+	Currency           string `json:"currency"`
+}
+
+// CollateralCoinsResponse represents the response from querying the collateral coins.
+type CollateralCoinsResponse struct {
+	RetCode int    `json:"retCode"`
+	RetMsg  string `json:"retMsg"`
+	Result  struct {
+		VipCoinList []struct {
+			List     []CollateralCoin `json:"list"`
+			VipLevel string           `json:"vipLevel"`
+		} `json:"vipCoinList"`
+	} `json:"result"`
+	RetExtInfo any   `json:"retExtInfo"`
+	Time       int64 `json:"time"`
+}
+
+// LoanableCoin describes a coin that can be borrowed and its interest rates.
+type LoanableCoin struct {
+	BorrowingAccuracy          int    `json:"borrowingAccuracy"`
+	Currency                   string `json:"currency"`
+	FlexibleHourlyInterestRate string `json:"flexibleHourlyInterestRate"`
+	HourlyInterestRate7D       string `json:"hourlyInterestRate7D"`
+	HourlyInterestRate14D      string `json:"hourlyInterestRate14D"`
+	HourlyInterestRate30D      string `json:"hourlyInterestRate30D"`
+	HourlyInterestRate90D      string `json:"hourlyInterestRate90D"`
+	HourlyInterestRate180D     string `json:"hourlyInterestRate180D"`
+	MaxBorrowingAmount         string `json:"maxBorrowingAmount"`
+	MinBorrowingAmount         string `json:"minBorrowingAmount"`
+}
+
+// LoanableCoinsResponse represents the response from querying the loanable coins.
+type LoanableCoinsResponse struct {
+	RetCode int    `json:"retCode"`
+	RetMsg  string `json:"retMsg"`
+	Result  struct {
+		VipCoinList []struct {
+			List     []LoanableCoin `json:"list"`
+			VipLevel string         `json:"vipLevel"`
+		} `json:"vipCoinList"`
+	} `json:"result"`
+	RetExtInfo any   `json:"retExtInfo"`
+	Time       int64 `json:"time"`
+}
+
+// BorrowRequest represents the payload for borrowing against collateral. Either LoanAmount or
+// CollateralAmount must be set.
+type BorrowRequest struct {
+	LoanCurrency       string  `json:"loanCurrency"`               // Required: Coin to borrow
+	LoanAmount         *string `json:"loanAmount,omitempty"`       // Optional: Amount to borrow
+	LoanTerm           *string `json:"loanTerm,omitempty"`         // Optional: Fixed term, e.g. LoanTerm30Days; flexible when unset
+	CollateralCurrency string  `json:"collateralCurrency"`         // Required: Coin to pledge
+	CollateralAmount   *string `json:"collateralAmount,omitempty"` // Optional: Amount to pledge
+}
+
+// BorrowResponse represents the response from borrowing.
+type BorrowResponse struct {
+	RetCode int    `json:"retCode"`
+	RetMsg  string `json:"retMsg"`
+	Result  struct {
+		OrderID string `json:"orderId"`
+	} `json:"result"`
+	RetExtInfo any   `json:"retExtInfo"`
+	Time       int64 `json:"time"`
+}
+
+// RepayResponse represents the response from repaying a loan.
+type RepayResponse struct {
+	RetCode int    `json:"retCode"`
+	RetMsg  string `json:"retMsg"`
+	Result  struct {
+		RepayID string `json:"repayId"`
+	} `json:"result"`
+	RetExtInfo any   `json:"retExtInfo"`
+	Time       int64 `json:"time"`
+}
+
+// AdjustLTVResponse represents the response from adjusting the collateral of a loan.
+type AdjustLTVResponse struct {
+	RetCode int    `json:"retCode"`
+	RetMsg  string `json:"retMsg"`
+	Result  struct {
+		AdjustID string `json:"adjustId"`
+	} `json:"result"`
+	RetExtInfo any   `json:"retExtInfo"`
+	Time       int64 `json:"time"`
+}
+
+// GetLoanOrdersRequest represents the query parameters for the ongoing loan orders.
+type GetLoanOrdersRequest struct {
+	OrderID            *string `json:"orderId,omitempty"`            // Optional: Loan order ID
+	LoanCurrency       *string `json:"loanCurrency,omitempty"`       // Optional: Borrowed coin
+	CollateralCurrency *string `json:"collateralCurrency,omitempty"` // Optional: Collateral coin
+	LoanTermType       *int    `json:"loanTermType,omitempty"`       // Optional: LoanTermTypeFlexible or LoanTermTypeFixed
+	LoanTerm           *string `json:"loanTerm,omitempty"`           // Optional: Fixed term, only with LoanTermTypeFixed
+	Limit              *int    `json:"limit,omitempty"`              // Optional: Limit for data size per page, at most 100
+	Cursor             *string `json:"cursor,omitempty"`             // Optional: Cursor of a previous page; disables automatic paging
+}
+
+// LoanOrder is an ongoing loan.
+type LoanOrder struct {
+	CollateralAmount        string `json:"collateralAmount"`
+	CollateralCurrency      string `json:"collateralCurrency"`
+	CurrentLTV              string `json:"currentLTV"`
+	ExpirationTime          string `json:"expirationTime"`
+	HourlyInterestRate      string `json:"hourlyInterestRate"`
+	LoanCurrency            string `json:"loanCurrency"`
+	LoanTerm                string `json:"loanTerm"` // Empty for flexible loans
+	OrderID                 string `json:"orderId"`
+	ResidualInterest        string `json:"residualInterest"`
+	ResidualPenaltyInterest string `json:"residualPenaltyInterest"`
+	TotalDebt               string `json:"totalDebt"`
+}
+
+// LoanOrdersResponse represents the response from querying the ongoing loan orders.
+type LoanOrdersResponse struct {
+	RetCode int    `json:"retCode"`
+	RetMsg  string `json:"retMsg"`
+	Result  struct {
+		List           []LoanOrder `json:"list"`
+		NextPageCursor string      `json:"nextPageCursor"`
+	} `json:"result"`
+	RetExtInfo any   `json:"retExtInfo"`
+	Time       int64 `json:"time"`
+}
@@ -0,0 +1,233 @@
+package earn
+
+import (
+	"fmt"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+)
+
+// Earn defines the interface for the earn endpoints of the Bybit API.
+type Earn interface {
+	// GetProducts retrieves the earn products of a category.
+	// category: string - CategoryFlexibleSaving or CategoryOnChain.
+	// coin: string - the coin; empty returns every coin.
+	// returns: *ProductsResponse - the products with their APR and limits.
+	//          error - an error if the request fails.
+	GetProducts(category, coin string) (*ProductsResponse, error)
+
+	// Subscribe stakes an amount into an earn product.
+	// req: OrderRequest - the product, wallet and amount to stake.
+	// returns: *OrderResponse - the IDs of the stake order.
+	//          error - an error if the request fails.
+	Subscribe(req *OrderRequest) (*OrderResponse, error)
+
+	// Redeem redeems an amount from an earn product.
+	// req: OrderRequest - the product, wallet and amount to redeem.
+	// returns: *OrderResponse - the IDs of the redeem order.
+	//          error - an error if the request fails.
+	Redeem(req *OrderRequest) (*OrderResponse, error)
+
+	// GetOrderHistory retrieves the stake and redeem orders.
+	// req: HistoryRequest - the category, filters and paging of the query.
+	// returns: *OrderHistoryResponse - the orders of all pages, or of one page when a cursor is given.
+	//          error - an error if any request fails.
+	GetOrderHistory(req *HistoryRequest) (*OrderHistoryResponse, error)
+
+	// GetPositions retrieves the staked positions.
+	// category: string - CategoryFlexibleSaving or CategoryOnChain.
+	// productID: string - the product ID; empty returns every product.
+	// coin: string - the coin; empty returns every coin.
+	// returns: *PositionsResponse - the staked positions.
+	//          error - an error if the request fails.
+	GetPositions(category, productID, coin string) (*PositionsResponse, error)
+
+	// GetYieldHistory retrieves the yield distributions.
+	// req: HistoryRequest - the category, product, time range and paging of the query.
+	// returns: *YieldHistoryResponse - the yields of all pages, or of one page when a cursor is given.
+	//          error - an error if any request fails.
+	GetYieldHistory(req *HistoryRequest) (*YieldHistoryResponse, error)
+}
+
+type impl struct {
+	client *client.Client
+}
+
+// New creates a new instance of the Earn interface, which can be used to interact with the Bybit API.
+func New(c *client.Client) Earn {
+	return &impl{client: c}
+}
+
+// GetProducts retrieves the earn products of a category.
+func (i *impl) GetProducts(category, coin string) (*ProductsResponse, error) {
+	if err := validCategory(category); err != nil {
+		return nil, err
+	}
+	params := client.Params{"category": category}
+	if coin != "" {
+		params["coin"] = coin
+	}
+	response, err := i.client.Get("/v5/earn/product", params)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching earn products: %w", err)
+	}
+	var productsResponse ProductsResponse
+	if err := response.Unmarshal(&productsResponse); err != nil {
+		return nil, fmt.Errorf("error parsing earn products response: %w", err)
+	}
+	if productsResponse.RetCode != 0 {
+		return &productsResponse, fmt.Errorf("API returned error: %s", productsResponse.RetMsg)
+	}
+	return &productsResponse, nil
+}
+
+// Subscribe stakes an amount into an earn product. An orderLinkId is generated and written back to
+// req when none is set, so the order can be looked up with GetOrderHistory.
+func (i *impl) Subscribe(req *OrderRequest) (*OrderResponse, error) {
+	return i.placeOrder(req, OrderTypeStake)
+}
+
+// Redeem redeems an amount from an earn product. An orderLinkId is generated and written back to
+// req when none is set.
+func (i *impl) Redeem(req *OrderRequest) (*OrderResponse, error) {
+	return i.placeOrder(req, OrderTypeRedeem)
+}
+
+func (i *impl) placeOrder(req *OrderRequest, orderType string) (*OrderResponse, error) {
+	if err := validCategory(req.Category); err != nil {
+		return nil, err
+	}
+	if req.AccountType != AccountTypeFund && req.AccountType != AccountTypeUnified {
+		return nil, fmt.Errorf("invalid accountType %q", req.AccountType)
+	}
+	if req.Amount == "" || req.Coin == "" || req.ProductID == "" {
+		return nil, fmt.Errorf("amount, coin and productId are required")
+	}
+	if req.OrderLinkID == nil {
+		orderLinkID := newOrderLinkID()
+		req.OrderLinkID = &orderLinkID
+	}
+
+	response, err := i.client.Post("/v5/earn/place-order", ConvertOrderRequestToParams(req, orderType))
+	if err != nil {
+		return nil, fmt.Errorf("error placing earn order: %w", err)
+	}
+	var orderResponse OrderResponse
+	if err := response.Unmarshal(&orderResponse); err != nil {
+		return nil, fmt.Errorf("error parsing earn order response: %w", err)
+	}
+	if orderResponse.RetCode != 0 {
+		return &orderResponse, fmt.Errorf("API returned error: %s", orderResponse.RetMsg)
+	}
+	return &orderResponse, nil
+}
+
+// GetOrderHistory retrieves the stake and redeem orders. When no cursor is supplied every page is
+// fetched and the orders are merged into a single response.
+func (i *impl) GetOrderHistory(req *HistoryRequest) (*OrderHistoryResponse, error) {
+	if err := validateHistoryRequest(req); err != nil {
+		return nil, err
+	}
+
+	followCursor := req.Cursor == nil
+	page := *req
+	var merged *OrderHistoryResponse
+
+	for {
+		response, err := i.client.Get("/v5/earn/order", ConvertHistoryRequestToParams(&page))
+		if err != nil {
+			return nil, fmt.Errorf("error fetching earn order history: %w", err)
+		}
+		var historyResponse OrderHistoryResponse
+		if err := response.Unmarshal(&historyResponse); err != nil {
+			return nil, fmt.Errorf("error parsing earn order history response: %w", err)
+		}
+		if historyResponse.RetCode != 0 {
+			return &historyResponse, fmt.Errorf("API returned error: %s", historyResponse.RetMsg)
+		}
+
+		if merged == nil {
+			merged = &historyResponse
+		} else {
+			merged.Result.List = append(merged.Result.List, historyResponse.Result.List...)
+			merged.Result.NextPageCursor = historyResponse.Result.NextPageCursor
+			merged.Time = historyResponse.Time
+		}
+		if !followCursor || historyResponse.Result.NextPageCursor == "" {
+			break
+		}
+		cursor := historyResponse.Result.NextPageCursor
+		page.Cursor = &cursor
+	}
+
+	return merged, nil
+}
+
+// GetPositions retrieves the staked positions of a category.
+func (i *impl) GetPositions(category, productID, coin string) (*PositionsResponse, error) {
+	if err := validCategory(category); err != nil {
+		return nil, err
+	}
+	params := client.Params{"category": category}
+	if productID != "" {
+		params["productId"] = productID
+	}
+	if coin != "" {
+		params["coin"] = coin
+	}
+	response, err := i.client.Get("/v5/earn/position", params)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching earn positions: %w", err)
+	}
+	var positionsResponse PositionsResponse
+	if err := response.Unmarshal(&positionsResponse); err != nil {
+		return nil, fmt.Errorf("error parsing earn positions response: %w", err)
+	}
+	if positionsResponse.RetCode != 0 {
+		return &positionsResponse, fmt.Errorf("API returned error: %s", positionsResponse.RetMsg)
+	}
+	return &positionsResponse, nil
+}
+
+// GetYieldHistory retrieves the yield distributions. When no cursor is supplied every page is
+// fetched and the yields are merged into a single response.
+func (i *impl) GetYieldHistory(req *HistoryRequest) (*YieldHistoryResponse, error) {
+	if req.OrderID != nil || req.OrderLinkID != nil {
+		return nil, fmt.Errorf("orderId and orderLinkId are not supported by the yield history endpoint")
+	}
+	if err := validateHistoryRequest(req); err != nil {
+		return nil, err
+	}
+
+	followCursor := req.Cursor == nil
+	page := *req
+	var merged *YieldHistoryResponse
+
+	for {
+		response, err := i.client.Get("/v5/earn/yield", ConvertHistoryRequestToParams(&page))
+		if err != nil {
+			return nil, fmt.Errorf("error fetching earn yield history: %w", err)
+		}
+		var yieldResponse YieldHistoryResponse
+		if err := response.Unmarshal(&yieldResponse); err != nil {
+			return nil, fmt.Errorf("error parsing earn yield history response: %w", err)
+		}
+		if yieldResponse.RetCode != 0 {
+			return &yieldResponse, fmt.Errorf("API returned error: %s", yieldResponse.RetMsg)
+		}
+
+		if merged == nil {
+			merged = &yieldResponse
+		} else {
+			merged.Result.Yield = append(merged.Result.Yield, yieldResponse.Result.Yield...)
+			merged.Result.NextPageCursor = yieldResponse.Result.NextPageCursor
+			merged.Time = yieldResponse.Time
+		}
+		if !followCursor || yieldResponse.Result.NextPageCursor == "" {
+			break
+		}
+		cursor := yieldResponse.Result.NextPageCursor
+		page.Cursor = &cursor
+	}
+
+	return merged, nil
+}
@@ -0,0 +1,84 @@
+package earn
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+)
+
+// newOrderLinkID returns a random 32 character orderLinkId.
+func newOrderLinkID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
+
+func validCategory(category string) error {
+	if category != CategoryFlexibleSaving && category != CategoryOnChain {
+		return fmt.Errorf("invalid category %q", category)
+	}
+	return nil
+}
+
+func ConvertOrderRequestToParams(req *OrderRequest, orderType string) client.Params {
+	params := make(client.Params)
+	params["category"] = req.Category
+	params["orderType"] = orderType
+	params["accountType"] = req.AccountType
+	params["amount"] = req.Amount
+	params["coin"] = req.Coin
+	params["productId"] = req.ProductID
+	if req.OrderLinkID != nil {
+		params["orderLinkId"] = *req.OrderLinkID
+	}
+	if req.RedeemPositionID != nil {
+		params["redeemPositionId"] = *req.RedeemPositionID
+	}
+	if req.ToAccountType != nil {
+		params["toAccountType"] = *req.ToAccountType
+	}
+	return params
+}
+
+func ConvertHistoryRequestToParams(req *HistoryRequest) client.Params {
+	params := make(client.Params)
+	params["category"] = req.Category
+	if req.OrderID != nil {
+		params["orderId"] = *req.OrderID
+	}
+	if req.OrderLinkID != nil {
+		params["orderLinkId"] = *req.OrderLinkID
+	}
+	if req.ProductID != nil {
+		params["productId"] = *req.ProductID
+	}
+	if req.StartTime != nil {
+		params["startTime"] = strconv.FormatInt(*req.StartTime, 10)
+	}
+	if req.EndTime != nil {
+		params["endTime"] = strconv.FormatInt(*req.EndTime, 10)
+	}
+	if req.Limit != nil {
+		params["limit"] = strconv.Itoa(*req.Limit)
+	}
+	if req.Cursor != nil {
+		params["cursor"] = *req.Cursor
+	}
+	return params
+}
+
+func validateHistoryRequest(req *HistoryRequest) error {
+	if err := validCategory(req.Category); err != nil {
+		return err
+	}
+	if req.Limit != nil && (*req.Limit < 1 || *req.Limit > MaxHistoryLimit) {
+		return fmt.Errorf("limit must be between 1 and %d", MaxHistoryLimit)
+	}
+	if req.StartTime != nil && req.EndTime != nil && *req.StartTime > *req.EndTime {
+		return fmt.Errorf("startTime must not be after endTime")
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+package earn
+
+// MaxHistoryLimit is the largest page size accepted by the order and yield history endpoints.
+const MaxHistoryLimit = 100
+
+// Earn product categories.
+const (
+	CategoryFlexibleSaving = "FlexibleSaving"
+	CategoryOnChain        = "OnChain"
+)
+
+// Order types of an earn order.
+const (
+	OrderTypeStake  = "Stake"
+	OrderTypeRedeem = "Redeem"
+)
+
+// Wallets an earn order can be funded from or redeemed to.
+const (
+	AccountTypeFund    = "FUND"
+	AccountTypeUnified = "UNIFIED"
+)
+
+// Values of Product.Status.
+const (
+	ProductStatusAvailable    = "Available"
+	ProductStatusNotAvailable = "NotAvailable"
+)
+
+// Product describes an earn product.
+type Product struct {
+	Category               string `json:"category"`
+	EstimateApr            string `json:"estimateApr"`
+	Coin                   string `json:"coin"`
+	MinStakeAmount         string `json:"minStakeAmount"`
+	MaxStakeAmount         string `json:"maxStakeAmount"`
+	Precision              string `json:"precision"`
+	ProductID              string `json:"productId"`
+	Status                 string `json:"status"`
+	MinRedeemAmount        string `json:"minRedeemAmount"`
+	MaxRedeemAmount        string `json:"maxRedeemAmount"`
+	Duration               string `json:"duration"` // OnChain only: Fixed or Flexible
+	Term                   int    `json:"term"`     // OnChain only: days of a fixed term product
+	SwapCoin               string `json:"swapCoin"`
+	SwapCoinPrecision      string `json:"swapCoinPrecision"`
+	StakeExchangeRate      string `json:"stakeExchangeRate"`
+	RedeemExchangeRate     string `json:"redeemExchangeRate"`
+	RewardDistributionType string `json:"rewardDistributionType"`
+	RewardIntervalMinute   int    `json:"rewardIntervalMinute"`
+	RedeemProcessingMinute int    `json:"redeemProcessingMinute"`
+}
+
+// ProductsResponse represents the response from querying the earn products.
+type ProductsResponse struct {
+	RetCode int    `json:"retCode"`
+	RetMsg  string `json:"retMsg"`
+	Result  struct {
+		List []Product `json:"list"`
+	} `json:"result"`
+	RetExtInfo any   `json:"retExtInfo"`
+	Time       int64 `json:"time"`
+}
+
+// OrderRequest represents the payload for subscribing to or redeeming from an earn product.
+type OrderRequest struct {
+	Category         string  `json:"category"`                   // Required: CategoryFlexibleSaving or CategoryOnChain
+	AccountType      string  `json:"accountType"`                // Required: AccountTypeFund or AccountTypeUnified
+	Amount           string  `json:"amount"`                     // Required: Amount to stake or redeem
+	Coin             string  `json:"coin"`                       // Required: Coin of the product
+	ProductID        string  `json:"productId"`                  // Required: Product ID
+	OrderLinkID      *string `json:"orderLinkId,omitempty"`      // Optional: Customised order ID, generated when unset
+	RedeemPositionID *string `json:"redeemPositionId,omitempty"` // Optional: Position to redeem, OnChain fixed term products only
+	ToAccountType    *string `json:"toAccountType,omitempty"`    // Optional: Wallet to redeem to, OnChain only
+}
+
+// OrderResponse represents the response from placing an earn order.
+type OrderResponse struct {
+	RetCode int    `json:"retCode"`
+	RetMsg  string `json:"retMsg"`
+	Result  struct {
+		OrderID     string `json:"orderId"`
+		OrderLinkID string `json:"orderLinkId"`
+	} `json:"result"`
+	RetExtInfo any   `json:"retExtInfo"`
+	Time       int64 `json:"time"`
+}
+
+// HistoryRequest represents the query parameters shared by the order and yield history endpoints.
+type HistoryRequest struct {
+	Category    string  `json:"category"`              // Required: CategoryFlexibleSaving or CategoryOnChain
+	OrderID     *string `json:"orderId,omitempty"`     // Optional: Order ID, GetOrderHistory only
+	OrderLinkID *string `json:"orderLinkId,omitempty"` // Optional: Customised order ID, GetOrderHistory only
+	ProductID   *string `json:"productId,omitempty"`   // Optional: Product ID
+	StartTime   *int64  `json:"startTime,omitempty"`   // Optional: Start timestamp in milliseconds
+	EndTime     *int64  `json:"endTime,omitempty"`     // Optional: End timestamp in milliseconds
+	Limit       *int    `json:"limit,omitempty"`       // Optional: Limit for data size per page, at most 100
+	Cursor      *string `json:"cursor,omitempty"`      // Optional: Cursor of a previous page; disables automatic paging
+}
+
+// Order is a stake or redeem order.
+type Order struct {
+	Coin               string `json:"coin"`
+	OrderValue         string `json:"orderValue"`
+	OrderType          string `json:"orderType"`
+	OrderID            string `json:"orderId"`
+	OrderLinkID        string `json:"orderLinkId"`
+	Status             string `json:"status"` // Pending, Success or Fail
+	CreatedAt          string `json:"createdAt"`
+	ProductID          string `json:"productId"`
+	UpdatedAt          string `json:"updatedAt"`
+	SwapOrderValue     string `json:"swapOrderValue"`
+	EstimateRedeemTime string `json:"estimateRedeemTime"`
+	EstimateStakeTime  string `json:"estimateStakeTime"`
+}
+
+// OrderHistoryResponse represents the response from querying the earn order history.
+type OrderHistoryResponse struct {
+	RetCode int    `json:"retCode"`
+	RetMsg  string `json:"retMsg"`
+	Result  struct {
+		List           []Order `json:"list"`
+		NextPageCursor string  `json:"nextPageCursor"`
+	} `json:"result"`
+	RetExtInfo any   `json:"retExtInfo"`
+	Time       int64 `json:"time"`
+}
+
+// Position is a staked position in an earn product.
+type Position struct {
+	Coin                            string `json:"coin"`
+	ProductID                       string `json:"productId"`
+	Amount                          string `json:"amount"`
+	TotalPnl                        string `json:"totalPnl"`
+	ClaimableYield                  string `json:"claimableYield"`
+	ID                              string `json:"id"`
+	Status                          string `json:"status"`
+	OrderID                         string `json:"orderId"`
+	EstimateRedeemTime              string `json:"estimateRedeemTime"`
+	EstimateStakeTime               string `json:"estimateStakeTime"`
+	EstimateInterestCalculationTime string `json:"estimateInterestCalculationTime"`
+	SettlementTime                  string `json:"settlementTime"`
+}
+
+// PositionsResponse represents the response from querying the staked positions.
+type PositionsResponse struct {
+	RetCode int    `json:"retCode"`
+	RetMsg  string `json:"retMsg"`
+	Result  struct {
+		List []Position `json:"list"`
+	} `json:"result"`
+	RetExtInfo any   `json:"retExtInfo"`
+	Time       int64 `json:"time"`
+}
+
+// Yield is a single yield distribution.
+type Yield struct {
+	ProductID              string `json:"productId"`
+	Coin                   string `json:"coin"`
+	ID                     string `json:"id"`
+	Amount                 string `json:"amount"`
+	YieldType              string `json:"yieldType"`
+	DistributionMode       string `json:"distributionMode"`
+	EffectiveStakingAmount string `json:"effectiveStakingAmount"`
+	OrderID                string `json:"orderId"`
+	Status                 string `json:"status"`
+	CreatedAt              string `json:"createdAt"`
+}
+
+// YieldHistoryResponse represents the response from querying the yield history.
+type YieldHistoryResponse struct {
+	RetCode int    `json:"retCode"`
+	RetMsg  string `json:"retMsg"`
+	Result  struct {
+		Yield          []Yield `json:"yield"`
+		NextPageCursor string  `json:"nextPageCursor"`
+	} `json:"result"`
+	RetExtInfo any   `json:"retExtInfo"`
+	Time       int64 `json:"time"`
+}
@@ -0,0 +1,24 @@
+package insloan
+
+import (
+	"strconv"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+)
+
+func ConvertRecordRequestToParams(req *RecordRequest) client.Params {
+	params := make(client.Params)
+	if req.OrderID != nil {
+		params["orderId"] = *req.OrderID
+	}
+	if req.StartTime != nil {
+		params["startTime"] = strconv.FormatInt(*req.StartTime, 10)
+	}
+	if req.EndTime != nil {
+		params["endTime"] = strconv.FormatInt(*req.EndTime, 10)
+	}
+	if req.Limit != nil {
+		params["limit"] = strconv.Itoa(*req.Limit)
+	}
+	return params
+}
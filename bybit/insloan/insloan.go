@@ -0,0 +1,157 @@
+package insloan
+
+import (
+	"fmt"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+)
+
+// InsLoan defines the interface for the institutional lending endpoints of the Bybit API.
+type InsLoan interface {
+	// GetProductInfo retrieves the institutional loan products.
+	// productID: string - the product ID; empty returns every product.
+	// returns: *ProductInfoResponse - the products and their risk lines.
+	//          error - an error if the request fails.
+	GetProductInfo(productID string) (*ProductInfoResponse, error)
+
+	// GetMarginCoinInfo retrieves the margin coins and collateral ratios of the loan products.
+	// productID: string - the product ID; empty returns every product.
+	// returns: *MarginCoinInfoResponse - the margin coins per product.
+	//          error - an error if the request fails.
+	GetMarginCoinInfo(productID string) (*MarginCoinInfoResponse, error)
+
+	// GetLoanOrders retrieves the loan orders.
+	// req: RecordRequest - the order ID, time range and limit of the query.
+	// returns: *LoanOrdersResponse - the loan orders.
+	//          error - an error if the request fails.
+	GetLoanOrders(req *RecordRequest) (*LoanOrdersResponse, error)
+
+	// GetRepayOrders retrieves the repay orders.
+	// req: RecordRequest - the time range and limit of the query.
+	// returns: *RepayOrdersResponse - the repay orders.
+	//          error - an error if the request fails.
+	GetRepayOrders(req *RecordRequest) (*RepayOrdersResponse, error)
+
+	// GetLTV retrieves the loan-to-value ratio of the outstanding loans.
+	// returns: *LTVResponse - the LTV, unpaid amounts and balances.
+	//          error - an error if the request fails.
+	GetLTV() (*LTVResponse, error)
+}
+
+type impl struct {
+	client *client.Client
+}
+
+// New creates a new instance of the InsLoan interface, which can be used to interact with the Bybit API.
+func New(c *client.Client) InsLoan {
+	return &impl{client: c}
+}
+
+// GetProductInfo retrieves one or every institutional loan product.
+func (i *impl) GetProductInfo(productID string) (*ProductInfoResponse, error) {
+	params := client.Params{}
+	if productID != "" {
+		params["productId"] = productID
+	}
+	response, err := i.client.Get("/v5/ins-loan/product-infos", params)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching loan product info: %w", err)
+	}
+	var productResponse ProductInfoResponse
+	if err := response.Unmarshal(&productResponse); err != nil {
+		return nil, fmt.Errorf("error parsing loan product info response: %w", err)
+	}
+	if productResponse.RetCode != 0 {
+		return &productResponse, fmt.Errorf("API returned error: %s", productResponse.RetMsg)
+	}
+	return &productResponse, nil
+}
+
+// GetMarginCoinInfo retrieves the margin coins of one or every loan product along with the
+// ladders used to convert their value into collateral.
+func (i *impl) GetMarginCoinInfo(productID string) (*MarginCoinInfoResponse, error) {
+	params := client.Params{}
+	if productID != "" {
+		params["productId"] = productID
+	}
+	response, err := i.client.Get("/v5/ins-loan/ensure-tokens-convert", params)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching margin coin info: %w", err)
+	}
+	var coinResponse MarginCoinInfoResponse
+	if err := response.Unmarshal(&coinResponse); err != nil {
+		return nil, fmt.Errorf("error parsing margin coin info response: %w", err)
+	}
+	if coinResponse.RetCode != 0 {
+		return &coinResponse, fmt.Errorf("API returned error: %s", coinResponse.RetMsg)
+	}
+	return &coinResponse, nil
+}
+
+// GetLoanOrders retrieves the loan orders, newest first.
+func (i *impl) GetLoanOrders(req *RecordRequest) (*LoanOrdersResponse, error) {
+	if err := validateRecordRequest(req); err != nil {
+		return nil, err
+	}
+	response, err := i.client.Get("/v5/ins-loan/loan-order", ConvertRecordRequestToParams(req))
+	if err != nil {
+		return nil, fmt.Errorf("error fetching loan orders: %w", err)
+	}
+	var ordersResponse LoanOrdersResponse
+	if err := response.Unmarshal(&ordersResponse); err != nil {
+		return nil, fmt.Errorf("error parsing loan orders response: %w", err)
+	}
+	if ordersResponse.RetCode != 0 {
+		return &ordersResponse, fmt.Errorf("API returned error: %s", ordersResponse.RetMsg)
+	}
+	return &ordersResponse, nil
+}
+
+// GetRepayOrders retrieves the repay orders, newest first.
+func (i *impl) GetRepayOrders(req *RecordRequest) (*RepayOrdersResponse, error) {
+	if req.OrderID != nil {
+		return nil, fmt.Errorf("orderId is not supported by the repay orders endpoint")
+	}
+	if err := validateRecordRequest(req); err != nil {
+		return nil, err
+	}
+	response, err := i.client.Get("/v5/ins-loan/repaid-history", ConvertRecordRequestToParams(req))
+	if err != nil {
+		return nil, fmt.Errorf("error fetching repay orders: %w", err)
+	}
+	var ordersResponse RepayOrdersResponse
+	if err := response.Unmarshal(&ordersResponse); err != nil {
+		return nil, fmt.Errorf("error parsing repay orders response: %w", err)
+	}
+	if ordersResponse.RetCode != 0 {
+		return &ordersResponse, fmt.Errorf("API returned error: %s", ordersResponse.RetMsg)
+	}
+	return &ordersResponse, nil
+}
+
+// GetLTV retrieves the loan-to-value ratio of the outstanding loans, including the sub accounts
+// bound to the risk unit.
+func (i *impl) GetLTV() (*LTVResponse, error) {
+	response, err := i.client.Get("/v5/ins-loan/ltv-convert", client.Params{})
+	if err != nil {
+		return nil, fmt.Errorf("error fetching LTV: %w", err)
+	}
+	var ltvResponse LTVResponse
+	if err := response.Unmarshal(&ltvResponse); err != nil {
+		return nil, fmt.Errorf("error parsing LTV response: %w", err)
+	}
+	if ltvResponse.RetCode != 0 {
+		return &ltvResponse, fmt.Errorf("API returned error: %s", ltvResponse.RetMsg)
+	}
+	return &ltvResponse, nil
+}
+
+func validateRecordRequest(req *RecordRequest) error {
+	if req.Limit != nil && (*req.Limit < 1 || *req.Limit > MaxRecordLimit) {
+		return fmt.Errorf("limit must be between 1 and %d", MaxRecordLimit)
+	}
+	if req.StartTime != nil && req.EndTime != nil && *req.StartTime > *req.EndTime {
+		return fmt.Errorf("startTime must not be after endTime")
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+package insloan
+
+// MaxRecordLimit is the largest page size accepted by the loan and repay order endpoints.
+const MaxRecordLimit = 100
+
+// Values of LoanOrder.Status.
+const (
+	LoanStatusOutstanding = 1
+	LoanStatusPaidOff     = 2
+)
+
+// SymbolLeverage is the maximum leverage of a contract symbol under a loan product.
+type SymbolLeverage struct {
+	Symbol   string `json:"symbol"`
+	Leverage string `json:"leverage"`
+}
+
+// ProductInfo describes an institutional loan product and its risk lines.
+type ProductInfo struct {
+	ProductID               string           `json:"productId"`
+	Leverage                string           `json:"leverage"`
+	SupportSpot             int              `json:"supportSpot"`
+	SupportContract         int              `json:"supportContract"`
+	SupportMarginTrading    int              `json:"supportMarginTrading"`
+	DeferredLiquidationLine string           `json:"deferredLiquidationLine"`
+	DeferredLiquidationTime string           `json:"deferredLiquidationTime"`
+	WithdrawLine            string           `json:"withdrawLine"`
+	TransferLine            string           `json:"transferLine"`
+	SpotBuyLine             string           `json:"spotBuyLine"`
+	SpotSellLine            string           `json:"spotSellLine"`
+	ContractOpenLine        string           `json:"contractOpenLine"`
+	LiquidationLine         string           `json:"liquidationLine"`
+	StopLiquidationLine     string           `json:"stopLiquidationLine"`
+	ContractLeverage        string           `json:"contractLeverage"`
+	TransferRatio           string           `json:"transferRatio"`
+	SpotSymbols             []string         `json:"spotSymbols"`
+	ContractSymbols         []string         `json:"contractSymbols"`
+	MarginLeverage          string           `json:"marginLeverage"`
+	USDTPerpetualLeverage   []SymbolLeverage `json:"USDTPerpetualLeverage"`
+	USDCContractLeverage    []SymbolLeverage `json:"USDCContractLeverage"`
+}
+
+// ProductInfoResponse represents the response from querying the loan products.
+type ProductInfoResponse struct {
+	RetCode int    `json:"retCode"`
+	RetMsg  string `json:"retMsg"`
+	Result  struct {
+		MarginProductInfo []ProductInfo `json:"marginProductInfo"`
+	} `json:"result"`
+	RetExtInfo any   `json:"retExtInfo"`
+	Time       int64 `json:"time"`
+}
+
+// ConvertRatio is the collateral ratio applied to the value of a coin within a ladder.
+type ConvertRatio struct {
+	Ladder       string `json:"ladder"`
+	ConvertRatio string `json:"convertRatio"`
+}
+
+// MarginCoinInfoResponse represents the response from querying the margin coins of the loan products.
+type MarginCoinInfoResponse struct {
+	RetCode int    `json:"retCode"`
+	RetMsg  string `json:"retMsg"`
+	Result  struct {
+		MarginToken []struct {
+			ProductID string `json:"productId"`
+			TokenInfo []struct {
+				Token            string         `json:"token"`
+				ConvertRatioList []ConvertRatio `json:"convertRatioList"`
+			} `json:"tokenInfo"`
+		} `json:"marginToken"`
+	} `json:"result"`
+	RetExtInfo any   `json:"retExtInfo"`
+	Time       int64 `json:"time"`
+}
+
+// RecordRequest represents the query parameters shared by the loan and repay order endpoints.
+type RecordRequest struct {
+	OrderID   *string `json:"orderId,omitempty"`   // Optional: Loan order ID, GetLoanOrders only
+	StartTime *int64  `json:"startTime,omitempty"` // Optional: Start timestamp in milliseconds
+	EndTime   *int64  `json:"endTime,omitempty"`   // Optional: End timestamp in milliseconds
+	Limit     *int    `json:"limit,omitempty"`     // Optional: Limit for data size, at most 100
+}
+
+// LoanOrder is a single institutional loan.
+type LoanOrder struct {
+	OrderID        string `json:"orderId"`
+	OrderProductID string `json:"orderProductId"`
+	ParentUID      string `json:"parentUid"`
+	LoanTime       string `json:"loanTime"`
+	LoanCoin       string `json:"loanCoin"`
+	LoanAmount     string `json:"loanAmount"`
+	UnpaidAmount   string `json:"unpaidAmount"`
+	UnpaidInterest string `json:"unpaidInterest"`
+	RepaidAmount   string `json:"repaidAmount"`
+	RepaidInterest string `json:"repaidInterest"`
+	InterestRate   string `json:"interestRate"`
+	Status         int    `json:"status"`
+	Leverage       string `json:"leverage"`
+}
+
+// LoanOrdersResponse represents the response from querying the loan orders.
+type LoanOrdersResponse struct {
+	RetCode int    `json:"retCode"`
+	RetMsg  string `json:"retMsg"`
+	Result  struct {
+		LoanInfo []LoanOrder `json:"loanInfo"`
+	} `json:"result"`
+	RetExtInfo any   `json:"retExtInfo"`
+	Time       int64 `json:"time"`
+}
+
+// RepayOrder is a single repayment of an institutional loan.
+type RepayOrder struct {
+	RepayOrderID string `json:"repayOrderId"`
+	RepaidTime   string `json:"repaidTime"`
+	Token        string `json:"token"`
+	Quantity     string `json:"quantity"`
+	Interest     string `json:"interest"`
+	BusinessType string `json:"businessType"` // 1 normal repayment, 2 repaid by liquidation
+	Status       string `json:"status"`       // 1 success, 2 fail
+}
+
+// RepayOrdersResponse represents the response from querying the repay orders.
+type RepayOrdersResponse struct {
+	RetCode int    `json:"retCode"`
+	RetMsg  string `json:"retMsg"`
+	Result  struct {
+		RepayInfo []RepayOrder `json:"repayInfo"`
+	} `json:"result"`
+	RetExtInfo any   `json:"retExtInfo"`
+	Time       int64 `json:"time"`
+}
+
+// LTVInfo is the loan-to-value ratio of a loan and the balances backing it.
+type LTVInfo struct {
+	LTV            string   `json:"ltv"`
+	Rst            string   `json:"rst"` // Remaining liquidation time in seconds, when deferred liquidation applies
+	ParentUID      string   `json:"parentUid"`
+	SubAccountUids []string `json:"subAccountUids"`
+	UnpaidAmount   string   `json:"unpaidAmount"`
+	UnpaidInfo     []struct {
+		Token          string `json:"token"`
+		UnpaidQty      string `json:"unpaidQty"`
+		UnpaidInterest string `json:"unpaidInterest"`
+	} `json:"unpaidInfo"`
+	Balance     string `json:"balance"`
+	BalanceInfo []struct {
+		Token           string `json:"token"`
+		Price           string `json:"price"`
+		Qty             string `json:"qty"`
+		ConvertedAmount string `json:"convertedAmount"`
+	} `json:"balanceInfo"`
+}
+
+// LTVResponse represents the response from querying the loan-to-value ratio.
+type LTVResponse struct {
+	RetCode int    `json:"retCode"`
+	RetMsg  string `json:"retMsg"`
+	Result  struct {
+		LtvInfo []LTVInfo `json:"ltvInfo"`
+	} `json:"result"`
+	RetExtInfo any   `json:"retExtInfo"`
+	Time       int64 `json:"time"`
+}
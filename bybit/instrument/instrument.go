@@ -0,0 +1,80 @@
+// Package instrument rounds order prices and quantities to the filters published by the
+// instruments-info endpoint, so orders are never rejected for precision violations.
+package instrument
+
+import (
+	"fmt"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/market"
+	"github.com/cploutarchou/crypto-sdk-suite/decimal"
+)
+
+// Rounder looks up instrument filters through an InstrumentCache and rounds values to them.
+type Rounder struct {
+	cache *market.InstrumentCache
+}
+
+// New creates a Rounder backed by the given cache.
+func New(cache *market.InstrumentCache) *Rounder {
+	return &Rounder{cache: cache}
+}
+
+// RoundPriceToTick rounds price to the nearest tick of the symbol.
+func (r *Rounder) RoundPriceToTick(category market.Category, symbol string, price decimal.Decimal) (decimal.Decimal, error) {
+	info, err := r.cache.Get(category, symbol)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return RoundPriceToTick(info, price)
+}
+
+// RoundQtyToStep rounds qty down to the quantity step of the symbol.
+func (r *Rounder) RoundQtyToStep(category market.Category, symbol string, qty decimal.Decimal) (decimal.Decimal, error) {
+	info, err := r.cache.Get(category, symbol)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return RoundQtyToStep(info, qty)
+}
+
+// TickSize returns the price tick of an instrument.
+func TickSize(info *market.InstrumentInfo) (decimal.Decimal, error) {
+	tick, err := decimal.NewFromString(info.PriceFilter.TickSize)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("invalid tick size for %s: %w", info.Symbol, err)
+	}
+	return tick, nil
+}
+
+// QtyStep returns the quantity step of an instrument. Spot instruments publish basePrecision
+// instead of qtyStep.
+func QtyStep(info *market.InstrumentInfo) (decimal.Decimal, error) {
+	raw := info.LotSizeFilter.QtyStep
+	if raw == "" {
+		raw = info.LotSizeFilter.BasePrecision
+	}
+	step, err := decimal.NewFromString(raw)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("invalid qty step for %s: %w", info.Symbol, err)
+	}
+	return step, nil
+}
+
+// RoundPriceToTick rounds price to the nearest multiple of the instrument's tick size.
+func RoundPriceToTick(info *market.InstrumentInfo, price decimal.Decimal) (decimal.Decimal, error) {
+	tick, err := TickSize(info)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return price.RoundToStep(tick)
+}
+
+// RoundQtyToStep rounds qty down to a multiple of the instrument's quantity step. Rounding down
+// keeps the order within the size the caller intended to risk.
+func RoundQtyToStep(info *market.InstrumentInfo, qty decimal.Decimal) (decimal.Decimal, error) {
+	step, err := QtyStep(info)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return qty.FloorToStep(step)
+}
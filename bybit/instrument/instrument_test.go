@@ -0,0 +1,72 @@
+package instrument
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/market"
+	"github.com/cploutarchou/crypto-sdk-suite/decimal"
+)
+
+// TestRounding verifies prices snap to the tick and quantities floor to the step.
+func TestRounding(t *testing.T) {
+	linear := &market.InstrumentInfo{
+		Symbol:        "BTCUSDT",
+		PriceFilter:   market.PriceFilter{TickSize: "0.10"},
+		LotSizeFilter: market.LotSizeFilter{QtyStep: "0.001"},
+	}
+	price, err := RoundPriceToTick(linear, decimal.RequireFromString("65000.26"))
+	assert.NoError(t, err)
+	assert.Equal(t, "65000.3", price.String())
+
+	qty, err := RoundQtyToStep(linear, decimal.RequireFromString("0.0129"))
+	assert.NoError(t, err)
+	assert.Equal(t, "0.012", qty.String())
+
+	spot := &market.InstrumentInfo{
+		Symbol:        "ETHUSDT",
+		PriceFilter:   market.PriceFilter{TickSize: "0.01"},
+		LotSizeFilter: market.LotSizeFilter{BasePrecision: "0.00001"},
+	}
+	qty, err = RoundQtyToStep(spot, decimal.RequireFromString("1.234567"))
+	assert.NoError(t, err)
+	assert.Equal(t, "1.23456", qty.String())
+
+	_, err = RoundPriceToTick(&market.InstrumentInfo{Symbol: "BAD"}, decimal.NewFromInt(1))
+	assert.Error(t, err)
+}
+
+// TestValidateOrder verifies each filter produces a descriptive error.
+func TestValidateOrder(t *testing.T) {
+	info := &market.InstrumentInfo{
+		Symbol:      "BTCUSDT",
+		PriceFilter: market.PriceFilter{MinPrice: "0.10", MaxPrice: "199999.80", TickSize: "0.10"},
+		LotSizeFilter: market.LotSizeFilter{
+			MinOrderQty:      "0.001",
+			MaxOrderQty:      "100",
+			MaxMktOrderQty:   "50",
+			QtyStep:          "0.001",
+			MinNotionalValue: "5",
+		},
+	}
+	d := decimal.RequireFromString
+
+	assert.NoError(t, ValidateOrder(info, Order{Qty: d("0.01"), Price: d("65000.1")}))
+	assert.NoError(t, ValidateOrder(info, Order{Qty: d("0.01"), IsMarket: true}))
+
+	cases := map[string]Order{
+		"qty":      {Qty: d("0.0105"), Price: d("65000")},
+		"price":    {Qty: d("0.01"), Price: d("65000.05")},
+		"notional": {Qty: d("0.001"), Price: d("1000")},
+	}
+	for field, order := range cases {
+		err := ValidateOrder(info, order)
+		var validationErr *ValidationError
+		assert.ErrorAs(t, err, &validationErr, field)
+		assert.Equal(t, field, validationErr.Field)
+	}
+
+	err := ValidateOrder(info, Order{Qty: d("60"), IsMarket: true})
+	assert.ErrorContains(t, err, "above the maximum 50")
+}
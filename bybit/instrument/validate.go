@@ -0,0 +1,134 @@
+package instrument
+
+import (
+	"fmt"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/market"
+	"github.com/cploutarchou/crypto-sdk-suite/decimal"
+)
+
+// ValidationError describes an order field that violates an instrument filter.
+type ValidationError struct {
+	Symbol string
+	Field  string
+	Reason string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid %s for %s: %s", e.Field, e.Symbol, e.Reason)
+}
+
+// Order holds the order fields checked against instrument filters. Price is zero for market orders.
+// QuoteQty marks a quantity expressed in the quote coin, which is how spot market buys are sized.
+type Order struct {
+	Qty      decimal.Decimal
+	Price    decimal.Decimal
+	IsMarket bool
+	QuoteQty bool
+}
+
+// ValidateOrder checks quantity and price against the lot size and price filters of an instrument
+// and returns a *ValidationError for the first violation found.
+func ValidateOrder(info *market.InstrumentInfo, order Order) error {
+	lot := info.LotSizeFilter
+	fail := func(field, format string, args ...any) error {
+		return &ValidationError{Symbol: info.Symbol, Field: field, Reason: fmt.Sprintf(format, args...)}
+	}
+
+	if order.Qty.Sign() <= 0 {
+		return fail("qty", "must be positive")
+	}
+
+	if order.QuoteQty {
+		if err := checkRange(order.Qty, lot.MinOrderAmt, lot.MaxOrderAmt); err != "" {
+			return fail("qty", "order amount %s %s", order.Qty, err)
+		}
+	} else {
+		maxQty := lot.MaxOrderQty
+		if order.IsMarket && lot.MaxMktOrderQty != "" {
+			maxQty = lot.MaxMktOrderQty
+		}
+		if err := checkRange(order.Qty, lot.MinOrderQty, maxQty); err != "" {
+			return fail("qty", "%s %s", order.Qty, err)
+		}
+		step, err := QtyStep(info)
+		if err == nil && !isMultiple(order.Qty, step) {
+			return fail("qty", "%s is not a multiple of the qty step %s", order.Qty, step)
+		}
+	}
+
+	if order.IsMarket {
+		return nil
+	}
+	if order.Price.Sign() <= 0 {
+		return fail("price", "must be positive for limit orders")
+	}
+	if err := checkRange(order.Price, info.PriceFilter.MinPrice, info.PriceFilter.MaxPrice); err != "" {
+		return fail("price", "%s %s", order.Price, err)
+	}
+	tick, err := TickSize(info)
+	if err == nil && !isMultiple(order.Price, tick) {
+		return fail("price", "%s is not a multiple of the tick size %s", order.Price, tick)
+	}
+
+	if order.QuoteQty {
+		return nil
+	}
+	notional := order.Qty.Mul(order.Price)
+	minNotional := lot.MinNotionalValue
+	if minNotional == "" {
+		minNotional = lot.MinOrderAmt
+	}
+	if minimum, err := decimal.NewFromString(minNotional); err == nil && notional.LessThan(minimum) {
+		return fail("notional", "%s is below the minimum order value %s", notional, minimum)
+	}
+	return nil
+}
+
+// ValidateQty checks a quantity on its own against the lot size filter, for amendments that
+// only change the quantity of a limit order.
+func ValidateQty(info *market.InstrumentInfo, qty decimal.Decimal) error {
+	if qty.Sign() <= 0 {
+		return &ValidationError{Symbol: info.Symbol, Field: "qty", Reason: "must be positive"}
+	}
+	if err := checkRange(qty, info.LotSizeFilter.MinOrderQty, info.LotSizeFilter.MaxOrderQty); err != "" {
+		return &ValidationError{Symbol: info.Symbol, Field: "qty", Reason: qty.String() + " " + err}
+	}
+	if step, err := QtyStep(info); err == nil && !isMultiple(qty, step) {
+		return &ValidationError{Symbol: info.Symbol, Field: "qty", Reason: fmt.Sprintf("%s is not a multiple of the qty step %s", qty, step)}
+	}
+	return nil
+}
+
+// ValidatePrice checks a price on its own against the price filter.
+func ValidatePrice(info *market.InstrumentInfo, price decimal.Decimal) error {
+	if price.Sign() <= 0 {
+		return &ValidationError{Symbol: info.Symbol, Field: "price", Reason: "must be positive"}
+	}
+	if err := checkRange(price, info.PriceFilter.MinPrice, info.PriceFilter.MaxPrice); err != "" {
+		return &ValidationError{Symbol: info.Symbol, Field: "price", Reason: price.String() + " " + err}
+	}
+	if tick, err := TickSize(info); err == nil && !isMultiple(price, tick) {
+		return &ValidationError{Symbol: info.Symbol, Field: "price", Reason: fmt.Sprintf("%s is not a multiple of the tick size %s", price, tick)}
+	}
+	return nil
+}
+
+// checkRange returns a description of the violation, or "" when value is within the optional bounds.
+func checkRange(value decimal.Decimal, minimum, maximum string) string {
+	if lower, err := decimal.NewFromString(minimum); err == nil && value.LessThan(lower) {
+		return "is below the minimum " + lower.String()
+	}
+	if upper, err := decimal.NewFromString(maximum); err == nil && upper.Sign() > 0 && value.GreaterThan(upper) {
+		return "is above the maximum " + upper.String()
+	}
+	return ""
+}
+
+func isMultiple(value, step decimal.Decimal) bool {
+	if step.Sign() <= 0 {
+		return true
+	}
+	floored, err := value.FloorToStep(step)
+	return err == nil && floored.Equal(value)
+}
@@ -0,0 +1,53 @@
+package lt
+
+import (
+	"strconv"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+)
+
+func ConvertPurchaseRequestToParams(req *PurchaseRequest) client.Params {
+	params := make(client.Params)
+	params["ltCoin"] = req.LtCoin
+	params["amount"] = req.Amount
+	if req.SerialNo != nil {
+		params["serialNo"] = *req.SerialNo
+	}
+	return params
+}
+
+func ConvertRedeemRequestToParams(req *RedeemRequest) client.Params {
+	params := make(client.Params)
+	params["ltCoin"] = req.LtCoin
+	params["quantity"] = req.Quantity
+	if req.SerialNo != nil {
+		params["serialNo"] = *req.SerialNo
+	}
+	return params
+}
+
+func ConvertOrderRecordRequestToParams(req *OrderRecordRequest) client.Params {
+	params := make(client.Params)
+	if req.LtCoin != nil {
+		params["ltCoin"] = *req.LtCoin
+	}
+	if req.OrderID != nil {
+		params["orderId"] = *req.OrderID
+	}
+	if req.StartTime != nil {
+		params["startTime"] = strconv.FormatInt(*req.StartTime, 10)
+	}
+	if req.EndTime != nil {
+		params["endTime"] = strconv.FormatInt(*req.EndTime, 10)
+	}
+	if req.Limit != nil {
+		params["limit"] = strconv.Itoa(*req.Limit)
+	}
+	if req.LtOrderType != nil {
+		params["ltOrderType"] = strconv.Itoa(*req.LtOrderType)
+	}
+	if req.SerialNo != nil {
+		params["serialNo"] = *req.SerialNo
+	}
+	return params
+}
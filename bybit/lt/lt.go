@@ -0,0 +1,150 @@
+package lt
+
+import (
+	"fmt"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+)
+
+// LeverageToken defines the interface for the spot leverage token endpoints of the Bybit API.
+type LeverageToken interface {
+	// GetTokenInfo retrieves the details of leverage tokens.
+	// ltCoin: string - the leverage token, e.g. BTC3L; empty returns every token.
+	// returns: *TokenInfoResponse - the limits, fees and values of the tokens.
+	//          error - an error if the request fails.
+	GetTokenInfo(ltCoin string) (*TokenInfoResponse, error)
+
+	// GetReferencePrice retrieves the net asset value and basket of a leverage token.
+	// ltCoin: string - the leverage token, e.g. BTC3L.
+	// returns: *ReferencePriceResponse - the market information of the token.
+	//          error - an error if the request fails.
+	GetReferencePrice(ltCoin string) (*ReferencePriceResponse, error)
+
+	// Purchase buys a leverage token with the quote coin.
+	// req: PurchaseRequest - the token and amount to purchase.
+	// returns: *PurchaseResponse - the purchase order.
+	//          error - an error if the request fails.
+	Purchase(req *PurchaseRequest) (*PurchaseResponse, error)
+
+	// Redeem redeems a leverage token for the quote coin.
+	// req: RedeemRequest - the token and quantity to redeem.
+	// returns: *RedeemResponse - the redeem order.
+	//          error - an error if the request fails.
+	Redeem(req *RedeemRequest) (*RedeemResponse, error)
+
+	// GetOrderRecords retrieves the purchase and redeem history.
+	// req: OrderRecordRequest - the filters of the history.
+	// returns: *OrderRecordResponse - the matching orders.
+	//          error - an error if the request fails.
+	GetOrderRecords(req *OrderRecordRequest) (*OrderRecordResponse, error)
+}
+
+type impl struct {
+	client *client.Client
+}
+
+// New creates a new instance of the LeverageToken interface, which can be used to interact with the Bybit API.
+func New(c *client.Client) LeverageToken {
+	return &impl{client: c}
+}
+
+// GetTokenInfo retrieves the details of one or every leverage token.
+func (i *impl) GetTokenInfo(ltCoin string) (*TokenInfoResponse, error) {
+	params := client.Params{}
+	if ltCoin != "" {
+		params["ltCoin"] = ltCoin
+	}
+	response, err := i.client.Get("/v5/spot-lever-token/info", params)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching leverage token info: %w", err)
+	}
+	var infoResponse TokenInfoResponse
+	if err := response.Unmarshal(&infoResponse); err != nil {
+		return nil, fmt.Errorf("error parsing leverage token info response: %w", err)
+	}
+	if infoResponse.RetCode != 0 {
+		return &infoResponse, fmt.Errorf("API returned error: %s", infoResponse.RetMsg)
+	}
+	return &infoResponse, nil
+}
+
+// GetReferencePrice retrieves the net asset value, circulation and basket of a leverage token.
+func (i *impl) GetReferencePrice(ltCoin string) (*ReferencePriceResponse, error) {
+	if ltCoin == "" {
+		return nil, fmt.Errorf("ltCoin is required")
+	}
+	response, err := i.client.Get("/v5/spot-lever-token/reference", client.Params{"ltCoin": ltCoin})
+	if err != nil {
+		return nil, fmt.Errorf("error fetching leverage token reference price: %w", err)
+	}
+	var priceResponse ReferencePriceResponse
+	if err := response.Unmarshal(&priceResponse); err != nil {
+		return nil, fmt.Errorf("error parsing leverage token reference price response: %w", err)
+	}
+	if priceResponse.RetCode != 0 {
+		return &priceResponse, fmt.Errorf("API returned error: %s", priceResponse.RetMsg)
+	}
+	return &priceResponse, nil
+}
+
+// Purchase buys a leverage token. The amount is denominated in the quote coin and must lie
+// within the minPurchase and maxPurchase limits reported by GetTokenInfo.
+func (i *impl) Purchase(req *PurchaseRequest) (*PurchaseResponse, error) {
+	if req.LtCoin == "" || req.Amount == "" {
+		return nil, fmt.Errorf("ltCoin and amount are required")
+	}
+	response, err := i.client.Post("/v5/spot-lever-token/purchase", ConvertPurchaseRequestToParams(req))
+	if err != nil {
+		return nil, fmt.Errorf("error purchasing leverage token: %w", err)
+	}
+	var purchaseResponse PurchaseResponse
+	if err := response.Unmarshal(&purchaseResponse); err != nil {
+		return nil, fmt.Errorf("error parsing purchase response: %w", err)
+	}
+	if purchaseResponse.RetCode != 0 {
+		return &purchaseResponse, fmt.Errorf("API returned error: %s", purchaseResponse.RetMsg)
+	}
+	return &purchaseResponse, nil
+}
+
+// Redeem redeems a quantity of a leverage token. The quantity must lie within the minRedeem and
+// maxRedeem limits reported by GetTokenInfo.
+func (i *impl) Redeem(req *RedeemRequest) (*RedeemResponse, error) {
+	if req.LtCoin == "" || req.Quantity == "" {
+		return nil, fmt.Errorf("ltCoin and quantity are required")
+	}
+	response, err := i.client.Post("/v5/spot-lever-token/redeem", ConvertRedeemRequestToParams(req))
+	if err != nil {
+		return nil, fmt.Errorf("error redeeming leverage token: %w", err)
+	}
+	var redeemResponse RedeemResponse
+	if err := response.Unmarshal(&redeemResponse); err != nil {
+		return nil, fmt.Errorf("error parsing redeem response: %w", err)
+	}
+	if redeemResponse.RetCode != 0 {
+		return &redeemResponse, fmt.Errorf("API returned error: %s", redeemResponse.RetMsg)
+	}
+	return &redeemResponse, nil
+}
+
+// GetOrderRecords retrieves the purchase and redeem history, newest first.
+func (i *impl) GetOrderRecords(req *OrderRecordRequest) (*OrderRecordResponse, error) {
+	if req.Limit != nil && (*req.Limit < 1 || *req.Limit > MaxOrderRecordLimit) {
+		return nil, fmt.Errorf("limit must be between 1 and %d", MaxOrderRecordLimit)
+	}
+	if req.LtOrderType != nil && *req.LtOrderType != OrderTypePurchase && *req.LtOrderType != OrderTypeRedeem {
+		return nil, fmt.Errorf("invalid ltOrderType %d", *req.LtOrderType)
+	}
+	response, err := i.client.Get("/v5/spot-lever-token/order-record", ConvertOrderRecordRequestToParams(req))
+	if err != nil {
+		return nil, fmt.Errorf("error fetching leverage token order records: %w", err)
+	}
+	var recordResponse OrderRecordResponse
+	if err := response.Unmarshal(&recordResponse); err != nil {
+		return nil, fmt.Errorf("error parsing order records response: %w", err)
+	}
+	if recordResponse.RetCode != 0 {
+		return &recordResponse, fmt.Errorf("API returned error: %s", recordResponse.RetMsg)
+	}
+	return &recordResponse, nil
+}
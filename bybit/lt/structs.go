@@ -0,0 +1,146 @@
+package lt
+
+// MaxOrderRecordLimit is the largest page size accepted by the order record endpoint.
+const MaxOrderRecordLimit = 500
+
+// Values of the ltOrderType field.
+const (
+	OrderTypePurchase = 1
+	OrderTypeRedeem   = 2
+)
+
+// TokenInfo describes a leverage token and its purchase and redeem limits.
+type TokenInfo struct {
+	LtCoin           string `json:"ltCoin"`
+	LtName           string `json:"ltName"`
+	MaxPurchase      string `json:"maxPurchase"`
+	MinPurchase      string `json:"minPurchase"`
+	MaxPurchaseDaily string `json:"maxPurchaseDaily"`
+	MaxRedeem        string `json:"maxRedeem"`
+	MinRedeem        string `json:"minRedeem"`
+	MaxRedeemDaily   string `json:"maxRedeemDaily"`
+	PurchaseFeeRate  string `json:"purchaseFeeRate"`
+	RedeemFeeRate    string `json:"redeemFeeRate"`
+	LtStatus         string `json:"ltStatus"` // 1 purchase and redeem allowed, 2 purchase only, 3 redeem only, 4 neither, 5 adjusting position
+	FundFee          string `json:"fundFee"`
+	FundFeeTime      string `json:"fundFeeTime"`
+	ManageFeeRate    string `json:"manageFeeRate"`
+	ManageFeeTime    string `json:"manageFeeTime"`
+	Value            string `json:"value"`
+	NetValue         string `json:"netValue"`
+	Total            string `json:"total"`
+}
+
+// TokenInfoResponse represents the response from querying leverage token information.
+type TokenInfoResponse struct {
+	RetCode int    `json:"retCode"`
+	RetMsg  string `json:"retMsg"`
+	Result  struct {
+		List []TokenInfo `json:"list"`
+	} `json:"result"`
+	RetExtInfo any   `json:"retExtInfo"`
+	Time       int64 `json:"time"`
+}
+
+// ReferencePriceResponse represents the response from querying the market of a leverage token.
+type ReferencePriceResponse struct {
+	RetCode int    `json:"retCode"`
+	RetMsg  string `json:"retMsg"`
+	Result  struct {
+		LtCoin      string `json:"ltCoin"`
+		Nav         string `json:"nav"`
+		NavTime     string `json:"navTime"`
+		Circulation string `json:"circulation"`
+		Basket      string `json:"basket"`
+		Leverage    string `json:"leverage"`
+	} `json:"result"`
+	RetExtInfo any   `json:"retExtInfo"`
+	Time       int64 `json:"time"`
+}
+
+// PurchaseRequest represents the payload for purchasing a leverage token.
+type PurchaseRequest struct {
+	LtCoin   string  `json:"ltCoin"`             // Required: Leverage token, e.g. BTC3L
+	Amount   string  `json:"amount"`             // Required: Purchase amount in the quote coin
+	SerialNo *string `json:"serialNo,omitempty"` // Optional: Customised serial number
+}
+
+// PurchaseResponse represents the response from purchasing a leverage token.
+type PurchaseResponse struct {
+	RetCode int    `json:"retCode"`
+	RetMsg  string `json:"retMsg"`
+	Result  struct {
+		LtCoin        string `json:"ltCoin"`
+		LtOrderStatus string `json:"ltOrderStatus"` // 1 completed, 2 processing, 3 failed
+		ExecQty       string `json:"execQty"`
+		ExecAmt       string `json:"execAmt"`
+		Amount        string `json:"amount"`
+		PurchaseID    string `json:"purchaseId"`
+		SerialNo      string `json:"serialNo"`
+		ValueCoin     string `json:"valueCoin"`
+	} `json:"result"`
+	RetExtInfo any   `json:"retExtInfo"`
+	Time       int64 `json:"time"`
+}
+
+// RedeemRequest represents the payload for redeeming a leverage token.
+type RedeemRequest struct {
+	LtCoin   string  `json:"ltCoin"`             // Required: Leverage token, e.g. BTC3L
+	Quantity string  `json:"quantity"`           // Required: Redeem quantity of the leverage token
+	SerialNo *string `json:"serialNo,omitempty"` // Optional: Customised serial number
+}
+
+// RedeemResponse represents the response from redeeming a leverage token.
+type RedeemResponse struct {
+	RetCode int    `json:"retCode"`
+	RetMsg  string `json:"retMsg"`
+	Result  struct {
+		LtCoin        string `json:"ltCoin"`
+		LtOrderStatus string `json:"ltOrderStatus"` // 1 completed, 2 processing, 3 failed
+		Quantity      string `json:"quantity"`
+		ExecQty       string `json:"execQty"`
+		ExecAmt       string `json:"execAmt"`
+		RedeemID      string `json:"redeemId"`
+		SerialNo      string `json:"serialNo"`
+		ValueCoin     string `json:"valueCoin"`
+	} `json:"result"`
+	RetExtInfo any   `json:"retExtInfo"`
+	Time       int64 `json:"time"`
+}
+
+// OrderRecordRequest represents the query parameters for the purchase and redeem history.
+type OrderRecordRequest struct {
+	LtCoin      *string `json:"ltCoin,omitempty"`      // Optional: Leverage token
+	OrderID     *string `json:"orderId,omitempty"`     // Optional: Order ID
+	StartTime   *int64  `json:"startTime,omitempty"`   // Optional: Start timestamp in milliseconds
+	EndTime     *int64  `json:"endTime,omitempty"`     // Optional: End timestamp in milliseconds
+	Limit       *int    `json:"limit,omitempty"`       // Optional: Limit for data size, at most 500
+	LtOrderType *int    `json:"ltOrderType,omitempty"` // Optional: OrderTypePurchase or OrderTypeRedeem
+	SerialNo    *string `json:"serialNo,omitempty"`    // Optional: Customised serial number
+}
+
+// OrderRecord is a single purchase or redeem order.
+type OrderRecord struct {
+	LtCoin        string `json:"ltCoin"`
+	OrderID       string `json:"orderId"`
+	LtOrderType   int    `json:"ltOrderType"`
+	OrderTime     int64  `json:"orderTime"`
+	UpdateTime    int64  `json:"updateTime"`
+	LtOrderStatus string `json:"ltOrderStatus"`
+	Fee           string `json:"fee"`
+	Amount        string `json:"amount"`
+	Value         string `json:"value"`
+	ValueCoin     string `json:"valueCoin"`
+	SerialNo      string `json:"serialNo"`
+}
+
+// OrderRecordResponse represents the response from querying the purchase and redeem history.
+type OrderRecordResponse struct {
+	RetCode int    `json:"retCode"`
+	RetMsg  string `json:"retMsg"`
+	Result  struct {
+		List []OrderRecord `json:"list"`
+	} `json:"result"`
+	RetExtInfo any   `json:"retExtInfo"`
+	Time       int64 `json:"time"`
+}
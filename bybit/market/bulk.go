@@ -0,0 +1,92 @@
+package market
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// BulkConcurrency is the number of requests the bulk helpers keep in flight. All of them still
+// wait on the client's shared market data rate limiter.
+const BulkConcurrency = 10
+
+// fetchConcurrently calls fetch for every symbol with at most BulkConcurrency calls in flight.
+// Results are keyed by symbol; failed symbols are left out and reported in the joined error.
+func fetchConcurrently[T any](symbols []string, fetch func(symbol string) (T, error)) (map[string]T, error) {
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		results = make(map[string]T, len(symbols))
+		errs    []error
+		sem     = make(chan struct{}, BulkConcurrency)
+	)
+	for _, symbol := range symbols {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(symbol string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			value, err := fetch(symbol)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", symbol, err))
+				return
+			}
+			results[symbol] = value
+		}(symbol)
+	}
+	wg.Wait()
+	return results, errors.Join(errs...)
+}
+
+// GetTickersForSymbols returns the tickers of the given symbols keyed by symbol. Spot, linear and
+// inverse tickers are fetched with a single call for the whole category; option tickers are
+// fetched per symbol concurrently.
+func (m *marketImpl) GetTickersForSymbols(category Category, symbols []string) (map[string]TickerInfo, error) {
+	if category != CategoryOption {
+		res, err := m.GetTickers(&TickersRequest{Category: category})
+		if err != nil {
+			return nil, err
+		}
+		all := make(map[string]TickerInfo, len(res.Result.List))
+		for _, ticker := range res.Result.List {
+			all[ticker.Symbol] = ticker
+		}
+		results := make(map[string]TickerInfo, len(symbols))
+		var errs []error
+		for _, symbol := range symbols {
+			ticker, ok := all[symbol]
+			if !ok {
+				errs = append(errs, fmt.Errorf("%s: ticker not found in category %s", symbol, category))
+				continue
+			}
+			results[symbol] = ticker
+		}
+		return results, errors.Join(errs...)
+	}
+
+	return fetchConcurrently(symbols, func(symbol string) (TickerInfo, error) {
+		res, err := m.GetTickers(&TickersRequest{Category: category, Symbol: &symbol})
+		if err != nil {
+			return TickerInfo{}, err
+		}
+		if len(res.Result.List) == 0 {
+			return TickerInfo{}, errors.New("ticker not found")
+		}
+		return res.Result.List[0], nil
+	})
+}
+
+// GetLatestKlines returns the latest limit candles of each symbol keyed by symbol, newest first.
+// Requests run concurrently under the shared rate limiter.
+func (m *marketImpl) GetLatestKlines(category Category, interval Interval, limit int, symbols []string) (map[string][]Candle, error) {
+	return fetchConcurrently(symbols, func(symbol string) ([]Candle, error) {
+		return m.GetCandles(&KlineRequest{
+			Category: category,
+			Symbol:   symbol,
+			Interval: interval,
+			Limit:    &limit,
+		})
+	})
+}
@@ -0,0 +1,39 @@
+package market
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestFetchConcurrently verifies results are keyed by symbol, failures are reported and the
+// number of calls in flight never exceeds BulkConcurrency.
+func TestFetchConcurrently(t *testing.T) {
+	symbols := make([]string, 0, 50)
+	for i := 0; i < 50; i++ {
+		symbols = append(symbols, string(rune('A'+i%26))+string(rune('a'+i/26)))
+	}
+	var inFlight, peak atomic.Int32
+
+	results, err := fetchConcurrently(symbols, func(symbol string) (string, error) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		if symbol == "Aa" {
+			return "", errors.New("boom")
+		}
+		return symbol + "!", nil
+	})
+
+	assert.ErrorContains(t, err, "Aa: boom")
+	assert.Len(t, results, 49)
+	assert.Equal(t, "Ba!", results["Ba"])
+	assert.LessOrEqual(t, peak.Load(), int32(BulkConcurrency))
+}
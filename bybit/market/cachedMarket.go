@@ -0,0 +1,111 @@
+package market
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// CacheTTLs configures how long each cached endpoint reuses a response. A zero TTL disables
+// caching for that endpoint.
+type CacheTTLs struct {
+	Tickers         time.Duration
+	InstrumentsInfo time.Duration
+	FundingHistory  time.Duration
+}
+
+// DefaultCacheTTLs suits dashboards that refresh every second or so.
+var DefaultCacheTTLs = CacheTTLs{
+	Tickers:         time.Second,
+	InstrumentsInfo: time.Hour,
+	FundingHistory:  time.Minute,
+}
+
+// cachedMarket decorates a Market, serving repeated identical requests from memory until their
+// TTL expires. Responses are stored encoded and every caller gets its own copy, so modifying a
+// response does not affect the cache. Calls that are not cached pass straight through to the
+// wrapped Market.
+type cachedMarket struct {
+	Market
+	ttls CacheTTLs
+	now  func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	value     []byte // JSON encoding of the response
+	expiresAt time.Time
+}
+
+// NewCachedMarket wraps m with a response cache for tickers, instruments info and funding history.
+func NewCachedMarket(m Market, ttls CacheTTLs) Market {
+	return &cachedMarket{
+		Market:  m,
+		ttls:    ttls,
+		now:     time.Now,
+		entries: make(map[string]cacheEntry),
+	}
+}
+
+// cached returns a copy of the stored value for the endpoint and request, or calls fetch and
+// stores the result. Errors are never cached, and expired entries are evicted whenever a value
+// is stored.
+func cached[T any](c *cachedMarket, endpoint string, ttl time.Duration, req any, fetch func() (T, error)) (T, error) {
+	if ttl <= 0 {
+		return fetch()
+	}
+	raw, err := json.Marshal(req)
+	if err != nil {
+		return fetch()
+	}
+	key := endpoint + ":" + string(raw)
+
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+	if ok && c.now().Before(entry.expiresAt) {
+		var value T
+		if err := json.Unmarshal(entry.value, &value); err == nil {
+			return value, nil
+		}
+	}
+
+	value, err := fetch()
+	if err != nil {
+		return value, err
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return value, nil
+	}
+	now := c.now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k, e := range c.entries {
+		if !now.Before(e.expiresAt) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = cacheEntry{value: encoded, expiresAt: now.Add(ttl)}
+	return value, nil
+}
+
+func (c *cachedMarket) GetTickers(req *TickersRequest) (*TickerResponse, error) {
+	return cached(c, "tickers", c.ttls.Tickers, req, func() (*TickerResponse, error) {
+		return c.Market.GetTickers(req)
+	})
+}
+
+func (c *cachedMarket) GetInstrumentsInfo(req *InstrumentsInfoRequest) (*InstrumentsInfoResponse, error) {
+	return cached(c, "instruments-info", c.ttls.InstrumentsInfo, req, func() (*InstrumentsInfoResponse, error) {
+		return c.Market.GetInstrumentsInfo(req)
+	})
+}
+
+func (c *cachedMarket) GetFundingRateHistory(req *FundingRateHistoryRequest) (*FundingRateHistory, error) {
+	return cached(c, "funding-history", c.ttls.FundingHistory, req, func() (*FundingRateHistory, error) {
+		return c.Market.GetFundingRateHistory(req)
+	})
+}
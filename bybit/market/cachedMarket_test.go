@@ -0,0 +1,65 @@
+package market
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeTickersMarket struct {
+	Market
+	calls int
+}
+
+func (f *fakeTickersMarket) GetTickers(req *TickersRequest) (*TickerResponse, error) {
+	f.calls++
+	var res TickerResponse
+	res.Result.Category = string(req.Category)
+	return &res, nil
+}
+
+// TestCachedMarket verifies identical requests are served from memory until the TTL expires.
+func TestCachedMarket(t *testing.T) {
+	fake := &fakeTickersMarket{}
+	m := NewCachedMarket(fake, CacheTTLs{Tickers: time.Second}).(*cachedMarket)
+	now := time.Now()
+	m.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		_, err := m.GetTickers(&TickersRequest{Category: CategoryLinear})
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 1, fake.calls)
+
+	_, _ = m.GetTickers(&TickersRequest{Category: CategorySpot})
+	assert.Equal(t, 2, fake.calls)
+
+	now = now.Add(2 * time.Second)
+	_, _ = m.GetTickers(&TickersRequest{Category: CategoryLinear})
+	assert.Equal(t, 3, fake.calls)
+}
+
+// TestCachedMarketCopies verifies callers cannot modify cached responses and that expired
+// entries are evicted.
+func TestCachedMarketCopies(t *testing.T) {
+	fake := &fakeTickersMarket{}
+	m := NewCachedMarket(fake, CacheTTLs{Tickers: time.Second}).(*cachedMarket)
+	now := time.Now()
+	m.now = func() time.Time { return now }
+
+	first, err := m.GetTickers(&TickersRequest{Category: CategoryLinear})
+	assert.NoError(t, err)
+	first.Result.Category = "changed"
+	second, err := m.GetTickers(&TickersRequest{Category: CategoryLinear})
+	assert.NoError(t, err)
+	assert.Equal(t, "linear", second.Result.Category)
+	second.Result.Category = "changed again"
+	third, _ := m.GetTickers(&TickersRequest{Category: CategoryLinear})
+	assert.Equal(t, "linear", third.Result.Category)
+	assert.Equal(t, 1, fake.calls)
+
+	now = now.Add(2 * time.Second)
+	_, _ = m.GetTickers(&TickersRequest{Category: CategorySpot})
+	assert.Len(t, m.entries, 1, "the expired linear entry must be evicted")
+}
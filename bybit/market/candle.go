@@ -0,0 +1,57 @@
+package market
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cploutarchou/crypto-sdk-suite/decimal"
+)
+
+// Candle is a typed kline row. Volume and Turnover are only populated by the last-price kline
+// endpoint; mark, index and premium index klines return OHLC only.
+type Candle struct {
+	Start    time.Time
+	Open     decimal.Decimal
+	High     decimal.Decimal
+	Low      decimal.Decimal
+	Close    decimal.Decimal
+	Volume   decimal.Decimal
+	Turnover decimal.Decimal
+}
+
+// ParseCandle converts a raw kline row of [startTime, open, high, low, close(, volume, turnover)].
+func ParseCandle(row []string) (Candle, error) {
+	if len(row) < 5 {
+		return Candle{}, fmt.Errorf("invalid kline row: expected at least 5 fields, got %d", len(row))
+	}
+	startMs, err := strconv.ParseInt(row[0], 10, 64)
+	if err != nil {
+		return Candle{}, fmt.Errorf("error parsing kline start time: %w", err)
+	}
+	candle := Candle{Start: time.UnixMilli(startMs)}
+
+	fields := []*decimal.Decimal{&candle.Open, &candle.High, &candle.Low, &candle.Close, &candle.Volume, &candle.Turnover}
+	for i, field := range fields {
+		if i+1 >= len(row) {
+			break
+		}
+		if *field, err = decimal.NewFromString(row[i+1]); err != nil {
+			return Candle{}, fmt.Errorf("error parsing kline field %d: %w", i+1, err)
+		}
+	}
+	return candle, nil
+}
+
+// Candles converts the raw rows of a kline result, keeping the API order (newest first).
+func (r *KlineResult) Candles() ([]Candle, error) {
+	candles := make([]Candle, 0, len(r.List))
+	for _, row := range r.List {
+		candle, err := ParseCandle(row)
+		if err != nil {
+			return nil, err
+		}
+		candles = append(candles, candle)
+	}
+	return candles, nil
+}
@@ -0,0 +1,28 @@
+package market
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestKlineResult_Candles verifies raw kline rows are converted to typed candles.
+func TestKlineResult_Candles(t *testing.T) {
+	result := KlineResult{List: [][]string{
+		{"1670608800000", "17071", "17073", "17027", "17055.5", "268611", "15.74462667"},
+		{"1670605200000", "17000", "17080", "16990", "17071"},
+	}}
+
+	candles, err := result.Candles()
+	assert.NoError(t, err)
+	assert.Len(t, candles, 2)
+	assert.Equal(t, time.UnixMilli(1670608800000), candles[0].Start)
+	assert.Equal(t, "17055.5", candles[0].Close.String())
+	assert.Equal(t, "15.74462667", candles[0].Turnover.String())
+	assert.Equal(t, "17071", candles[1].Close.String())
+	assert.True(t, candles[1].Volume.IsZero())
+
+	_, err = ParseCandle([]string{"1670608800000", "1"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,105 @@
+package market
+
+import (
+	"fmt"
+	"time"
+)
+
+// Category is a Bybit product category.
+type Category string
+
+const (
+	CategorySpot    Category = "spot"
+	CategoryLinear  Category = "linear"
+	CategoryInverse Category = "inverse"
+	CategoryOption  Category = "option"
+)
+
+func (c Category) String() string {
+	return string(c)
+}
+
+// Validate returns an error when c is not a known category.
+func (c Category) Validate() error {
+	switch c {
+	case CategorySpot, CategoryLinear, CategoryInverse, CategoryOption:
+		return nil
+	}
+	return fmt.Errorf("invalid category %q", string(c))
+}
+
+// Interval is a kline interval.
+type Interval string
+
+const (
+	Interval1m  Interval = "1"
+	Interval3m  Interval = "3"
+	Interval5m  Interval = "5"
+	Interval15m Interval = "15"
+	Interval30m Interval = "30"
+	Interval1h  Interval = "60"
+	Interval2h  Interval = "120"
+	Interval4h  Interval = "240"
+	Interval6h  Interval = "360"
+	Interval12h Interval = "720"
+	Interval1d  Interval = "D"
+	Interval1w  Interval = "W"
+	Interval1M  Interval = "M"
+)
+
+var intervalDurations = map[Interval]time.Duration{
+	Interval1m:  time.Minute,
+	Interval3m:  3 * time.Minute,
+	Interval5m:  5 * time.Minute,
+	Interval15m: 15 * time.Minute,
+	Interval30m: 30 * time.Minute,
+	Interval1h:  time.Hour,
+	Interval2h:  2 * time.Hour,
+	Interval4h:  4 * time.Hour,
+	Interval6h:  6 * time.Hour,
+	Interval12h: 12 * time.Hour,
+	Interval1d:  24 * time.Hour,
+	Interval1w:  7 * 24 * time.Hour,
+}
+
+func (i Interval) String() string {
+	return string(i)
+}
+
+// Validate returns an error when i is not a known kline interval.
+func (i Interval) Validate() error {
+	if _, ok := intervalDurations[i]; ok || i == Interval1M {
+		return nil
+	}
+	return fmt.Errorf("invalid interval %q", string(i))
+}
+
+// Duration returns the length of one candle. Monthly candles vary in length and return 0.
+func (i Interval) Duration() time.Duration {
+	return intervalDurations[i]
+}
+
+// IntervalTime is the aggregation period of the open interest and long/short ratio endpoints.
+type IntervalTime string
+
+const (
+	IntervalTime5min  IntervalTime = "5min"
+	IntervalTime15min IntervalTime = "15min"
+	IntervalTime30min IntervalTime = "30min"
+	IntervalTime1h    IntervalTime = "1h"
+	IntervalTime4h    IntervalTime = "4h"
+	IntervalTime1d    IntervalTime = "1d"
+)
+
+func (i IntervalTime) String() string {
+	return string(i)
+}
+
+// Validate returns an error when i is not a known interval time.
+func (i IntervalTime) Validate() error {
+	switch i {
+	case IntervalTime5min, IntervalTime15min, IntervalTime30min, IntervalTime1h, IntervalTime4h, IntervalTime1d:
+		return nil
+	}
+	return fmt.Errorf("invalid interval time %q", string(i))
+}
@@ -0,0 +1,300 @@
+package market
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+)
+
+// MaxKlineLimit is the largest page Bybit returns for any kline endpoint.
+const MaxKlineLimit = 1000
+
+// MaxFundingHistoryLimit is the largest page the funding history endpoint returns.
+const MaxFundingHistoryLimit = 200
+
+// MaxOpenInterestLimit is the largest page the open interest endpoint returns.
+const MaxOpenInterestLimit = 200
+
+// MaxLongShortRatioLimit is the largest page the account-ratio endpoint returns.
+const MaxLongShortRatioLimit = 500
+
+// ConvertKlineRequestToParams prepares the query parameters for the kline endpoints.
+func ConvertKlineRequestToParams(req *KlineRequest) client.Params {
+	params := client.Params{
+		"symbol":   req.Symbol,
+		"interval": req.Interval,
+	}
+	if req.Category != "" {
+		params["category"] = req.Category
+	}
+	if req.Start != nil {
+		params["start"] = strconv.FormatInt(*req.Start, 10)
+	}
+	if req.End != nil {
+		params["end"] = strconv.FormatInt(*req.End, 10)
+	}
+	if req.Limit != nil {
+		params["limit"] = strconv.Itoa(*req.Limit)
+	}
+	return params
+}
+
+// ConvertOrderbookRequestToParams prepares the query parameters for the orderbook endpoint.
+func ConvertOrderbookRequestToParams(req *OrderbookRequest) client.Params {
+	params := client.Params{
+		"category": req.Category,
+		"symbol":   req.Symbol,
+	}
+	if req.Limit != nil {
+		params["limit"] = strconv.Itoa(*req.Limit)
+	}
+	return params
+}
+
+// ConvertTickersRequestToParams prepares the query parameters for the tickers endpoint.
+func ConvertTickersRequestToParams(req *TickersRequest) client.Params {
+	params := client.Params{"category": req.Category}
+	if req.Symbol != nil {
+		params["symbol"] = *req.Symbol
+	}
+	if req.BaseCoin != nil {
+		params["baseCoin"] = *req.BaseCoin
+	}
+	if req.ExpDate != nil {
+		params["expDate"] = *req.ExpDate
+	}
+	return params
+}
+
+// ConvertInstrumentsInfoRequestToParams prepares the query parameters for the instruments-info endpoint.
+func ConvertInstrumentsInfoRequestToParams(req *InstrumentsInfoRequest) client.Params {
+	params := client.Params{"category": req.Category}
+	if req.Symbol != nil {
+		params["symbol"] = *req.Symbol
+	}
+	if req.Status != nil {
+		params["status"] = *req.Status
+	}
+	if req.BaseCoin != nil {
+		params["baseCoin"] = *req.BaseCoin
+	}
+	if req.Limit != nil {
+		params["limit"] = strconv.Itoa(*req.Limit)
+	}
+	if req.Cursor != nil {
+		params["cursor"] = *req.Cursor
+	}
+	return params
+}
+
+// ConvertFundingRateHistoryRequestToParams prepares the query parameters for the funding history endpoint.
+func ConvertFundingRateHistoryRequestToParams(req *FundingRateHistoryRequest) client.Params {
+	params := client.Params{
+		"category": req.Category,
+		"symbol":   req.Symbol,
+	}
+	if req.StartTime != nil {
+		params["startTime"] = strconv.FormatInt(*req.StartTime, 10)
+	}
+	if req.EndTime != nil {
+		params["endTime"] = strconv.FormatInt(*req.EndTime, 10)
+	}
+	if req.Limit != nil && *req.Limit > 0 {
+		params["limit"] = strconv.Itoa(*req.Limit)
+	}
+	return params
+}
+
+// ConvertRecentTradesRequestToParams prepares the query parameters for the recent-trade endpoint.
+func ConvertRecentTradesRequestToParams(req *RecentTradesRequest) client.Params {
+	params := client.Params{"category": req.Category}
+	if req.Symbol != nil {
+		params["symbol"] = *req.Symbol
+	}
+	if req.BaseCoin != nil {
+		params["baseCoin"] = *req.BaseCoin
+	}
+	if req.OptionType != nil {
+		params["optionType"] = *req.OptionType
+	}
+	if req.Limit != nil {
+		params["limit"] = strconv.Itoa(*req.Limit)
+	}
+	return params
+}
+
+// ConvertOpenInterestRequestToParams prepares the query parameters for the open interest endpoint.
+func ConvertOpenInterestRequestToParams(req *OpenInterestRequest) client.Params {
+	params := client.Params{
+		"category":     req.Category,
+		"symbol":       req.Symbol,
+		"intervalTime": req.IntervalTime,
+	}
+	if req.StartTime != nil {
+		params["startTime"] = strconv.FormatInt(*req.StartTime, 10)
+	}
+	if req.EndTime != nil {
+		params["endTime"] = strconv.FormatInt(*req.EndTime, 10)
+	}
+	if req.Limit != nil && *req.Limit > 0 {
+		params["limit"] = strconv.Itoa(*req.Limit)
+	}
+	if req.Cursor != nil {
+		params["cursor"] = *req.Cursor
+	}
+	return params
+}
+
+// ConvertHistoricalVolatilityRequestToParams prepares the query parameters for the historical volatility endpoint.
+func ConvertHistoricalVolatilityRequestToParams(req *HistoricalVolatilityRequest) client.Params {
+	params := client.Params{"category": req.Category}
+	if req.BaseCoin != nil {
+		params["baseCoin"] = *req.BaseCoin
+	}
+	if req.QuoteCoin != nil {
+		params["quoteCoin"] = *req.QuoteCoin
+	}
+	if req.Period != nil {
+		params["period"] = strconv.Itoa(*req.Period)
+	}
+	if req.StartTime != nil {
+		params["startTime"] = strconv.FormatInt(*req.StartTime, 10)
+	}
+	if req.EndTime != nil {
+		params["endTime"] = strconv.FormatInt(*req.EndTime, 10)
+	}
+	return params
+}
+
+// ConvertRiskLimitRequestToParams prepares the query parameters for the risk limit endpoint.
+func ConvertRiskLimitRequestToParams(req *RiskLimitRequest) client.Params {
+	params := client.Params{"category": req.Category}
+	if req.Symbol != nil {
+		params["symbol"] = *req.Symbol
+	}
+	if req.Cursor != nil {
+		params["cursor"] = *req.Cursor
+	}
+	return params
+}
+
+// ConvertDeliveryPriceRequestToParams prepares the query parameters for the delivery price endpoint.
+func ConvertDeliveryPriceRequestToParams(req *DeliveryPriceRequest) client.Params {
+	params := client.Params{"category": req.Category}
+	if req.Symbol != nil {
+		params["symbol"] = *req.Symbol
+	}
+	if req.BaseCoin != nil {
+		params["baseCoin"] = *req.BaseCoin
+	}
+	if req.Limit != nil {
+		params["limit"] = strconv.Itoa(*req.Limit)
+	}
+	if req.Cursor != nil {
+		params["cursor"] = *req.Cursor
+	}
+	return params
+}
+
+// ConvertLongShortRatioRequestToParams prepares the query parameters for the account-ratio endpoint.
+func ConvertLongShortRatioRequestToParams(req *LongShortRatioRequest) client.Params {
+	params := client.Params{
+		"category": req.Category,
+		"symbol":   req.Symbol,
+		"period":   req.Period,
+	}
+	if req.StartTime != nil {
+		params["startTime"] = strconv.FormatInt(*req.StartTime, 10)
+	}
+	if req.EndTime != nil {
+		params["endTime"] = strconv.FormatInt(*req.EndTime, 10)
+	}
+	if req.Limit != nil && *req.Limit > 0 {
+		params["limit"] = strconv.Itoa(*req.Limit)
+	}
+	if req.Cursor != nil {
+		params["cursor"] = *req.Cursor
+	}
+	return params
+}
+
+// getKline fetches candles from one of the kline endpoints. Bybit returns at most
+// MaxKlineLimit rows per call, newest first, so when Start is set or Limit exceeds a
+// single page the request is repeated with End moved before the oldest row received
+// until the range or the limit is exhausted. The merged list keeps the API ordering.
+func (m *marketImpl) getKline(path string, req *KlineRequest) (*KlineResponse, error) {
+	if req == nil || req.Symbol == "" || req.Interval == "" {
+		return nil, errors.New("symbol and interval are required")
+	}
+	if err := req.Interval.Validate(); err != nil {
+		return nil, err
+	}
+	if req.Category != "" {
+		if err := req.Category.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	remaining := -1 // unbounded: keep paging until Start is reached
+	if req.Limit != nil {
+		remaining = *req.Limit
+	} else if req.Start == nil {
+		remaining = 0 // single request with the API default page size
+	}
+
+	page := *req
+	var merged *KlineResponse
+	for {
+		page.Limit = nil
+		pageLimit := MaxKlineLimit
+		if remaining > 0 {
+			pageLimit = min(remaining, MaxKlineLimit)
+			page.Limit = &pageLimit
+		} else if remaining < 0 {
+			page.Limit = &pageLimit
+		}
+
+		res, err := m.c.Get(path, ConvertKlineRequestToParams(&page))
+		if err != nil {
+			return nil, err
+		}
+		var kline KlineResponse
+		if err := res.Unmarshal(&kline); err != nil {
+			return nil, err
+		}
+		if kline.RetCode != 0 {
+			return &kline, fmt.Errorf("API returned error: %s", kline.RetMsg)
+		}
+
+		if merged == nil {
+			merged = &kline
+		} else {
+			merged.APIResponse = kline.APIResponse
+			merged.Result.List = append(merged.Result.List, kline.Result.List...)
+		}
+
+		rows := kline.Result.List
+		if remaining == 0 || len(rows) < pageLimit {
+			break
+		}
+		if remaining > 0 {
+			remaining -= len(rows)
+			if remaining == 0 {
+				break
+			}
+		}
+
+		oldest, err := strconv.ParseInt(rows[len(rows)-1][0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing kline start time: %w", err)
+		}
+		if req.Start != nil && oldest <= *req.Start {
+			break
+		}
+		end := oldest - 1
+		page.End = &end
+	}
+	return merged, nil
+}
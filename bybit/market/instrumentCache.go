@@ -0,0 +1,98 @@
+package market
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// DefaultInstrumentCacheTTL is how long instrument specifications are reused before being re-fetched.
+const DefaultInstrumentCacheTTL = time.Hour
+
+// InstrumentCache keeps instrument specifications in memory so tick size and lot size filters can be
+// looked up for every order without hitting the instruments-info endpoint each time.
+// Entries are kept per category, keyed by symbol, and expire after the configured TTL.
+type InstrumentCache struct {
+	market Market
+	ttl    time.Duration
+	now    func() time.Time
+
+	mu      sync.RWMutex
+	entries map[Category]map[string]instrumentEntry
+}
+
+type instrumentEntry struct {
+	info      InstrumentInfo
+	fetchedAt time.Time
+}
+
+// NewInstrumentCache creates a cache backed by the given Market. A ttl of zero uses DefaultInstrumentCacheTTL.
+func NewInstrumentCache(m Market, ttl time.Duration) *InstrumentCache {
+	if ttl <= 0 {
+		ttl = DefaultInstrumentCacheTTL
+	}
+	return &InstrumentCache{
+		market:  m,
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[Category]map[string]instrumentEntry),
+	}
+}
+
+// Get returns the specification of a symbol, fetching it when it is missing or expired.
+func (c *InstrumentCache) Get(category Category, symbol string) (*InstrumentInfo, error) {
+	c.mu.RLock()
+	entry, ok := c.entries[category][symbol]
+	c.mu.RUnlock()
+	if ok && c.now().Sub(entry.fetchedAt) < c.ttl {
+		info := entry.info
+		return &info, nil
+	}
+
+	res, err := c.market.GetInstrumentsInfo(&InstrumentsInfoRequest{Category: category, Symbol: &symbol})
+	if err != nil {
+		return nil, err
+	}
+	c.store(category, res.Result.List, false)
+	for i := range res.Result.List {
+		if res.Result.List[i].Symbol == symbol {
+			info := res.Result.List[i]
+			return &info, nil
+		}
+	}
+	return nil, fmt.Errorf("instrument %s not found in category %s", symbol, category)
+}
+
+// Refresh re-fetches every instrument of a category and replaces the cached entries, so
+// delisted symbols are dropped.
+func (c *InstrumentCache) Refresh(category Category) error {
+	res, err := c.market.GetInstrumentsInfo(&InstrumentsInfoRequest{Category: category})
+	if err != nil {
+		return err
+	}
+	c.store(category, res.Result.List, true)
+	return nil
+}
+
+// Invalidate drops a cached symbol so the next Get fetches it again.
+func (c *InstrumentCache) Invalidate(category Category, symbol string) {
+	c.mu.Lock()
+	delete(c.entries[category], symbol)
+	c.mu.Unlock()
+}
+
+// store caches the instruments of a category. With replace, the category's previous entries
+// are discarded; otherwise the instruments are added to them.
+func (c *InstrumentCache) store(category Category, list []InstrumentInfo, replace bool) {
+	now := c.now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entries := c.entries[category]
+	if replace || entries == nil {
+		entries = make(map[string]instrumentEntry, len(list))
+		c.entries[category] = entries
+	}
+	for i := range list {
+		entries[list[i].Symbol] = instrumentEntry{info: list[i], fetchedAt: now}
+	}
+}
@@ -0,0 +1,70 @@
+package market
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeInstrumentsMarket struct {
+	Market
+	calls    int
+	delisted bool // ETHUSDT is no longer listed
+}
+
+func (f *fakeInstrumentsMarket) GetInstrumentsInfo(req *InstrumentsInfoRequest) (*InstrumentsInfoResponse, error) {
+	f.calls++
+	var res InstrumentsInfoResponse
+	res.Result.Category = string(req.Category)
+	res.Result.List = []InstrumentInfo{
+		{Symbol: "BTCUSDT", PriceFilter: PriceFilter{TickSize: "0.10"}},
+		{Symbol: "ETHUSDT", PriceFilter: PriceFilter{TickSize: "0.01"}},
+	}
+	if f.delisted {
+		res.Result.List = res.Result.List[:1]
+	}
+	return &res, nil
+}
+
+// TestInstrumentCache_Get verifies entries are served from memory until the TTL expires.
+func TestInstrumentCache_Get(t *testing.T) {
+	fake := &fakeInstrumentsMarket{}
+	cache := NewInstrumentCache(fake, time.Minute)
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+
+	info, err := cache.Get("linear", "BTCUSDT")
+	assert.NoError(t, err)
+	assert.Equal(t, "0.10", info.PriceFilter.TickSize)
+	assert.Equal(t, 1, fake.calls)
+
+	// Both symbols were returned by the first call, so neither triggers a fetch.
+	_, err = cache.Get("linear", "ETHUSDT")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, fake.calls)
+
+	now = now.Add(2 * time.Minute)
+	_, err = cache.Get("linear", "BTCUSDT")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, fake.calls)
+
+	_, err = cache.Get("linear", "SOLUSDT")
+	assert.Error(t, err)
+}
+
+// TestInstrumentCache_Refresh verifies a refresh drops symbols that are no longer listed.
+func TestInstrumentCache_Refresh(t *testing.T) {
+	fake := &fakeInstrumentsMarket{}
+	cache := NewInstrumentCache(fake, time.Hour)
+	assert.NoError(t, cache.Refresh(CategoryLinear))
+	_, err := cache.Get(CategoryLinear, "ETHUSDT")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, fake.calls)
+
+	fake.delisted = true
+	assert.NoError(t, cache.Refresh(CategoryLinear))
+	_, err = cache.Get(CategoryLinear, "ETHUSDT")
+	assert.Error(t, err)
+	assert.Equal(t, 3, fake.calls, "the delisted symbol must be looked up again")
+}
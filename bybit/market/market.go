@@ -1,28 +1,50 @@
 package market
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
 )
 
 type Market interface {
 	ServerTime(params *client.Params) (*ServerTimeResponse, error)
+	GetServerTime() (*ServerTimeResponse, error)
 	Kline(params *client.Params) (*KlineResponse, error)
+	GetKline(req *KlineRequest) (*KlineResponse, error)
+	GetCandles(req *KlineRequest) ([]Candle, error)
+	GetLatestKlines(category Category, interval Interval, limit int, symbols []string) (map[string][]Candle, error)
+	GetMarkPriceKline(req *KlineRequest) (*KlineResponse, error)
+	GetIndexPriceKline(req *KlineRequest) (*KlineResponse, error)
+	GetPremiumIndexPriceKline(req *KlineRequest) (*KlineResponse, error)
 	Announcement(params *client.Params) (*AnnouncementsResponse, error)
 	MarkPriceKline(params *client.Params) (*KlineResponse, error)
 	IndexPriceKline(params *client.Params) (*KlineResponse, error)
 	PremiumIndexKline(params *client.Params) (*KlineResponse, error)
 	OrderBook(params *client.Params) (*OrderBook, error)
+	GetOrderbook(req *OrderbookRequest) (*OrderbookResponse, error)
 	InstrumentsInfo(params *client.Params) (*InstrumentsInfoResponse, error)
+	GetInstrumentsInfo(req *InstrumentsInfoRequest) (*InstrumentsInfoResponse, error)
+	GetPreListingInstruments(category Category) ([]InstrumentInfo, error)
 	Tickers(params *client.Params) (*TickerResponse, error)
+	GetTickers(req *TickersRequest) (*TickerResponse, error)
+	GetTickersForSymbols(category Category, symbols []string) (map[string]TickerInfo, error)
 	FundingHistory(params *client.Params) (*FundingRateHistory, error)
+	GetFundingRateHistory(req *FundingRateHistoryRequest) (*FundingRateHistory, error)
 	RiskLimit(params *client.Params) (*RiskLimit, error)
+	GetRiskLimit(req *RiskLimitRequest) (*RiskLimit, error)
 	OpenInterest(params *client.Params) (*OpenHistory, error)
+	GetOpenInterest(req *OpenInterestRequest) (*OpenHistory, error)
 	Insurance(params *client.Params) (*Insurance, error)
+	GetInsurance(coin *string) (*Insurance, error)
 	RecentTrade(params *client.Params) (*ResendTrade, error)
+	GetPublicRecentTrades(req *RecentTradesRequest) (*PublicTradesResponse, error)
 	DeliveryPrice(params *client.Params) (*DeliveryPrice, error)
+	GetDeliveryPrice(req *DeliveryPriceRequest) (*DeliveryPrice, error)
 	HistoricalVolatility(params *client.Params) (*HistoricalVolatility, error)
+	GetLongShortRatio(req *LongShortRatioRequest) (*LongShortRatio, error)
+	GetHistoricalVolatility(req *HistoricalVolatilityRequest) (*HistoricalVolatility, error)
 }
 
 type marketImpl struct {
@@ -44,6 +66,24 @@ func (m *marketImpl) ServerTime(params *client.Params) (*ServerTimeResponse, err
 	}
 	return &serverTime, nil
 }
+
+// GetServerTime returns the Bybit server time. Use Result.Time for a time.Time, or
+// client.Client.Time when only the time is needed.
+func (m *marketImpl) GetServerTime() (*ServerTimeResponse, error) {
+	res, err := m.c.Get(fmt.Sprintf("/%s/market/time", client.APIVersion), client.Params{})
+	if err != nil {
+		return nil, err
+	}
+	var serverTime ServerTimeResponse
+	if err := res.Unmarshal(&serverTime); err != nil {
+		return nil, err
+	}
+	if serverTime.RetCode != 0 {
+		return &serverTime, fmt.Errorf("API returned error: %s", serverTime.RetMsg)
+	}
+	return &serverTime, nil
+}
+
 func (m *marketImpl) Kline(params *client.Params) (*KlineResponse, error) {
 	res, err := m.c.Get(fmt.Sprintf("/%s/market/kline", client.APIVersion), *params)
 
@@ -58,6 +98,38 @@ func (m *marketImpl) Kline(params *client.Params) (*KlineResponse, error) {
 	return &kline, nil
 }
 
+// GetKline returns last-price candles for a symbol, paging through long start/end ranges.
+func (m *marketImpl) GetKline(req *KlineRequest) (*KlineResponse, error) {
+	return m.getKline(fmt.Sprintf("/%s/market/kline", client.APIVersion), req)
+}
+
+// GetCandles is GetKline with the rows converted to typed candles, newest first.
+func (m *marketImpl) GetCandles(req *KlineRequest) ([]Candle, error) {
+	kline, err := m.GetKline(req)
+	if err != nil {
+		return nil, err
+	}
+	return kline.Result.Candles()
+}
+
+// GetMarkPriceKline returns mark price candles for linear and inverse contracts.
+// Each row is [startTime, open, high, low, close].
+func (m *marketImpl) GetMarkPriceKline(req *KlineRequest) (*KlineResponse, error) {
+	return m.getKline(fmt.Sprintf("/%s/market/mark-price-kline", client.APIVersion), req)
+}
+
+// GetIndexPriceKline returns index price candles for linear and inverse contracts.
+// Each row is [startTime, open, high, low, close].
+func (m *marketImpl) GetIndexPriceKline(req *KlineRequest) (*KlineResponse, error) {
+	return m.getKline(fmt.Sprintf("/%s/market/index-price-kline", client.APIVersion), req)
+}
+
+// GetPremiumIndexPriceKline returns premium index candles for linear contracts.
+// Each row is [startTime, open, high, low, close].
+func (m *marketImpl) GetPremiumIndexPriceKline(req *KlineRequest) (*KlineResponse, error) {
+	return m.getKline(fmt.Sprintf("/%s/market/premium-index-price-kline", client.APIVersion), req)
+}
+
 func (m *marketImpl) Announcement(params *client.Params) (*AnnouncementsResponse, error) {
 	res, err := m.c.Get(fmt.Sprintf("/%s/announcements/index", client.APIVersion), *params)
 	if err != nil {
@@ -98,7 +170,7 @@ func (m *marketImpl) IndexPriceKline(params *client.Params) (*KlineResponse, err
 }
 
 func (m *marketImpl) PremiumIndexKline(params *client.Params) (*KlineResponse, error) {
-	res, err := m.c.Get(fmt.Sprintf("/%s/market/premium-index-kline", client.APIVersion), *params)
+	res, err := m.c.Get(fmt.Sprintf("/%s/market/premium-index-price-kline", client.APIVersion), *params)
 	if err != nil {
 		return nil, err
 	}
@@ -123,6 +195,28 @@ func (m *marketImpl) OrderBook(params *client.Params) (*OrderBook, error) {
 	return &orderBook, nil
 }
 
+// GetOrderbook returns a typed orderbook snapshot with the requested depth per side.
+func (m *marketImpl) GetOrderbook(req *OrderbookRequest) (*OrderbookResponse, error) {
+	if req == nil || req.Category == "" || req.Symbol == "" {
+		return nil, errors.New("category and symbol are required")
+	}
+	if err := req.Category.Validate(); err != nil {
+		return nil, err
+	}
+	res, err := m.c.Get(fmt.Sprintf("/%s/market/orderbook", client.APIVersion), ConvertOrderbookRequestToParams(req))
+	if err != nil {
+		return nil, err
+	}
+	var orderbook OrderbookResponse
+	if err := res.Unmarshal(&orderbook); err != nil {
+		return nil, err
+	}
+	if orderbook.RetCode != 0 {
+		return &orderbook, fmt.Errorf("API returned error: %s", orderbook.RetMsg)
+	}
+	return &orderbook, nil
+}
+
 func (m *marketImpl) InstrumentsInfo(params *client.Params) (*InstrumentsInfoResponse, error) {
 	res, err := m.c.Get(fmt.Sprintf("/%s/market/instruments-info", client.APIVersion), *params)
 	if err != nil {
@@ -136,6 +230,46 @@ func (m *marketImpl) InstrumentsInfo(params *client.Params) (*InstrumentsInfoRes
 	return &instrumentsInfo, nil
 }
 
+// GetInstrumentsInfo returns instrument specifications. When no cursor is supplied all pages
+// are fetched and merged into a single response.
+func (m *marketImpl) GetInstrumentsInfo(req *InstrumentsInfoRequest) (*InstrumentsInfoResponse, error) {
+	if req == nil || req.Category == "" {
+		return nil, errors.New("category is required")
+	}
+	if err := req.Category.Validate(); err != nil {
+		return nil, err
+	}
+	followCursor := req.Cursor == nil
+	page := *req
+	var merged *InstrumentsInfoResponse
+	for {
+		res, err := m.c.Get(fmt.Sprintf("/%s/market/instruments-info", client.APIVersion), ConvertInstrumentsInfoRequestToParams(&page))
+		if err != nil {
+			return nil, err
+		}
+		var info InstrumentsInfoResponse
+		if err := res.Unmarshal(&info); err != nil {
+			return nil, err
+		}
+		if info.RetCode != 0 {
+			return &info, fmt.Errorf("API returned error: %s", info.RetMsg)
+		}
+		if merged == nil {
+			merged = &info
+		} else {
+			merged.APIBaseResponse = info.APIBaseResponse
+			merged.Result.List = append(merged.Result.List, info.Result.List...)
+			merged.Result.NextPageCursor = info.Result.NextPageCursor
+		}
+		if !followCursor || info.Result.NextPageCursor == "" {
+			break
+		}
+		cursor := info.Result.NextPageCursor
+		page.Cursor = &cursor
+	}
+	return merged, nil
+}
+
 func (m *marketImpl) Tickers(params *client.Params) (*TickerResponse, error) {
 	res, err := m.c.Get(fmt.Sprintf("/%s/market/tickers", client.APIVersion), *params)
 	if err != nil {
@@ -149,6 +283,32 @@ func (m *marketImpl) Tickers(params *client.Params) (*TickerResponse, error) {
 	return &tickers, nil
 }
 
+// GetTickers returns 24h ticker statistics for spot, linear, inverse or option symbols.
+// Option tickers additionally carry implied volatility and Greeks.
+func (m *marketImpl) GetTickers(req *TickersRequest) (*TickerResponse, error) {
+	if req == nil || req.Category == "" {
+		return nil, errors.New("category is required")
+	}
+	if err := req.Category.Validate(); err != nil {
+		return nil, err
+	}
+	if req.Category == CategoryOption && req.Symbol == nil && req.BaseCoin == nil {
+		return nil, errors.New("symbol or baseCoin is required for option tickers")
+	}
+	res, err := m.c.Get(fmt.Sprintf("/%s/market/tickers", client.APIVersion), ConvertTickersRequestToParams(req))
+	if err != nil {
+		return nil, err
+	}
+	var tickers TickerResponse
+	if err := res.Unmarshal(&tickers); err != nil {
+		return nil, err
+	}
+	if tickers.RetCode != 0 {
+		return &tickers, fmt.Errorf("API returned error: %s", tickers.RetMsg)
+	}
+	return &tickers, nil
+}
+
 func (m *marketImpl) FundingHistory(params *client.Params) (*FundingRateHistory, error) {
 	res, err := m.c.Get(fmt.Sprintf("/%s/market/funding/history", client.APIVersion), *params)
 	if err != nil {
@@ -162,8 +322,83 @@ func (m *marketImpl) FundingHistory(params *client.Params) (*FundingRateHistory,
 	return &fundingHistory, nil
 }
 
+// GetFundingRateHistory returns funding rate points, newest first. The endpoint returns at most
+// 200 records per call, so the window is walked backwards from EndTime (or now) until StartTime
+// or Limit is reached. Without StartTime and Limit a single page is returned; a Limit of zero
+// counts as unset.
+func (m *marketImpl) GetFundingRateHistory(req *FundingRateHistoryRequest) (*FundingRateHistory, error) {
+	if req == nil || req.Category == "" || req.Symbol == "" {
+		return nil, errors.New("category and symbol are required")
+	}
+	if err := req.Category.Validate(); err != nil {
+		return nil, err
+	}
+
+	remaining := -1 // unbounded: keep paging until StartTime is reached
+	if req.Limit != nil && *req.Limit > 0 {
+		remaining = *req.Limit
+	} else if req.StartTime == nil {
+		remaining = MaxFundingHistoryLimit
+	}
+
+	page := *req
+	if page.StartTime != nil && page.EndTime == nil {
+		// The API rejects startTime without endTime.
+		end := client.GetCurrentTime()
+		page.EndTime = &end
+	}
+
+	var merged *FundingRateHistory
+	for {
+		pageLimit := MaxFundingHistoryLimit
+		if remaining >= 0 {
+			pageLimit = min(remaining, MaxFundingHistoryLimit)
+		}
+		page.Limit = &pageLimit
+
+		res, err := m.c.Get(fmt.Sprintf("/%s/market/funding/history", client.APIVersion), ConvertFundingRateHistoryRequestToParams(&page))
+		if err != nil {
+			return nil, err
+		}
+		var history FundingRateHistory
+		if err := res.Unmarshal(&history); err != nil {
+			return nil, err
+		}
+		if history.RetCode != 0 {
+			return &history, fmt.Errorf("API returned error: %s", history.RetMsg)
+		}
+		if merged == nil {
+			merged = &history
+		} else {
+			merged.APIResponse = history.APIResponse
+			merged.Result.List = append(merged.Result.List, history.Result.List...)
+		}
+
+		rows := history.Result.List
+		if len(rows) < pageLimit {
+			break
+		}
+		if remaining >= 0 {
+			remaining -= len(rows)
+			if remaining <= 0 {
+				break
+			}
+		}
+		oldest, err := strconv.ParseInt(rows[len(rows)-1].FundingRateTimestamp, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing funding rate timestamp: %w", err)
+		}
+		if page.StartTime != nil && oldest <= *page.StartTime {
+			break
+		}
+		end := oldest - 1
+		page.EndTime = &end
+	}
+	return merged, nil
+}
+
 func (m *marketImpl) RiskLimit(params *client.Params) (*RiskLimit, error) {
-	res, err := m.c.Get(fmt.Sprintf("/%s/market/insurance", client.APIVersion), *params)
+	res, err := m.c.Get(fmt.Sprintf("/%s/market/risk-limit", client.APIVersion), *params)
 	if err != nil {
 		return nil, err
 	}
@@ -175,6 +410,46 @@ func (m *marketImpl) RiskLimit(params *client.Params) (*RiskLimit, error) {
 	return &riskLimit, nil
 }
 
+// GetRiskLimit returns the risk limit tiers of a category or symbol. When no cursor is supplied
+// all pages are fetched and merged into a single response.
+func (m *marketImpl) GetRiskLimit(req *RiskLimitRequest) (*RiskLimit, error) {
+	if req == nil || req.Category == "" {
+		return nil, errors.New("category is required")
+	}
+	if err := req.Category.Validate(); err != nil {
+		return nil, err
+	}
+	followCursor := req.Cursor == nil
+	page := *req
+	var merged *RiskLimit
+	for {
+		res, err := m.c.Get(fmt.Sprintf("/%s/market/risk-limit", client.APIVersion), ConvertRiskLimitRequestToParams(&page))
+		if err != nil {
+			return nil, err
+		}
+		var riskLimit RiskLimit
+		if err := res.Unmarshal(&riskLimit); err != nil {
+			return nil, err
+		}
+		if riskLimit.RetCode != 0 {
+			return &riskLimit, fmt.Errorf("API returned error: %s", riskLimit.RetMsg)
+		}
+		if merged == nil {
+			merged = &riskLimit
+		} else {
+			merged.APIResponse = riskLimit.APIResponse
+			merged.Result.List = append(merged.Result.List, riskLimit.Result.List...)
+			merged.Result.NextPageCursor = riskLimit.Result.NextPageCursor
+		}
+		if !followCursor || riskLimit.Result.NextPageCursor == "" {
+			break
+		}
+		cursor := riskLimit.Result.NextPageCursor
+		page.Cursor = &cursor
+	}
+	return merged, nil
+}
+
 func (m *marketImpl) OpenInterest(params *client.Params) (*OpenHistory, error) {
 	res, err := m.c.Get(fmt.Sprintf("/%s/market/open-interest", client.APIVersion), *params)
 	if err != nil {
@@ -188,6 +463,70 @@ func (m *marketImpl) OpenInterest(params *client.Params) (*OpenHistory, error) {
 	return &openInterest, nil
 }
 
+// GetOpenInterest returns open interest points, newest first. When StartTime or a Limit above
+// one page is given, nextPageCursor is followed until the range or the limit is exhausted. A
+// Limit of zero counts as unset.
+func (m *marketImpl) GetOpenInterest(req *OpenInterestRequest) (*OpenHistory, error) {
+	if req == nil || req.Category == "" || req.Symbol == "" || req.IntervalTime == "" {
+		return nil, errors.New("category, symbol and intervalTime are required")
+	}
+	if err := req.Category.Validate(); err != nil {
+		return nil, err
+	}
+	if err := req.IntervalTime.Validate(); err != nil {
+		return nil, err
+	}
+
+	remaining := -1 // unbounded: follow the cursor to the end of the range
+	limited := req.Limit != nil && *req.Limit > 0
+	singlePage := !limited && (req.StartTime == nil || req.Cursor != nil)
+	if limited {
+		remaining = *req.Limit
+	}
+
+	page := *req
+	var merged *OpenHistory
+	for {
+		pageLimit := MaxOpenInterestLimit
+		if remaining >= 0 {
+			pageLimit = min(remaining, MaxOpenInterestLimit)
+		}
+		page.Limit = &pageLimit
+
+		res, err := m.c.Get(fmt.Sprintf("/%s/market/open-interest", client.APIVersion), ConvertOpenInterestRequestToParams(&page))
+		if err != nil {
+			return nil, err
+		}
+		var history OpenHistory
+		if err := res.Unmarshal(&history); err != nil {
+			return nil, err
+		}
+		if history.RetCode != 0 {
+			return &history, fmt.Errorf("API returned error: %s", history.RetMsg)
+		}
+		if merged == nil {
+			merged = &history
+		} else {
+			merged.APIResponse = history.APIResponse
+			merged.Result.List = append(merged.Result.List, history.Result.List...)
+			merged.Result.NextPageCursor = history.Result.NextPageCursor
+		}
+
+		if remaining >= 0 {
+			remaining -= len(history.Result.List)
+			if remaining <= 0 {
+				break
+			}
+		}
+		if singlePage || history.Result.NextPageCursor == "" || len(history.Result.List) == 0 {
+			break
+		}
+		cursor := history.Result.NextPageCursor
+		page.Cursor = &cursor
+	}
+	return merged, nil
+}
+
 func (m *marketImpl) Insurance(params *client.Params) (*Insurance, error) {
 	res, err := m.c.Get(fmt.Sprintf("/%s/market/insurance", client.APIVersion), *params)
 	if err != nil {
@@ -201,8 +540,28 @@ func (m *marketImpl) Insurance(params *client.Params) (*Insurance, error) {
 	return &insurance, nil
 }
 
+// GetInsurance returns insurance pool balances. All coins are returned when coin is nil.
+func (m *marketImpl) GetInsurance(coin *string) (*Insurance, error) {
+	params := client.Params{}
+	if coin != nil {
+		params["coin"] = *coin
+	}
+	res, err := m.c.Get(fmt.Sprintf("/%s/market/insurance", client.APIVersion), params)
+	if err != nil {
+		return nil, err
+	}
+	var insurance Insurance
+	if err := res.Unmarshal(&insurance); err != nil {
+		return nil, err
+	}
+	if insurance.RetCode != 0 {
+		return &insurance, fmt.Errorf("API returned error: %s", insurance.RetMsg)
+	}
+	return &insurance, nil
+}
+
 func (m *marketImpl) RecentTrade(params *client.Params) (*ResendTrade, error) {
-	res, err := m.c.Get(fmt.Sprintf("/%s/market/trading-records", client.APIVersion), *params)
+	res, err := m.c.Get(fmt.Sprintf("/%s/market/recent-trade", client.APIVersion), *params)
 	if err != nil {
 		return nil, err
 	}
@@ -214,8 +573,33 @@ func (m *marketImpl) RecentTrade(params *client.Params) (*ResendTrade, error) {
 	return &recentTrade, nil
 }
 
+// GetPublicRecentTrades returns the latest public trades, newest first.
+func (m *marketImpl) GetPublicRecentTrades(req *RecentTradesRequest) (*PublicTradesResponse, error) {
+	if req == nil || req.Category == "" {
+		return nil, errors.New("category is required")
+	}
+	if err := req.Category.Validate(); err != nil {
+		return nil, err
+	}
+	if req.Category != CategoryOption && req.Symbol == nil {
+		return nil, errors.New("symbol is required")
+	}
+	res, err := m.c.Get(fmt.Sprintf("/%s/market/recent-trade", client.APIVersion), ConvertRecentTradesRequestToParams(req))
+	if err != nil {
+		return nil, err
+	}
+	var trades PublicTradesResponse
+	if err := res.Unmarshal(&trades); err != nil {
+		return nil, err
+	}
+	if trades.RetCode != 0 {
+		return &trades, fmt.Errorf("API returned error: %s", trades.RetMsg)
+	}
+	return &trades, nil
+}
+
 func (m *marketImpl) DeliveryPrice(params *client.Params) (*DeliveryPrice, error) {
-	res, err := m.c.Get(fmt.Sprintf("/%s/public/delivery-price", client.APIVersion), *params)
+	res, err := m.c.Get(fmt.Sprintf("/%s/market/delivery-price", client.APIVersion), *params)
 	if err != nil {
 		return nil, err
 	}
@@ -227,8 +611,48 @@ func (m *marketImpl) DeliveryPrice(params *client.Params) (*DeliveryPrice, error
 	return &deliveryPrice, nil
 }
 
+// GetDeliveryPrice returns the delivery prices of expired futures and options. When no cursor
+// is supplied all pages are fetched and merged into a single response.
+func (m *marketImpl) GetDeliveryPrice(req *DeliveryPriceRequest) (*DeliveryPrice, error) {
+	if req == nil || req.Category == "" {
+		return nil, errors.New("category is required")
+	}
+	if err := req.Category.Validate(); err != nil {
+		return nil, err
+	}
+	followCursor := req.Cursor == nil
+	page := *req
+	var merged *DeliveryPrice
+	for {
+		res, err := m.c.Get(fmt.Sprintf("/%s/market/delivery-price", client.APIVersion), ConvertDeliveryPriceRequestToParams(&page))
+		if err != nil {
+			return nil, err
+		}
+		var deliveryPrice DeliveryPrice
+		if err := res.Unmarshal(&deliveryPrice); err != nil {
+			return nil, err
+		}
+		if deliveryPrice.RetCode != 0 {
+			return &deliveryPrice, fmt.Errorf("API returned error: %s", deliveryPrice.RetMsg)
+		}
+		if merged == nil {
+			merged = &deliveryPrice
+		} else {
+			merged.APIResponse = deliveryPrice.APIResponse
+			merged.Result.List = append(merged.Result.List, deliveryPrice.Result.List...)
+			merged.Result.NextPageCursor = deliveryPrice.Result.NextPageCursor
+		}
+		if !followCursor || deliveryPrice.Result.NextPageCursor == "" {
+			break
+		}
+		cursor := deliveryPrice.Result.NextPageCursor
+		page.Cursor = &cursor
+	}
+	return merged, nil
+}
+
 func (m *marketImpl) HistoricalVolatility(params *client.Params) (*HistoricalVolatility, error) {
-	res, err := m.c.Get(fmt.Sprintf("/%s/public/historical-volatility", client.APIVersion), *params)
+	res, err := m.c.Get(fmt.Sprintf("/%s/market/historical-volatility", client.APIVersion), *params)
 	if err != nil {
 		return nil, err
 	}
@@ -239,3 +663,97 @@ func (m *marketImpl) HistoricalVolatility(params *client.Params) (*HistoricalVol
 	}
 	return &historicalVolatility, nil
 }
+
+// GetHistoricalVolatility returns option historical volatility observations for a base coin.
+// StartTime and EndTime must either both be set or both be omitted.
+func (m *marketImpl) GetHistoricalVolatility(req *HistoricalVolatilityRequest) (*HistoricalVolatility, error) {
+	if req == nil {
+		return nil, errors.New("request is required")
+	}
+	if (req.StartTime == nil) != (req.EndTime == nil) {
+		return nil, errors.New("startTime and endTime must be passed together")
+	}
+	params := ConvertHistoricalVolatilityRequestToParams(req)
+	if req.Category == "" {
+		params["category"] = "option"
+	}
+	res, err := m.c.Get(fmt.Sprintf("/%s/market/historical-volatility", client.APIVersion), params)
+	if err != nil {
+		return nil, err
+	}
+	var volatility HistoricalVolatility
+	if err := res.Unmarshal(&volatility); err != nil {
+		return nil, err
+	}
+	if volatility.RetCode != 0 {
+		return &volatility, fmt.Errorf("API returned error: %s", volatility.RetMsg)
+	}
+	return &volatility, nil
+}
+
+// GetLongShortRatio returns the ratio of accounts holding long and short positions, newest first.
+// When StartTime or a Limit above one page is given, nextPageCursor is followed until the range
+// or the limit is exhausted. A Limit of zero counts as unset.
+func (m *marketImpl) GetLongShortRatio(req *LongShortRatioRequest) (*LongShortRatio, error) {
+	if req == nil || req.Category == "" || req.Symbol == "" || req.Period == "" {
+		return nil, errors.New("category, symbol and period are required")
+	}
+	if err := req.Category.Validate(); err != nil {
+		return nil, err
+	}
+	if err := req.Period.Validate(); err != nil {
+		return nil, err
+	}
+
+	remaining := -1 // unbounded: follow the cursor to the end of the range
+	limited := req.Limit != nil && *req.Limit > 0
+	singlePage := !limited && (req.StartTime == nil || req.Cursor != nil)
+	if limited {
+		remaining = *req.Limit
+	}
+
+	page := *req
+	var merged *LongShortRatio
+	for {
+		page.Limit = nil
+		if remaining >= 0 {
+			pageLimit := min(remaining, MaxLongShortRatioLimit)
+			page.Limit = &pageLimit
+		} else if !singlePage {
+			pageLimit := MaxLongShortRatioLimit
+			page.Limit = &pageLimit
+		}
+
+		res, err := m.c.Get(fmt.Sprintf("/%s/market/account-ratio", client.APIVersion), ConvertLongShortRatioRequestToParams(&page))
+		if err != nil {
+			return nil, err
+		}
+		var ratio LongShortRatio
+		if err := res.Unmarshal(&ratio); err != nil {
+			return nil, err
+		}
+		if ratio.RetCode != 0 {
+			return &ratio, fmt.Errorf("API returned error: %s", ratio.RetMsg)
+		}
+		if merged == nil {
+			merged = &ratio
+		} else {
+			merged.APIResponse = ratio.APIResponse
+			merged.Result.List = append(merged.Result.List, ratio.Result.List...)
+			merged.Result.NextPageCursor = ratio.Result.NextPageCursor
+		}
+
+		if remaining >= 0 {
+			remaining -= len(ratio.Result.List)
+			if remaining <= 0 {
+				break
+			}
+		}
+		if singlePage || ratio.Result.NextPageCursor == "" || len(ratio.Result.List) == 0 {
+			break
+		}
+		cursor := ratio.Result.NextPageCursor
+		page.Cursor = &cursor
+	}
+	return merged, nil
+}
@@ -0,0 +1,227 @@
+package market
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	seriesNewest = int64(1_699_999_980_000) // a multiple of seriesStep
+	seriesStep   = int64(60_000)
+)
+
+// newPagingMarket returns a Market backed by an httptest server. handle builds the result of a
+// request and the queries it received are recorded in order.
+func newPagingMarket(t *testing.T, handle func(q url.Values) any) (Market, *[]url.Values) {
+	var queries []url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Query())
+		_ = json.NewEncoder(w).Encode(map[string]any{"retCode": 0, "retMsg": "OK", "result": handle(r.URL.Query())})
+	}))
+	t.Cleanup(server.Close)
+	return New(client.NewClientWithBaseURL("key", "secret", server.URL)), &queries
+}
+
+// timeSeries returns the timestamps a time ranged endpoint answers with: one point per
+// seriesStep, newest first, from the end parameter (or seriesNewest) back to the start
+// parameter and at most limit points.
+func timeSeries(q url.Values, startKey, endKey string, defaultLimit int) []int64 {
+	end, start, limit := seriesNewest, int64(0), defaultLimit
+	if v := q.Get(endKey); v != "" {
+		end, _ = strconv.ParseInt(v, 10, 64)
+		end -= end % seriesStep
+	}
+	if v := q.Get(startKey); v != "" {
+		start, _ = strconv.ParseInt(v, 10, 64)
+	}
+	if v := q.Get("limit"); v != "" {
+		limit, _ = strconv.Atoi(v)
+	}
+	var points []int64
+	for ts := end; ts >= start && len(points) < limit; ts -= seriesStep {
+		points = append(points, ts)
+	}
+	return points
+}
+
+// cursorSeries pages through total points with the offset of the next page as cursor.
+func cursorSeries(q url.Values, total, defaultLimit int) (offsets []int, next string) {
+	offset, _ := strconv.Atoi(q.Get("cursor"))
+	limit := defaultLimit
+	if v := q.Get("limit"); v != "" {
+		limit, _ = strconv.Atoi(v)
+	}
+	for i := offset; i < total && len(offsets) < limit; i++ {
+		offsets = append(offsets, i)
+	}
+	if end := offset + len(offsets); end < total {
+		next = strconv.Itoa(end)
+	}
+	return offsets, next
+}
+
+func assertDescending(t *testing.T, timestamps []int64) {
+	t.Helper()
+	for i := 1; i < len(timestamps); i++ {
+		require.Less(t, timestamps[i], timestamps[i-1], "point %d is out of order or duplicated", i)
+	}
+}
+
+func TestGetKlinePaging(t *testing.T) {
+	m, queries := newPagingMarket(t, func(q url.Values) any {
+		var list [][]string
+		for _, ts := range timeSeries(q, "start", "end", 200) {
+			list = append(list, []string{strconv.FormatInt(ts, 10), "1", "2", "0.5", "1.5", "10", "15"})
+		}
+		return map[string]any{"list": list}
+	})
+
+	limit := MaxKlineLimit + 500
+	res, err := m.GetKline(&KlineRequest{Category: CategoryLinear, Symbol: "BTCUSDT", Interval: Interval1m, Limit: &limit})
+	require.NoError(t, err)
+	require.Equal(t, limit, len(res.Result.List))
+	timestamps := make([]int64, len(res.Result.List))
+	for i, row := range res.Result.List {
+		timestamps[i], _ = strconv.ParseInt(row[0], 10, 64)
+	}
+	assertDescending(t, timestamps)
+	require.Len(t, *queries, 2)
+	assert.Equal(t, "500", (*queries)[1].Get("limit"))
+	assert.Equal(t, strconv.FormatInt(timestamps[MaxKlineLimit-1]-1, 10), (*queries)[1].Get("end"))
+
+	// A start time pages back until the start is covered.
+	*queries = nil
+	start := seriesNewest - 2500*seriesStep
+	res, err = m.GetKline(&KlineRequest{Category: CategoryLinear, Symbol: "BTCUSDT", Interval: Interval1m, Start: &start})
+	require.NoError(t, err)
+	assert.Equal(t, 2501, len(res.Result.List))
+	assert.Len(t, *queries, 3)
+}
+
+func TestGetFundingRateHistoryPaging(t *testing.T) {
+	m, queries := newPagingMarket(t, func(q url.Values) any {
+		var list []FundingRateHistoryItem
+		for _, ts := range timeSeries(q, "startTime", "endTime", MaxFundingHistoryLimit) {
+			list = append(list, FundingRateHistoryItem{Symbol: "BTCUSDT", FundingRate: "0.0001", FundingRateTimestamp: strconv.FormatInt(ts, 10)})
+		}
+		return map[string]any{"category": "linear", "list": list}
+	})
+
+	start := seriesNewest - 449*seriesStep
+	end := seriesNewest
+	res, err := m.GetFundingRateHistory(&FundingRateHistoryRequest{Category: CategoryLinear, Symbol: "BTCUSDT", StartTime: &start, EndTime: &end})
+	require.NoError(t, err)
+	require.Equal(t, 450, len(res.Result.List))
+	timestamps := make([]int64, len(res.Result.List))
+	for i, item := range res.Result.List {
+		timestamps[i], _ = strconv.ParseInt(item.FundingRateTimestamp, 10, 64)
+	}
+	assertDescending(t, timestamps)
+	assert.Equal(t, start, timestamps[len(timestamps)-1])
+	assert.Len(t, *queries, 3)
+	for _, q := range *queries {
+		assert.Equal(t, strconv.FormatInt(start, 10), q.Get("startTime"))
+	}
+
+	// A zero Limit counts as unset and never reaches the API.
+	*queries = nil
+	zero := 0
+	res, err = m.GetFundingRateHistory(&FundingRateHistoryRequest{Category: CategoryLinear, Symbol: "BTCUSDT", Limit: &zero})
+	require.NoError(t, err)
+	assert.Equal(t, MaxFundingHistoryLimit, len(res.Result.List))
+	require.Len(t, *queries, 1)
+	assert.Equal(t, strconv.Itoa(MaxFundingHistoryLimit), (*queries)[0].Get("limit"))
+	assert.Empty(t, ConvertFundingRateHistoryRequestToParams(&FundingRateHistoryRequest{Limit: &zero})["limit"])
+}
+
+func TestGetOpenInterestPaging(t *testing.T) {
+	m, queries := newPagingMarket(t, func(q url.Values) any {
+		offsets, next := cursorSeries(q, 450, 50)
+		list := make([]OpenHistoryItem, len(offsets))
+		for i, offset := range offsets {
+			list[i] = OpenHistoryItem{OpenInterest: "1", Timestamp: strconv.FormatInt(seriesNewest-int64(offset)*seriesStep, 10)}
+		}
+		return map[string]any{"symbol": "BTCUSDT", "category": "linear", "list": list, "nextPageCursor": next}
+	})
+
+	limit := 300
+	res, err := m.GetOpenInterest(&OpenInterestRequest{Category: CategoryLinear, Symbol: "BTCUSDT", IntervalTime: IntervalTime5min, Limit: &limit})
+	require.NoError(t, err)
+	assert.Equal(t, 300, len(res.Result.List))
+	require.Len(t, *queries, 2)
+	assert.Equal(t, "200", (*queries)[1].Get("cursor"))
+	assert.Equal(t, "100", (*queries)[1].Get("limit"))
+	assert.Equal(t, "300", res.Result.NextPageCursor)
+
+	*queries = nil
+	start := int64(0)
+	res, err = m.GetOpenInterest(&OpenInterestRequest{Category: CategoryLinear, Symbol: "BTCUSDT", IntervalTime: IntervalTime5min, StartTime: &start})
+	require.NoError(t, err)
+	assert.Equal(t, 450, len(res.Result.List))
+	assert.Len(t, *queries, 3)
+	assert.Empty(t, res.Result.NextPageCursor)
+
+	// A supplied cursor returns that page only.
+	*queries = nil
+	cursor := "400"
+	res, err = m.GetOpenInterest(&OpenInterestRequest{Category: CategoryLinear, Symbol: "BTCUSDT", IntervalTime: IntervalTime5min, Cursor: &cursor})
+	require.NoError(t, err)
+	assert.Equal(t, 50, len(res.Result.List))
+	assert.Len(t, *queries, 1)
+
+	// A zero Limit counts as unset.
+	*queries = nil
+	zero := 0
+	res, err = m.GetOpenInterest(&OpenInterestRequest{Category: CategoryLinear, Symbol: "BTCUSDT", IntervalTime: IntervalTime5min, Limit: &zero})
+	require.NoError(t, err)
+	assert.Equal(t, MaxOpenInterestLimit, len(res.Result.List))
+	require.Len(t, *queries, 1)
+	assert.Equal(t, strconv.Itoa(MaxOpenInterestLimit), (*queries)[0].Get("limit"))
+	assert.Empty(t, ConvertOpenInterestRequestToParams(&OpenInterestRequest{Limit: &zero})["limit"])
+}
+
+func TestGetLongShortRatioPaging(t *testing.T) {
+	m, queries := newPagingMarket(t, func(q url.Values) any {
+		offsets, next := cursorSeries(q, 1200, 50)
+		list := make([]LongShortRatioItem, len(offsets))
+		for i, offset := range offsets {
+			list[i] = LongShortRatioItem{Symbol: "BTCUSDT", BuyRatio: "0.5", SellRatio: "0.5", Timestamp: strconv.FormatInt(seriesNewest-int64(offset)*seriesStep, 10)}
+		}
+		return map[string]any{"list": list, "nextPageCursor": next}
+	})
+
+	start := int64(0)
+	res, err := m.GetLongShortRatio(&LongShortRatioRequest{Category: CategoryLinear, Symbol: "BTCUSDT", Period: IntervalTime1h, StartTime: &start})
+	require.NoError(t, err)
+	assert.Equal(t, 1200, len(res.Result.List))
+	require.Len(t, *queries, 3)
+	assert.Equal(t, strconv.Itoa(MaxLongShortRatioLimit), (*queries)[0].Get("limit"))
+	assert.Equal(t, "1000", (*queries)[2].Get("cursor"))
+
+	// Without a range or limit a single page of the API default size is returned.
+	*queries = nil
+	res, err = m.GetLongShortRatio(&LongShortRatioRequest{Category: CategoryLinear, Symbol: "BTCUSDT", Period: IntervalTime1h})
+	require.NoError(t, err)
+	assert.Equal(t, 50, len(res.Result.List))
+	assert.Equal(t, "50", res.Result.NextPageCursor)
+	assert.Len(t, *queries, 1)
+	assert.Empty(t, (*queries)[0].Get("limit"))
+
+	// So does a zero Limit.
+	*queries = nil
+	zero := 0
+	res, err = m.GetLongShortRatio(&LongShortRatioRequest{Category: CategoryLinear, Symbol: "BTCUSDT", Period: IntervalTime1h, Limit: &zero})
+	require.NoError(t, err)
+	assert.Equal(t, 50, len(res.Result.List))
+	require.Len(t, *queries, 1)
+	assert.Empty(t, (*queries)[0].Get("limit"))
+	assert.Empty(t, ConvertLongShortRatioRequestToParams(&LongShortRatioRequest{Limit: &zero})["limit"])
+}
@@ -0,0 +1,69 @@
+package market
+
+// Instrument statuses.
+const (
+	StatusPreLaunch  = "PreLaunch"
+	StatusTrading    = "Trading"
+	StatusDelivering = "Delivering"
+	StatusClosed     = "Closed"
+)
+
+// Phases of a pre-listed instrument.
+const (
+	PhaseNotStarted          = "NotStarted"
+	PhaseFinished            = "Finished"
+	PhaseCallAuction         = "CallAuction"
+	PhaseCallAuctionNoCancel = "CallAuctionNoCancel"
+	PhaseCrossMatching       = "CrossMatching"
+	PhaseContinuousTrading   = "ContinuousTrading"
+)
+
+// PreListingPhase is a scheduled phase of a pre-listed instrument.
+type PreListingPhase struct {
+	Phase     string `json:"phase"`
+	StartTime string `json:"startTime"`
+	EndTime   string `json:"endTime"`
+}
+
+// PreListingInfo describes the auction schedule and fees of a pre-listed instrument.
+type PreListingInfo struct {
+	CurAuctionPhase string            `json:"curAuctionPhase"`
+	Phases          []PreListingPhase `json:"phases"`
+	AuctionFeeInfo  struct {
+		AuctionFeeRate string `json:"auctionFeeRate"`
+		TakerFeeRate   string `json:"takerFeeRate"`
+		MakerFeeRate   string `json:"makerFeeRate"`
+	} `json:"auctionFeeInfo"`
+}
+
+// AcceptsOrders reports whether orders can be placed in the current phase. Orders are rejected
+// before the auction starts and while the auction is being matched.
+func (p *PreListingInfo) AcceptsOrders() bool {
+	switch p.CurAuctionPhase {
+	case PhaseCallAuction, PhaseCallAuctionNoCancel, PhaseContinuousTrading:
+		return true
+	}
+	return false
+}
+
+// AcceptsCancels reports whether orders can be cancelled in the current phase.
+func (p *PreListingInfo) AcceptsCancels() bool {
+	return p.CurAuctionPhase == PhaseCallAuction || p.CurAuctionPhase == PhaseContinuousTrading
+}
+
+// GetPreListingInstruments returns the pre-listed instruments of a category, so strategies can
+// discover new pairs before regular trading starts.
+func (m *marketImpl) GetPreListingInstruments(category Category) ([]InstrumentInfo, error) {
+	status := StatusPreLaunch
+	res, err := m.GetInstrumentsInfo(&InstrumentsInfoRequest{Category: category, Status: &status})
+	if err != nil {
+		return nil, err
+	}
+	var instruments []InstrumentInfo
+	for _, instrument := range res.Result.List {
+		if instrument.IsPreListing {
+			instruments = append(instruments, instrument)
+		}
+	}
+	return instruments, nil
+}
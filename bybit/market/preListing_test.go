@@ -0,0 +1,32 @@
+package market
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreListingInfo(t *testing.T) {
+	var info InstrumentInfo
+	err := json.Unmarshal([]byte(`{
+		"symbol": "NEWUSDT",
+		"status": "PreLaunch",
+		"isPreListing": true,
+		"preListingInfo": {
+			"curAuctionPhase": "CallAuctionNoCancel",
+			"phases": [{"phase": "CallAuction", "startTime": "1", "endTime": "2"}],
+			"auctionFeeInfo": {"auctionFeeRate": "0", "takerFeeRate": "0.001", "makerFeeRate": "0.0004"}
+		}
+	}`), &info)
+	require.NoError(t, err)
+	require.NotNil(t, info.PreListingInfo)
+	assert.True(t, info.IsPreListing)
+	assert.True(t, info.PreListingInfo.AcceptsOrders())
+	assert.False(t, info.PreListingInfo.AcceptsCancels())
+	assert.Equal(t, "0.001", info.PreListingInfo.AuctionFeeInfo.TakerFeeRate)
+
+	info.PreListingInfo.CurAuctionPhase = PhaseCrossMatching
+	assert.False(t, info.PreListingInfo.AcceptsOrders())
+}
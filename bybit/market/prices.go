@@ -0,0 +1,60 @@
+package market
+
+import (
+	"fmt"
+
+	"github.com/cploutarchou/crypto-sdk-suite/decimal"
+)
+
+// PriceLevel is one order book level.
+type PriceLevel struct {
+	Price decimal.Decimal
+	Size  decimal.Decimal
+}
+
+// Bids returns the bid side of the order book, best price first.
+func (r OrderBookResult) Bids() ([]PriceLevel, error) {
+	return parseLevels(r.B)
+}
+
+// Asks returns the ask side of the order book, best price first.
+func (r OrderBookResult) Asks() ([]PriceLevel, error) {
+	return parseLevels(r.A)
+}
+
+func parseLevels(rows [][]string) ([]PriceLevel, error) {
+	levels := make([]PriceLevel, len(rows))
+	for i, row := range rows {
+		if len(row) < 2 {
+			return nil, fmt.Errorf("order book level %d has %d fields, want 2", i, len(row))
+		}
+		var err error
+		if levels[i].Price, err = decimal.NewFromString(row[0]); err != nil {
+			return nil, fmt.Errorf("order book level %d price: %w", i, err)
+		}
+		if levels[i].Size, err = decimal.NewFromString(row[1]); err != nil {
+			return nil, fmt.Errorf("order book level %d size: %w", i, err)
+		}
+	}
+	return levels, nil
+}
+
+// Last returns the last traded price as a decimal.
+func (t TickerInfo) Last() (decimal.Decimal, error) {
+	return decimal.NewFromString(t.LastPrice)
+}
+
+// Mark returns the mark price as a decimal. Spot tickers have no mark price.
+func (t TickerInfo) Mark() (decimal.Decimal, error) {
+	return decimal.NewFromString(t.MarkPrice)
+}
+
+// BestBid returns the best bid price as a decimal.
+func (t TickerInfo) BestBid() (decimal.Decimal, error) {
+	return decimal.NewFromString(t.Bid1Price)
+}
+
+// BestAsk returns the best ask price as a decimal.
+func (t TickerInfo) BestAsk() (decimal.Decimal, error) {
+	return decimal.NewFromString(t.Ask1Price)
+}
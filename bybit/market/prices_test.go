@@ -0,0 +1,37 @@
+package market
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderBookLevels(t *testing.T) {
+	book := OrderBookResult{
+		B: [][]string{{"30000.5", "1.25"}, {"30000", "0.1"}},
+		A: [][]string{{"30001", "0.002"}},
+	}
+	bids, err := book.Bids()
+	require.NoError(t, err)
+	require.Len(t, bids, 2)
+	assert.Equal(t, "30000.5", bids[0].Price.String())
+	assert.Equal(t, "1.25", bids[0].Size.String())
+
+	asks, err := book.Asks()
+	require.NoError(t, err)
+	assert.Equal(t, "0.002", asks[0].Size.String())
+
+	_, err = OrderBookResult{B: [][]string{{"1"}}}.Bids()
+	assert.Error(t, err)
+}
+
+func TestTickerPrices(t *testing.T) {
+	ticker := TickerInfo{LastPrice: "0.1", Bid1Price: "0.09", Ask1Price: "0.11"}
+	last, err := ticker.Last()
+	require.NoError(t, err)
+	bid, _ := ticker.BestBid()
+	ask, _ := ticker.BestAsk()
+	assert.Equal(t, "0.2", bid.Add(ask).String())
+	assert.Equal(t, "0.1", last.String())
+}
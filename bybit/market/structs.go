@@ -1,5 +1,12 @@
 package market
 
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
 type APIResponse struct {
 	RetCode    int    `json:"retCode"`
 	RetMsg     string `json:"retMsg"`
@@ -10,12 +17,12 @@ type APIResponse struct {
 
 // KlineRequest represents a request for querying historical klines
 type KlineRequest struct {
-	Category string `json:"category,omitempty"` // Optional: 'spot', 'linear', 'inverse'. Defaults to 'linear' if not specified.
-	Symbol   string `json:"symbol"`             // Required: Symbol name.
-	Interval string `json:"interval"`           // Required: Kline interval. Accepts '1', '3', '5', '15', '30', '60', '120', '240', '360', '720', 'D', 'M', 'W'.
-	Start    *int64 `json:"start,omitempty"`    // Optional: The start timestamp in milliseconds.
-	End      *int64 `json:"end,omitempty"`      // Optional: The end timestamp in milliseconds.
-	Limit    *int   `json:"limit,omitempty"`    // Optional: Limit the number of klines returned.
+	Category Category `json:"category,omitempty"` // Optional: 'spot', 'linear', 'inverse'. Defaults to 'linear' if not specified.
+	Symbol   string   `json:"symbol"`             // Required: Symbol name.
+	Interval Interval `json:"interval"`           // Required: Kline interval. Accepts '1', '3', '5', '15', '30', '60', '120', '240', '360', '720', 'D', 'M', 'W'.
+	Start    *int64   `json:"start,omitempty"`    // Optional: The start timestamp in milliseconds.
+	End      *int64   `json:"end,omitempty"`      // Optional: The end timestamp in milliseconds.
+	Limit    *int     `json:"limit,omitempty"`    // Optional: Limit the number of klines returned.
 }
 
 type KlineResult struct {
@@ -29,6 +36,15 @@ type ServerTimeResult struct {
 	TimeNano   string `json:"timeNano"`
 }
 
+// Time converts the nanosecond server time to a time.Time.
+func (r ServerTimeResult) Time() (time.Time, error) {
+	nanos, err := strconv.ParseInt(r.TimeNano, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(0, nanos), nil
+}
+
 type OrderBookResult struct {
 	S  string     `json:"s"`
 	A  [][]string `json:"a"`
@@ -38,8 +54,28 @@ type OrderBookResult struct {
 }
 
 type RiskLimitResult struct {
-	Category string `json:"category"`
-	List     []any  `json:"list"`
+	Category       string          `json:"category"`
+	List           []RiskLimitTier `json:"list"`
+	NextPageCursor string          `json:"nextPageCursor"`
+}
+
+// RiskLimitTier is one risk limit tier of a symbol. Margin rates are fractions, e.g. "0.005".
+type RiskLimitTier struct {
+	ID                int    `json:"id"`
+	Symbol            string `json:"symbol"`
+	RiskLimitValue    string `json:"riskLimitValue"`
+	MaintenanceMargin string `json:"maintenanceMargin"`
+	InitialMargin     string `json:"initialMargin"`
+	IsLowestRisk      int    `json:"isLowestRisk"`
+	MaxLeverage       string `json:"maxLeverage"`
+	MMDeduction       string `json:"mmDeduction"`
+}
+
+// RiskLimitRequest represents a request for risk limit tiers.
+type RiskLimitRequest struct {
+	Category Category `json:"category"`         // Required: 'linear' or 'inverse'.
+	Symbol   *string  `json:"symbol,omitempty"` // Optional: Symbol name. All symbols are returned when omitted.
+	Cursor   *string  `json:"cursor,omitempty"` // Optional: nextPageCursor of a previous page. Disables automatic paging.
 }
 
 type ResendTradeItem struct {
@@ -81,6 +117,104 @@ type FundingRateHistoryItem struct {
 	FundingRateTimestamp string `json:"fundingRateTimestamp"`
 }
 
+// FundingRateHistoryRequest represents a request for historical funding rates.
+type FundingRateHistoryRequest struct {
+	Category  Category `json:"category"`            // Required: 'linear' or 'inverse'.
+	Symbol    string   `json:"symbol"`              // Required: Symbol name.
+	StartTime *int64   `json:"startTime,omitempty"` // Optional: The start timestamp in milliseconds.
+	EndTime   *int64   `json:"endTime,omitempty"`   // Optional: The end timestamp in milliseconds.
+	Limit     *int     `json:"limit,omitempty"`     // Optional: Total number of records to return. Pages of 200 are fetched as needed; zero means unset.
+}
+
+// RecentTradesRequest represents a request for recent public trades.
+type RecentTradesRequest struct {
+	Category   Category `json:"category"`             // Required: 'spot', 'linear', 'inverse' or 'option'.
+	Symbol     *string  `json:"symbol,omitempty"`     // Optional for options, required otherwise.
+	BaseCoin   *string  `json:"baseCoin,omitempty"`   // Optional: Option only.
+	OptionType *string  `json:"optionType,omitempty"` // Optional: Option only, 'Call' or 'Put'.
+	Limit      *int     `json:"limit,omitempty"`      // Optional: spot [1,60], others [1,1000].
+}
+
+// PublicTrade is a single public trade. The mark/index price and IV fields are only set for options.
+type PublicTrade struct {
+	ExecID       string `json:"execId"`
+	Symbol       string `json:"symbol"`
+	Price        string `json:"price"`
+	Size         string `json:"size"`
+	Side         string `json:"side"`
+	Time         string `json:"time"`
+	IsBlockTrade bool   `json:"isBlockTrade"`
+	Seq          string `json:"seq"`
+	MarkPrice    string `json:"mP"`
+	IndexPrice   string `json:"iP"`
+	MarkIv       string `json:"mIv"`
+	Iv           string `json:"iv"`
+}
+
+type PublicTradesResponse struct {
+	APIResponse
+	Result struct {
+		Category string        `json:"category"`
+		List     []PublicTrade `json:"list"`
+	} `json:"result"`
+}
+
+// OpenInterestRequest represents a request for open interest history.
+type OpenInterestRequest struct {
+	Category     Category     `json:"category"`            // Required: 'linear' or 'inverse'.
+	Symbol       string       `json:"symbol"`              // Required: Symbol name.
+	IntervalTime IntervalTime `json:"intervalTime"`        // Required: One of the IntervalTime constants.
+	StartTime    *int64       `json:"startTime,omitempty"` // Optional: The start timestamp in milliseconds.
+	EndTime      *int64       `json:"endTime,omitempty"`   // Optional: The end timestamp in milliseconds.
+	Limit        *int         `json:"limit,omitempty"`     // Optional: Total number of points to return. Pages of 200 are fetched as needed; zero means unset.
+	Cursor       *string      `json:"cursor,omitempty"`    // Optional: nextPageCursor of a previous page. Disables automatic paging.
+}
+
+// HistoricalVolatilityRequest represents a request for option historical volatility.
+type HistoricalVolatilityRequest struct {
+	Category  Category `json:"category"`            // Required: 'option'.
+	BaseCoin  *string  `json:"baseCoin,omitempty"`  // Optional: Base coin, defaults to BTC.
+	QuoteCoin *string  `json:"quoteCoin,omitempty"` // Optional: 'USD' or 'USDT', defaults to USD.
+	Period    *int     `json:"period,omitempty"`    // Optional: Period in days, e.g. 7, 14, 21, 30, 60, 90, 180, 270. Defaults to 7.
+	StartTime *int64   `json:"startTime,omitempty"` // Optional: The start timestamp in milliseconds. Range up to 30 days.
+	EndTime   *int64   `json:"endTime,omitempty"`   // Optional: The end timestamp in milliseconds.
+}
+
+// DeliveryPriceRequest represents a request for delivery prices of expired contracts.
+type DeliveryPriceRequest struct {
+	Category Category `json:"category"`           // Required: 'linear', 'inverse' or 'option'.
+	Symbol   *string  `json:"symbol,omitempty"`   // Optional: Symbol name.
+	BaseCoin *string  `json:"baseCoin,omitempty"` // Optional: Option only, defaults to BTC.
+	Limit    *int     `json:"limit,omitempty"`    // Optional: Page size [1, 200].
+	Cursor   *string  `json:"cursor,omitempty"`   // Optional: nextPageCursor of a previous page. Disables automatic paging.
+}
+
+// LongShortRatioRequest represents a request for the long/short account ratio.
+type LongShortRatioRequest struct {
+	Category  Category     `json:"category"`            // Required: 'linear' or 'inverse'.
+	Symbol    string       `json:"symbol"`              // Required: Symbol name.
+	Period    IntervalTime `json:"period"`              // Required: One of the IntervalTime constants.
+	StartTime *int64       `json:"startTime,omitempty"` // Optional: The start timestamp in milliseconds.
+	EndTime   *int64       `json:"endTime,omitempty"`   // Optional: The end timestamp in milliseconds.
+	Limit     *int         `json:"limit,omitempty"`     // Optional: Total number of points to return. Pages of 500 are fetched as needed; zero means unset.
+	Cursor    *string      `json:"cursor,omitempty"`    // Optional: nextPageCursor of a previous page. Disables automatic paging.
+}
+
+type LongShortRatioItem struct {
+	Symbol    string `json:"symbol"`
+	BuyRatio  string `json:"buyRatio"`
+	SellRatio string `json:"sellRatio"`
+	Timestamp string `json:"timestamp"`
+}
+
+type LongShortRatio struct {
+	APIResponse
+	Result struct {
+		List           []LongShortRatioItem `json:"list"`
+		NextPageCursor string               `json:"nextPageCursor"`
+	} `json:"result"`
+}
+
 type KlineResponse struct {
 	APIResponse
 	Result KlineResult `json:"result"`
@@ -177,36 +311,69 @@ type AnnouncementsResponse struct {
 	Result Announcement `json:"result"`
 }
 
+// LeverageFilter describes the leverage range of a derivatives instrument.
+type LeverageFilter struct {
+	MinLeverage  string `json:"minLeverage"`
+	MaxLeverage  string `json:"maxLeverage"`
+	LeverageStep string `json:"leverageStep"`
+}
+
+// PriceFilter describes the allowed price range and tick size of an instrument.
+type PriceFilter struct {
+	MinPrice string `json:"minPrice"`
+	MaxPrice string `json:"maxPrice"`
+	TickSize string `json:"tickSize"`
+}
+
+// LotSizeFilter describes the allowed order quantity of an instrument. Spot instruments use the
+// precision and amount fields, derivatives use QtyStep.
+type LotSizeFilter struct {
+	MaxOrderQty         string `json:"maxOrderQty"`
+	MinOrderQty         string `json:"minOrderQty"`
+	MaxMktOrderQty      string `json:"maxMktOrderQty"`
+	QtyStep             string `json:"qtyStep"`
+	PostOnlyMaxOrderQty string `json:"postOnlyMaxOrderQty"`
+	MinNotionalValue    string `json:"minNotionalValue"`
+	BasePrecision       string `json:"basePrecision"`
+	QuotePrecision      string `json:"quotePrecision"`
+	MinOrderAmt         string `json:"minOrderAmt"`
+	MaxOrderAmt         string `json:"maxOrderAmt"`
+}
+
 type InstrumentInfo struct {
-	Symbol          string `json:"symbol"`
-	ContractType    string `json:"contractType"`
-	Status          string `json:"status"`
-	BaseCoin        string `json:"baseCoin"`
-	QuoteCoin       string `json:"quoteCoin"`
-	LaunchTime      string `json:"launchTime"`
-	DeliveryTime    string `json:"deliveryTime"`
-	DeliveryFeeRate string `json:"deliveryFeeRate"`
-	PriceScale      string `json:"priceScale"`
-	LeverageFilter  struct {
-		MinLeverage  string `json:"minLeverage"`
-		MaxLeverage  string `json:"maxLeverage"`
-		LeverageStep string `json:"leverageStep"`
-	} `json:"leverageFilter"`
-	PriceFilter struct {
-		MinPrice string `json:"minPrice"`
-		MaxPrice string `json:"maxPrice"`
-		TickSize string `json:"tickSize"`
-	} `json:"priceFilter"`
-	LotSizeFilter struct {
-		MaxOrderQty         string `json:"maxOrderQty"`
-		MinOrderQty         string `json:"minOrderQty"`
-		MaxMktOrderQty      string `json:"maxMktOrderQty"`
-		QtyStep             string `json:"qtyStep"`
-		PostOnlyMaxOrderQty string `json:"postOnlyMaxOrderQty"`
-	} `json:"lotSizeFilter"`
-	UnifiedMarginTrade bool   `json:"unifiedMarginTrade"`
-	FundingInterval    int    `json:"fundingInterval"`
-	SettleCoin         string `json:"settleCoin"`
+	Symbol             string          `json:"symbol"`
+	ContractType       string          `json:"contractType"`
+	OptionsType        string          `json:"optionsType"`
+	Status             string          `json:"status"`
+	BaseCoin           string          `json:"baseCoin"`
+	QuoteCoin          string          `json:"quoteCoin"`
+	LaunchTime         string          `json:"launchTime"`
+	DeliveryTime       string          `json:"deliveryTime"`
+	DeliveryFeeRate    string          `json:"deliveryFeeRate"`
+	PriceScale         string          `json:"priceScale"`
+	Innovation         string          `json:"innovation"`
+	MarginTrading      string          `json:"marginTrading"`
+	LeverageFilter     LeverageFilter  `json:"leverageFilter"`
+	PriceFilter        PriceFilter     `json:"priceFilter"`
+	LotSizeFilter      LotSizeFilter   `json:"lotSizeFilter"`
+	UnifiedMarginTrade bool            `json:"unifiedMarginTrade"`
+	FundingInterval    int             `json:"fundingInterval"`
+	SettleCoin         string          `json:"settleCoin"`
+	CopyTrading        string          `json:"copyTrading"`
+	UpperFundingRate   string          `json:"upperFundingRate"`
+	LowerFundingRate   string          `json:"lowerFundingRate"`
+	IsPreListing       bool            `json:"isPreListing"`
+	PreListingInfo     *PreListingInfo `json:"preListingInfo"` // Only set while the symbol is pre-listed.
+}
+
+// InstrumentsInfoRequest represents a request for instrument specifications.
+type InstrumentsInfoRequest struct {
+	Category Category `json:"category"`           // Required: 'spot', 'linear', 'inverse' or 'option'.
+	Symbol   *string  `json:"symbol,omitempty"`   // Optional: Symbol name.
+	Status   *string  `json:"status,omitempty"`   // Optional: Symbol status filter, e.g. 'Trading' or 'PreLaunch'.
+	BaseCoin *string  `json:"baseCoin,omitempty"` // Optional: Derivatives only.
+	Limit    *int     `json:"limit,omitempty"`    // Optional: Page size [1, 1000].
+	Cursor   *string  `json:"cursor,omitempty"`   // Optional: nextPageCursor of the previous page.
 }
 
 type InstrumentsInfoResponse struct {
@@ -243,6 +410,33 @@ type TickerInfo struct {
 	Ask1Price              string `json:"ask1Price"`
 	Bid1Size               string `json:"bid1Size"`
 	Basis                  string `json:"basis"`
+	USDIndexPrice          string `json:"usdIndexPrice"` // Spot only.
+
+	// Pre-listing only.
+	CurPreListingPhase string `json:"curPreListingPhase"`
+	PreOpenPrice       string `json:"preOpenPrice"`
+	PreQty             string `json:"preQty"`
+
+	// Option only.
+	Bid1Iv          string `json:"bid1Iv"`
+	Ask1Iv          string `json:"ask1Iv"`
+	MarkIv          string `json:"markIv"`
+	UnderlyingPrice string `json:"underlyingPrice"`
+	TotalVolume     string `json:"totalVolume"`
+	TotalTurnover   string `json:"totalTurnover"`
+	Delta           string `json:"delta"`
+	Gamma           string `json:"gamma"`
+	Vega            string `json:"vega"`
+	Theta           string `json:"theta"`
+	Change24H       string `json:"change24h"`
+}
+
+// TickersRequest represents a request for 24h ticker statistics.
+type TickersRequest struct {
+	Category Category `json:"category"`           // Required: 'spot', 'linear', 'inverse' or 'option'.
+	Symbol   *string  `json:"symbol,omitempty"`   // Optional: Symbol name. Returns all symbols of the category when omitted.
+	BaseCoin *string  `json:"baseCoin,omitempty"` // Optional: Option only. Either symbol or baseCoin is required for options.
+	ExpDate  *string  `json:"expDate,omitempty"`  // Optional: Option only, expiry date such as 25DEC22.
 }
 
 type TickerResponse struct {
@@ -252,3 +446,52 @@ type TickerResponse struct {
 		List     []TickerInfo `json:"list"`
 	} `json:"result"`
 }
+
+// OrderbookRequest represents a request for the orderbook snapshot.
+type OrderbookRequest struct {
+	Category Category `json:"category"`        // Required: 'spot', 'linear', 'inverse' or 'option'.
+	Symbol   string   `json:"symbol"`          // Required: Symbol name.
+	Limit    *int     `json:"limit,omitempty"` // Optional: Depth per side. spot [1,200], linear/inverse [1,500], option [1,25].
+}
+
+// OrderbookLevel is a single price level of the book.
+type OrderbookLevel struct {
+	Price string
+	Size  string
+}
+
+// UnmarshalJSON decodes a level from Bybit's ["price", "size"] array form.
+func (l *OrderbookLevel) UnmarshalJSON(data []byte) error {
+	var raw []string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if len(raw) != 2 {
+		return fmt.Errorf("invalid orderbook level: %s", data)
+	}
+	l.Price, l.Size = raw[0], raw[1]
+	return nil
+}
+
+// MarshalJSON encodes a level back to the ["price", "size"] array form.
+func (l OrderbookLevel) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]string{l.Price, l.Size})
+}
+
+// OrderbookSnapshot is a typed orderbook snapshot. Bids are sorted by price descending and
+// asks ascending. UpdateID and Seq match the "u" and "seq" fields of the WebSocket orderbook
+// topic, so the snapshot can seed a locally maintained book.
+type OrderbookSnapshot struct {
+	Symbol   string           `json:"s"`
+	Bids     []OrderbookLevel `json:"b"`
+	Asks     []OrderbookLevel `json:"a"`
+	TS       int64            `json:"ts"`
+	UpdateID int64            `json:"u"`
+	Seq      int64            `json:"seq"`
+	CTS      int64            `json:"cts"`
+}
+
+type OrderbookResponse struct {
+	APIResponse
+	Result OrderbookSnapshot `json:"result"`
+}
@@ -0,0 +1,45 @@
+package p2p
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+)
+
+// toParams converts a request struct into POST parameters using its json tags, which keeps the
+// nested trading preferences and the payment ID arrays intact.
+func toParams(req any) (client.Params, error) {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding request: %w", err)
+	}
+	params := make(client.Params)
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&params); err != nil {
+		return nil, fmt.Errorf("error encoding request: %w", err)
+	}
+	return params, nil
+}
+
+func validSide(side string) bool {
+	return side == SideBuy || side == SideSell
+}
+
+func validateAd(priceType int, premium, price, minAmount, maxAmount, quantity string, paymentIDs []string) error {
+	if priceType != PriceTypeFixed && priceType != PriceTypeFloating {
+		return fmt.Errorf("invalid priceType %d", priceType)
+	}
+	if priceType == PriceTypeFloating && premium == "" {
+		return fmt.Errorf("premium is required for floating price ads")
+	}
+	if price == "" || minAmount == "" || maxAmount == "" || quantity == "" {
+		return fmt.Errorf("price, minAmount, maxAmount and quantity are required")
+	}
+	if len(paymentIDs) == 0 {
+		return fmt.Errorf("at least one payment method is required")
+	}
+	return nil
+}
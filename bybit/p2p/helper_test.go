@@ -0,0 +1,27 @@
+package p2p
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToParams(t *testing.T) {
+	status := OrderStatusWaitingSellerRelease
+	params, err := toParams(&GetOrdersRequest{Page: 1, Size: 20, Status: &status, Side: []int{0, 1}})
+	require.NoError(t, err)
+	assert.NotContains(t, params, "tokenId")
+
+	body, err := json.Marshal(params)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"page":1,"size":20,"status":20,"side":[0,1]}`, string(body))
+}
+
+func TestValidateAd(t *testing.T) {
+	assert.NoError(t, validateAd(PriceTypeFixed, "", "1.01", "10", "1000", "500", []string{"123"}))
+	assert.Error(t, validateAd(PriceTypeFloating, "", "1.01", "10", "1000", "500", []string{"123"}))
+	assert.Error(t, validateAd(PriceTypeFixed, "", "1.01", "10", "1000", "500", nil))
+	assert.Error(t, validateAd(2, "", "1.01", "10", "1000", "500", []string{"123"}))
+}
@@ -0,0 +1,350 @@
+package p2p
+
+import (
+	"fmt"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+)
+
+// P2P defines the interface for the P2P trading endpoints of the Bybit API.
+type P2P interface {
+	// GetOnlineAds lists the online ads of the P2P market.
+	// req: GetOnlineAdsRequest - the token, fiat currency, side and page of the query.
+	// returns: *AdsResponse - the matching ads.
+	//          error - an error if the request fails.
+	GetOnlineAds(req *GetOnlineAdsRequest) (*AdsResponse, error)
+
+	// GetMyAds lists the ads posted by the account.
+	// returns: *AdsResponse - the ads of the account.
+	//          error - an error if the request fails.
+	GetMyAds() (*AdsResponse, error)
+
+	// GetAd retrieves a single ad posted by the account.
+	// itemID: string - the ad ID.
+	// returns: *AdResponse - the ad.
+	//          error - an error if the request fails.
+	GetAd(itemID string) (*AdResponse, error)
+
+	// CreateAd posts an ad.
+	// req: CreateAdRequest - the token, price, limits and payment methods of the ad.
+	// returns: *CreateAdResponse - the ID of the new ad.
+	//          error - an error if the request fails.
+	CreateAd(req *CreateAdRequest) (*CreateAdResponse, error)
+
+	// UpdateAd modifies or relists an ad.
+	// req: UpdateAdRequest - the ad ID, the action and the new terms.
+	// returns: *Response - the response from the API.
+	//          error - an error if the request fails.
+	UpdateAd(req *UpdateAdRequest) (*Response, error)
+
+	// CancelAd takes an ad offline.
+	// itemID: string - the ad ID.
+	// returns: *Response - the response from the API.
+	//          error - an error if the request fails.
+	CancelAd(itemID string) (*Response, error)
+
+	// GetOrders lists the orders of the account.
+	// req: GetOrdersRequest - the page, status, time range and side of the query.
+	// returns: *OrdersResponse - the matching orders.
+	//          error - an error if the request fails.
+	GetOrders(req *GetOrdersRequest) (*OrdersResponse, error)
+
+	// GetPendingOrders lists the orders that still need an action.
+	// req: GetOrdersRequest - the page, status, time range and side of the query.
+	// returns: *OrdersResponse - the pending orders.
+	//          error - an error if the request fails.
+	GetPendingOrders(req *GetOrdersRequest) (*OrdersResponse, error)
+
+	// GetOrder retrieves the details of an order.
+	// orderID: string - the order ID.
+	// returns: *OrderDetailResponse - the order including the payment details.
+	//          error - an error if the request fails.
+	GetOrder(orderID string) (*OrderDetailResponse, error)
+
+	// MarkOrderPaid marks an order as paid, as the buyer.
+	// orderID: string - the order ID.
+	// paymentType: string - the payment type used.
+	// paymentID: string - the payment method ID used.
+	// returns: *Response - the response from the API.
+	//          error - an error if the request fails.
+	MarkOrderPaid(orderID, paymentType, paymentID string) (*Response, error)
+
+	// ReleaseOrder releases the tokens of an order, as the seller.
+	// orderID: string - the order ID.
+	// returns: *Response - the response from the API.
+	//          error - an error if the request fails.
+	ReleaseOrder(orderID string) (*Response, error)
+
+	// SendMessage sends a chat message on an order.
+	// req: SendMessageRequest - the order, content and content type of the message.
+	// returns: *Response - the response from the API.
+	//          error - an error if the request fails.
+	SendMessage(req *SendMessageRequest) (*Response, error)
+
+	// GetMessages lists the chat messages of an order.
+	// orderID: string - the order ID.
+	// page: int - the page number, starting from 1.
+	// size: int - the page size.
+	// returns: *MessagesResponse - the messages.
+	//          error - an error if the request fails.
+	GetMessages(orderID string, page, size int) (*MessagesResponse, error)
+}
+
+type impl struct {
+	client *client.Client
+}
+
+// New creates a new instance of the P2P interface, which can be used to interact with the Bybit API.
+func New(c *client.Client) P2P {
+	return &impl{client: c}
+}
+
+// post sends a P2P request and decodes the response into out. Every P2P endpoint is a POST.
+func (i *impl) post(path string, params client.Params, out any) error {
+	response, err := i.client.Post(path, params)
+	if err != nil {
+		return err
+	}
+	return response.Unmarshal(out)
+}
+
+// GetOnlineAds lists the online ads of the P2P market for a token and fiat currency.
+func (i *impl) GetOnlineAds(req *GetOnlineAdsRequest) (*AdsResponse, error) {
+	if req.TokenID == "" || req.CurrencyID == "" {
+		return nil, fmt.Errorf("tokenId and currencyId are required")
+	}
+	if !validSide(req.Side) {
+		return nil, fmt.Errorf("invalid side %q", req.Side)
+	}
+	params, err := toParams(req)
+	if err != nil {
+		return nil, err
+	}
+	var adsResponse AdsResponse
+	if err := i.post("/v5/p2p/item/online", params, &adsResponse); err != nil {
+		return nil, fmt.Errorf("error fetching online ads: %w", err)
+	}
+	if adsResponse.RetCode != 0 {
+		return &adsResponse, fmt.Errorf("API returned error: %s", adsResponse.RetMsg)
+	}
+	return &adsResponse, nil
+}
+
+// GetMyAds lists the ads posted by the account.
+func (i *impl) GetMyAds() (*AdsResponse, error) {
+	var adsResponse AdsResponse
+	if err := i.post("/v5/p2p/item/personal/list", client.Params{}, &adsResponse); err != nil {
+		return nil, fmt.Errorf("error fetching personal ads: %w", err)
+	}
+	if adsResponse.RetCode != 0 {
+		return &adsResponse, fmt.Errorf("API returned error: %s", adsResponse.RetMsg)
+	}
+	return &adsResponse, nil
+}
+
+// GetAd retrieves a single ad posted by the account.
+func (i *impl) GetAd(itemID string) (*AdResponse, error) {
+	if itemID == "" {
+		return nil, fmt.Errorf("itemId is required")
+	}
+	var adResponse AdResponse
+	if err := i.post("/v5/p2p/item/info", client.Params{"itemId": itemID}, &adResponse); err != nil {
+		return nil, fmt.Errorf("error fetching ad: %w", err)
+	}
+	if adResponse.RetCode != 0 {
+		return &adResponse, fmt.Errorf("API returned error: %s", adResponse.RetMsg)
+	}
+	return &adResponse, nil
+}
+
+// CreateAd posts an ad. The payment IDs must belong to payment methods configured on the account.
+func (i *impl) CreateAd(req *CreateAdRequest) (*CreateAdResponse, error) {
+	if req.TokenID == "" || req.CurrencyID == "" {
+		return nil, fmt.Errorf("tokenId and currencyId are required")
+	}
+	if !validSide(req.Side) {
+		return nil, fmt.Errorf("invalid side %q", req.Side)
+	}
+	if err := validateAd(req.PriceType, req.Premium, req.Price, req.MinAmount, req.MaxAmount, req.Quantity, req.PaymentIDs); err != nil {
+		return nil, err
+	}
+	params, err := toParams(req)
+	if err != nil {
+		return nil, err
+	}
+	var createResponse CreateAdResponse
+	if err := i.post("/v5/p2p/item/create", params, &createResponse); err != nil {
+		return nil, fmt.Errorf("error creating ad: %w", err)
+	}
+	if createResponse.RetCode != 0 {
+		return &createResponse, fmt.Errorf("API returned error: %s", createResponse.RetMsg)
+	}
+	return &createResponse, nil
+}
+
+// UpdateAd modifies the terms of an ad with ActionModify, or relists an offline ad with ActionActivate.
+func (i *impl) UpdateAd(req *UpdateAdRequest) (*Response, error) {
+	if req.ID == "" {
+		return nil, fmt.Errorf("id is required")
+	}
+	if req.ActionType != ActionModify && req.ActionType != ActionActivate {
+		return nil, fmt.Errorf("invalid actionType %q", req.ActionType)
+	}
+	if err := validateAd(req.PriceType, req.Premium, req.Price, req.MinAmount, req.MaxAmount, req.Quantity, req.PaymentIDs); err != nil {
+		return nil, err
+	}
+	params, err := toParams(req)
+	if err != nil {
+		return nil, err
+	}
+	var updateResponse Response
+	if err := i.post("/v5/p2p/item/update", params, &updateResponse); err != nil {
+		return nil, fmt.Errorf("error updating ad: %w", err)
+	}
+	if updateResponse.RetCode != 0 {
+		return &updateResponse, fmt.Errorf("API returned error: %s", updateResponse.RetMsg)
+	}
+	return &updateResponse, nil
+}
+
+// CancelAd takes an ad offline. It can be relisted with UpdateAd and ActionActivate.
+func (i *impl) CancelAd(itemID string) (*Response, error) {
+	if itemID == "" {
+		return nil, fmt.Errorf("itemId is required")
+	}
+	var cancelResponse Response
+	if err := i.post("/v5/p2p/item/cancel", client.Params{"itemId": itemID}, &cancelResponse); err != nil {
+		return nil, fmt.Errorf("error cancelling ad: %w", err)
+	}
+	if cancelResponse.RetCode != 0 {
+		return &cancelResponse, fmt.Errorf("API returned error: %s", cancelResponse.RetMsg)
+	}
+	return &cancelResponse, nil
+}
+
+// GetOrders lists the orders of the account, newest first.
+func (i *impl) GetOrders(req *GetOrdersRequest) (*OrdersResponse, error) {
+	return i.getOrders("/v5/p2p/order/simplifyList", req)
+}
+
+// GetPendingOrders lists the orders that are waiting for a payment, a release or an appeal.
+func (i *impl) GetPendingOrders(req *GetOrdersRequest) (*OrdersResponse, error) {
+	return i.getOrders("/v5/p2p/order/pending/simplifyList", req)
+}
+
+func (i *impl) getOrders(path string, req *GetOrdersRequest) (*OrdersResponse, error) {
+	if req.Page < 1 || req.Size < 1 {
+		return nil, fmt.Errorf("page and size must be at least 1")
+	}
+	params, err := toParams(req)
+	if err != nil {
+		return nil, err
+	}
+	var ordersResponse OrdersResponse
+	if err := i.post(path, params, &ordersResponse); err != nil {
+		return nil, fmt.Errorf("error fetching orders: %w", err)
+	}
+	if ordersResponse.RetCode != 0 {
+		return &ordersResponse, fmt.Errorf("API returned error: %s", ordersResponse.RetMsg)
+	}
+	return &ordersResponse, nil
+}
+
+// GetOrder retrieves the details of an order, including the payment methods of the seller.
+func (i *impl) GetOrder(orderID string) (*OrderDetailResponse, error) {
+	if orderID == "" {
+		return nil, fmt.Errorf("orderId is required")
+	}
+	var orderResponse OrderDetailResponse
+	if err := i.post("/v5/p2p/order/info", client.Params{"orderId": orderID}, &orderResponse); err != nil {
+		return nil, fmt.Errorf("error fetching order: %w", err)
+	}
+	if orderResponse.RetCode != 0 {
+		return &orderResponse, fmt.Errorf("API returned error: %s", orderResponse.RetMsg)
+	}
+	return &orderResponse, nil
+}
+
+// MarkOrderPaid tells the seller the fiat payment has been made. Only call it once the payment has
+// actually been sent.
+func (i *impl) MarkOrderPaid(orderID, paymentType, paymentID string) (*Response, error) {
+	if orderID == "" || paymentType == "" || paymentID == "" {
+		return nil, fmt.Errorf("orderId, paymentType and paymentId are required")
+	}
+	params := client.Params{
+		"orderId":     orderID,
+		"paymentType": paymentType,
+		"paymentId":   paymentID,
+	}
+	var payResponse Response
+	if err := i.post("/v5/p2p/order/pay", params, &payResponse); err != nil {
+		return nil, fmt.Errorf("error marking order paid: %w", err)
+	}
+	if payResponse.RetCode != 0 {
+		return &payResponse, fmt.Errorf("API returned error: %s", payResponse.RetMsg)
+	}
+	return &payResponse, nil
+}
+
+// ReleaseOrder releases the tokens of an order to the buyer. Only call it once the fiat payment has
+// been received.
+func (i *impl) ReleaseOrder(orderID string) (*Response, error) {
+	if orderID == "" {
+		return nil, fmt.Errorf("orderId is required")
+	}
+	var releaseResponse Response
+	if err := i.post("/v5/p2p/order/finish", client.Params{"orderId": orderID}, &releaseResponse); err != nil {
+		return nil, fmt.Errorf("error releasing order: %w", err)
+	}
+	if releaseResponse.RetCode != 0 {
+		return &releaseResponse, fmt.Errorf("API returned error: %s", releaseResponse.RetMsg)
+	}
+	return &releaseResponse, nil
+}
+
+// SendMessage sends a chat message on an order.
+func (i *impl) SendMessage(req *SendMessageRequest) (*Response, error) {
+	if req.OrderID == "" || req.Message == "" {
+		return nil, fmt.Errorf("orderId and message are required")
+	}
+	switch req.ContentType {
+	case ContentTypeStr, ContentTypePic, ContentTypePdf, ContentTypeVideo:
+	default:
+		return nil, fmt.Errorf("invalid contentType %q", req.ContentType)
+	}
+	params, err := toParams(req)
+	if err != nil {
+		return nil, err
+	}
+	var messageResponse Response
+	if err := i.post("/v5/p2p/order/message/send", params, &messageResponse); err != nil {
+		return nil, fmt.Errorf("error sending message: %w", err)
+	}
+	if messageResponse.RetCode != 0 {
+		return &messageResponse, fmt.Errorf("API returned error: %s", messageResponse.RetMsg)
+	}
+	return &messageResponse, nil
+}
+
+// GetMessages lists the chat messages of an order.
+func (i *impl) GetMessages(orderID string, page, size int) (*MessagesResponse, error) {
+	if orderID == "" {
+		return nil, fmt.Errorf("orderId is required")
+	}
+	if page < 1 || size < 1 {
+		return nil, fmt.Errorf("page and size must be at least 1")
+	}
+	params := client.Params{
+		"orderId":     orderID,
+		"currentPage": fmt.Sprintf("%d", page),
+		"size":        fmt.Sprintf("%d", size),
+	}
+	var messagesResponse MessagesResponse
+	if err := i.post("/v5/p2p/order/message/listpage", params, &messagesResponse); err != nil {
+		return nil, fmt.Errorf("error fetching messages: %w", err)
+	}
+	if messagesResponse.RetCode != 0 {
+		return &messagesResponse, fmt.Errorf("API returned error: %s", messagesResponse.RetMsg)
+	}
+	return &messagesResponse, nil
+}
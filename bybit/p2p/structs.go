@@ -0,0 +1,278 @@
+package p2p
+
+// Sides of an ad, from the point of view of the advertiser.
+const (
+	SideBuy  = "0"
+	SideSell = "1"
+)
+
+// Price types of an ad.
+const (
+	PriceTypeFixed    = 0
+	PriceTypeFloating = 1
+)
+
+// Action types of an ad update.
+const (
+	ActionModify   = "MODIFY"
+	ActionActivate = "ACTIVE"
+)
+
+// Content types of a chat message.
+const (
+	ContentTypeStr   = "str"
+	ContentTypePic   = "pic"
+	ContentTypePdf   = "pdf"
+	ContentTypeVideo = "video"
+)
+
+// Order statuses.
+const (
+	OrderStatusWaitingBuyerPay      = 10
+	OrderStatusWaitingSellerRelease = 20
+	OrderStatusAppealing            = 30
+	OrderStatusCancelled            = 40
+	OrderStatusFinished             = 50
+)
+
+// BaseResponse is the response envelope of the P2P endpoints, which differs from the rest of V5.
+type BaseResponse struct {
+	RetCode int    `json:"ret_code"`
+	RetMsg  string `json:"ret_msg"`
+	ExtCode string `json:"ext_code"`
+	ExtInfo any    `json:"ext_info"`
+	TimeNow string `json:"time_now"`
+}
+
+// PaymentTerm is a payment method attached to an ad or an order.
+type PaymentTerm struct {
+	ID          string `json:"id"`
+	RealName    string `json:"realName"`
+	PaymentType int    `json:"paymentType"`
+	BankName    string `json:"bankName"`
+	BranchName  string `json:"branchName"`
+	AccountNo   string `json:"accountNo"`
+	Qrcode      string `json:"qrcode"`
+}
+
+// Ad is a P2P advertisement.
+type Ad struct {
+	ID                string        `json:"id"`
+	AccountID         string        `json:"accountId"`
+	UserID            string        `json:"userId"`
+	NickName          string        `json:"nickName"`
+	TokenID           string        `json:"tokenId"`
+	TokenName         string        `json:"tokenName"`
+	CurrencyID        string        `json:"currencyId"`
+	Side              int           `json:"side"`
+	PriceType         int           `json:"priceType"`
+	Price             string        `json:"price"`
+	Premium           string        `json:"premium"`
+	LastQuantity      string        `json:"lastQuantity"`
+	Quantity          string        `json:"quantity"`
+	FrozenQuantity    string        `json:"frozenQuantity"`
+	ExecutedQuantity  string        `json:"executedQuantity"`
+	MinAmount         string        `json:"minAmount"`
+	MaxAmount         string        `json:"maxAmount"`
+	Remark            string        `json:"remark"`
+	Status            int           `json:"status"` // 10 online, 20 offline, 30 completed
+	CreateDate        string        `json:"createDate"`
+	Payments          []string      `json:"payments"`
+	PaymentTerms      []PaymentTerm `json:"paymentTerms"`
+	OrderNum          int           `json:"orderNum"`
+	FinishNum         int           `json:"finishNum"`
+	RecentOrderNum    int           `json:"recentOrderNum"`
+	RecentExecuteRate int           `json:"recentExecuteRate"`
+	IsOnline          bool          `json:"isOnline"`
+	ItemType          string        `json:"itemType"`
+	PaymentPeriod     int           `json:"paymentPeriod"`
+}
+
+// GetOnlineAdsRequest represents the payload for listing the online ads of the market.
+type GetOnlineAdsRequest struct {
+	TokenID    string  `json:"tokenId"`        // Required: Token, e.g. USDT
+	CurrencyID string  `json:"currencyId"`     // Required: Fiat currency, e.g. EUR
+	Side       string  `json:"side"`           // Required: SideBuy or SideSell
+	Page       *string `json:"page,omitempty"` // Optional: Page number, 1 by default
+	Size       *string `json:"size,omitempty"` // Optional: Page size, 10 by default
+}
+
+// AdsResponse represents the response from listing ads.
+type AdsResponse struct {
+	BaseResponse
+	Result struct {
+		Count int  `json:"count"`
+		Items []Ad `json:"items"`
+	} `json:"result"`
+}
+
+// AdResponse represents the response from querying a single ad.
+type AdResponse struct {
+	BaseResponse
+	Result Ad `json:"result"`
+}
+
+// TradingPreferences restricts the counterparties that can trade with an ad.
+type TradingPreferences struct {
+	HasUnPostAd               int    `json:"hasUnPostAd"`
+	IsKyc                     int    `json:"isKyc"`
+	IsEmail                   int    `json:"isEmail"`
+	IsMobile                  int    `json:"isMobile"`
+	HasRegisterTime           int    `json:"hasRegisterTime"`
+	RegisterTimeThreshold     int    `json:"registerTimeThreshold"`
+	OrderFinishNumberDay30    int    `json:"orderFinishNumberDay30"`
+	CompleteRateDay30         string `json:"completeRateDay30"`
+	NationalLimit             string `json:"nationalLimit"`
+	HasOrderFinishNumberDay30 int    `json:"hasOrderFinishNumberDay30"`
+	HasCompleteRateDay30      int    `json:"hasCompleteRateDay30"`
+	HasNationalLimit          int    `json:"hasNationalLimit"`
+}
+
+// CreateAdRequest represents the payload for posting an ad.
+type CreateAdRequest struct {
+	TokenID              string             `json:"tokenId"`              // Required: Token, e.g. USDT
+	CurrencyID           string             `json:"currencyId"`           // Required: Fiat currency, e.g. EUR
+	Side                 string             `json:"side"`                 // Required: SideBuy or SideSell
+	PriceType            int                `json:"priceType"`            // Required: PriceTypeFixed or PriceTypeFloating
+	Premium              string             `json:"premium"`              // Required for PriceTypeFloating: Premium percentage
+	Price                string             `json:"price"`                // Required: Ad price
+	MinAmount            string             `json:"minAmount"`            // Required: Minimum order amount in fiat
+	MaxAmount            string             `json:"maxAmount"`            // Required: Maximum order amount in fiat
+	Remark               string             `json:"remark"`               // Optional: Ad terms shown to counterparties
+	TradingPreferenceSet TradingPreferences `json:"tradingPreferenceSet"` // Required: Counterparty restrictions
+	PaymentIDs           []string           `json:"paymentIds"`           // Required: Payment method IDs
+	Quantity             string             `json:"quantity"`             // Required: Token quantity
+	PaymentPeriod        string             `json:"paymentPeriod"`        // Required: Payment window in minutes
+	ItemType             string             `json:"itemType"`             // Required: "ORIGIN" or "BULK"
+}
+
+// UpdateAdRequest represents the payload for modifying or relisting an ad.
+type UpdateAdRequest struct {
+	ID                   string             `json:"id"`                   // Required: Ad ID
+	PriceType            int                `json:"priceType"`            // Required: PriceTypeFixed or PriceTypeFloating
+	Premium              string             `json:"premium"`              // Required for PriceTypeFloating: Premium percentage
+	Price                string             `json:"price"`                // Required: Ad price
+	MinAmount            string             `json:"minAmount"`            // Required: Minimum order amount in fiat
+	MaxAmount            string             `json:"maxAmount"`            // Required: Maximum order amount in fiat
+	Remark               string             `json:"remark"`               // Optional: Ad terms shown to counterparties
+	TradingPreferenceSet TradingPreferences `json:"tradingPreferenceSet"` // Required: Counterparty restrictions
+	PaymentIDs           []string           `json:"paymentIds"`           // Required: Payment method IDs
+	ActionType           string             `json:"actionType"`           // Required: ActionModify or ActionActivate
+	Quantity             string             `json:"quantity"`             // Required: Token quantity
+	PaymentPeriod        string             `json:"paymentPeriod"`        // Required: Payment window in minutes
+}
+
+// CreateAdResponse represents the response from posting an ad.
+type CreateAdResponse struct {
+	BaseResponse
+	Result struct {
+		ItemID            string `json:"itemId"`
+		SecurityRiskToken string `json:"securityRiskToken"`
+		RiskTokenType     string `json:"riskTokenType"`
+		RiskVersion       string `json:"riskVersion"`
+		NeedSecurityRisk  bool   `json:"needSecurityRisk"`
+	} `json:"result"`
+}
+
+// Response represents a response without a meaningful result.
+type Response struct {
+	BaseResponse
+	Result any `json:"result"`
+}
+
+// GetOrdersRequest represents the payload for listing orders.
+type GetOrdersRequest struct {
+	Page      int     `json:"page"`                // Required: Page number, starting from 1
+	Size      int     `json:"size"`                // Required: Page size
+	Status    *int    `json:"status,omitempty"`    // Optional: Order status, e.g. OrderStatusWaitingBuyerPay
+	BeginTime *string `json:"beginTime,omitempty"` // Optional: Begin timestamp in milliseconds
+	EndTime   *string `json:"endTime,omitempty"`   // Optional: End timestamp in milliseconds
+	TokenID   *string `json:"tokenId,omitempty"`   // Optional: Token, e.g. USDT
+	Side      []int   `json:"side,omitempty"`      // Optional: 0 buy, 1 sell
+}
+
+// Order is a P2P order.
+type Order struct {
+	ID                  string `json:"id"`
+	Side                int    `json:"side"`
+	TokenID             string `json:"tokenId"`
+	OrderType           string `json:"orderType"`
+	Amount              string `json:"amount"`
+	CurrencyID          string `json:"currencyId"`
+	Price               string `json:"price"`
+	NotifyTokenQuantity string `json:"notifyTokenQuantity"`
+	NotifyTokenID       string `json:"notifyTokenId"`
+	Fee                 string `json:"fee"`
+	TargetNickName      string `json:"targetNickName"`
+	TargetUserID        string `json:"targetUserId"`
+	Status              int    `json:"status"`
+	SelfUnreadMsgCount  string `json:"selfUnreadMsgCount"`
+	CreateDate          string `json:"createDate"`
+	TransferLastSeconds string `json:"transferLastSeconds"`
+	AppealLastSeconds   string `json:"appealLastSeconds"`
+	UserID              string `json:"userId"`
+	SellerRealName      string `json:"sellerRealName"`
+	BuyerRealName       string `json:"buyerRealName"`
+	UnreadMsgCount      string `json:"unreadMsgCount"`
+}
+
+// OrdersResponse represents the response from listing orders.
+type OrdersResponse struct {
+	BaseResponse
+	Result struct {
+		Count int     `json:"count"`
+		Items []Order `json:"items"`
+	} `json:"result"`
+}
+
+// OrderDetail is a P2P order including the payment details of the counterparty.
+type OrderDetail struct {
+	Order
+	ItemID           string        `json:"itemId"`
+	Quantity         string        `json:"quantity"`
+	PaymentType      int           `json:"paymentType"`
+	TransferDate     string        `json:"transferDate"`
+	PaymentTermList  []PaymentTerm `json:"paymentTermList"`
+	ConfirmedPayTerm PaymentTerm   `json:"confirmedPayTerm"`
+	Remark           string        `json:"remark"`
+	UpdateDate       string        `json:"updateDate"`
+}
+
+// OrderDetailResponse represents the response from querying an order.
+type OrderDetailResponse struct {
+	BaseResponse
+	Result OrderDetail `json:"result"`
+}
+
+// SendMessageRequest represents the payload for sending a chat message on an order.
+type SendMessageRequest struct {
+	OrderID     string  `json:"orderId"`            // Required: Order ID
+	Message     string  `json:"message"`            // Required: Text, or the URL of an uploaded file
+	ContentType string  `json:"contentType"`        // Required: ContentTypeStr, ContentTypePic, ContentTypePdf or ContentTypeVideo
+	MsgUUID     *string `json:"msgUuid,omitempty"`  // Optional: Customised message ID
+	FileName    *string `json:"fileName,omitempty"` // Optional: File name of an uploaded file
+}
+
+// Message is a chat message of an order.
+type Message struct {
+	ID          string `json:"id"`
+	Message     string `json:"message"`
+	UserID      string `json:"userId"`
+	MsgType     int    `json:"msgType"`
+	MsgCode     int    `json:"msgCode"`
+	CreateDate  string `json:"createDate"`
+	ContentType string `json:"contentType"`
+	OrderID     string `json:"orderId"`
+	MsgUUID     string `json:"msgUuid"`
+	NickName    string `json:"nickName"`
+	FileName    string `json:"fileName"`
+	AccountID   string `json:"accountId"`
+	IsRead      int    `json:"isRead"`
+	RoleType    string `json:"roleType"`
+}
+
+// MessagesResponse represents the response from listing the chat messages of an order.
+type MessagesResponse struct {
+	BaseResponse
+	Result []Message `json:"result"`
+}
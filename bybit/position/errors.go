@@ -0,0 +1,43 @@
+package position
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+)
+
+// ErrLeverageNotModified is returned by SetLeverage when the requested leverage is already set.
+// Callers applying a desired configuration can treat it as a no-op.
+var ErrLeverageNotModified = errors.New("leverage not modified")
+
+// ErrMarginModeNotModified is returned by SwitchMarginMode when the symbol is already in the requested mode.
+var ErrMarginModeNotModified = errors.New("margin mode not modified")
+
+// ErrPositionModeNotModified is returned by SwitchPositionMode when the requested mode is already active.
+var ErrPositionModeNotModified = errors.New("position mode not modified")
+
+// ErrRiskLimitNotModified is returned by SetRiskLimit when the position is already on the requested tier.
+var ErrRiskLimitNotModified = errors.New("risk limit not modified")
+
+// ErrTradingStopNotModified is returned by SetTradingStop when the TP/SL/trailing stop is unchanged.
+var ErrTradingStopNotModified = errors.New("trading stop not modified")
+
+// knownErrors maps retCodes that callers commonly want to match on to sentinel errors.
+var knownErrors = map[int]error{
+	34040:  ErrTradingStopNotModified,
+	110025: ErrPositionModeNotModified,
+	110026: ErrMarginModeNotModified,
+	110043: ErrLeverageNotModified,
+	110075: ErrRiskLimitNotModified,
+}
+
+// apiError converts a non-zero retCode into an error. Known codes wrap their sentinel as well as
+// the *client.APIError so both errors.Is and errors.As work on the result.
+func apiError(code int, msg string) error {
+	err := &client.APIError{Code: code, Msg: msg}
+	if sentinel, ok := knownErrors[code]; ok {
+		return fmt.Errorf("%w: %w", sentinel, err)
+	}
+	return err
+}
@@ -0,0 +1,22 @@
+package position
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIError(t *testing.T) {
+	err := apiError(110043, "Set leverage not modified")
+	assert.ErrorIs(t, err, ErrLeverageNotModified)
+
+	var apiErr *client.APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, 110043, apiErr.Code)
+
+	err = apiError(10001, "params error")
+	assert.NotErrorIs(t, err, ErrLeverageNotModified)
+	assert.True(t, errors.As(err, &apiErr))
+}
@@ -80,7 +80,7 @@ func ConvertSetTPSLModeRequestToParams(req *SetTPSLModeRequest) client.Params {
 	}
 
 	if req.TPSLMode != nil {
-		params["tpslMode"] = *req.TPSLMode
+		params["tpSlMode"] = *req.TPSLMode
 	}
 	return params
 }
@@ -96,7 +96,7 @@ func ConvertSwitchPositionModeRequestToParams(req *SwitchPositionModeRequest) cl
 		params["coin"] = *req.Coin
 	}
 	if req.Mode != nil {
-		params["positionMode"] = *req.Mode
+		params["mode"] = strconv.Itoa(*req.Mode)
 	}
 	return params
 }
@@ -175,9 +175,8 @@ func ConvertSetTradingStopRequestToParams(req *SetTradingStopRequest) client.Par
 	if req.SlOrderType != nil {
 		params["slOrderType"] = *req.SlOrderType
 	}
-	if req.PositionIdx > 0 {
-		params["positionIdx"] = strconv.Itoa(req.PositionIdx)
-	}
+	// positionIdx 0 (one-way mode) is a valid value and must be sent as well.
+	params["positionIdx"] = strconv.Itoa(req.PositionIdx)
 	return params
 }
 func ConvertSetAutoAddMarginRequestToParams(req *SetAutoAddMarginRequest) client.Params {
@@ -188,9 +187,8 @@ func ConvertSetAutoAddMarginRequestToParams(req *SetAutoAddMarginRequest) client
 	if req.Symbol != "" {
 		params["symbol"] = req.Symbol
 	}
-	if req.AutoAddMargin > 0 {
-		params["autoAddMargin"] = strconv.Itoa(req.AutoAddMargin)
-	}
+	// 0 switches auto-add-margin off, so it is always sent.
+	params["autoAddMargin"] = strconv.Itoa(req.AutoAddMargin)
 	if req.PositionIdx != nil {
 		params["positionIdx"] = strconv.Itoa(*req.PositionIdx)
 	}
@@ -205,7 +203,7 @@ func ConvertAddReduceMarginRequestToParams(req *AddReduceMarginRequest) client.P
 		params["symbol"] = req.Symbol
 	}
 	if req.Margin != "" {
-		params["autoAddMargin"] = req.Margin
+		params["margin"] = req.Margin
 	}
 	if req.PositionIdx != nil {
 		params["positionIdx"] = strconv.Itoa(*req.PositionIdx)
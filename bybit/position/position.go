@@ -1,9 +1,7 @@
 package position
 
 import (
-	"encoding/json"
 	"fmt"
-	"strconv"
 
 	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
 )
@@ -60,9 +58,9 @@ type Position interface {
 
 	// AddOrReduceMargin manually adds or reduces margin for an isolated margin position.
 	// req: AddReduceMarginRequest - the request containing add/reduce margin settings.
-	// returns: *Response - the response after adding or reducing margin.
+	// returns: *AddReduceMarginResponse - the updated position.
 	//          error - an error if the request fails.
-	AddOrReduceMargin(req *AddReduceMarginRequest) (*Response, error)
+	AddOrReduceMargin(req *AddReduceMarginRequest) (*AddReduceMarginResponse, error)
 
 	// MovePositions transfers positions between UIDs.
 	// req: MovePositionRequest - the request containing move position settings.
@@ -82,6 +80,13 @@ type Position interface {
 	//          error - an error if the request fails.
 	ConfirmNewRiskLimit(req *ConfirmNewRiskLimitRequest) (*Response, error)
 	GetClosedPnLup2Years(req *GetClosedPnLRequest) (*ClosedPnLResponse, error)
+
+	// GetClosedPnL retrieves all closed PnL records in the requested time range, following cursors
+	// and splitting ranges longer than seven days into several queries.
+	// req: GetClosedPnLRequest - the category, optional symbol and time range.
+	// returns: *ClosedPnLResponse - the merged closed PnL records.
+	//          error - an error if any request fails.
+	GetClosedPnL(req *GetClosedPnLRequest) (*ClosedPnLResponse, error)
 }
 type impl struct {
 	client *client.Client
@@ -92,49 +97,82 @@ func New(c *client.Client) Position {
 	return &impl{client: c}
 }
 
-// GetPositionInfo fetches position information from Bybit.
+// GetPositionInfo fetches position information from Bybit. When no cursor is supplied every
+// page is fetched and the positions are merged into a single response.
 func (i *impl) GetPositionInfo(params *RequestParams) (*Response, error) {
-	requestParams := ConvertPositionRequestParams(params)
-	response, err := i.client.Get("/v5/position/list", requestParams)
-	if err != nil {
-		return nil, fmt.Errorf("error fetching position info: %w", err)
+	if params.Category == "" {
+		return nil, fmt.Errorf("category is required")
 	}
-
-	// Parse the JSON response
-	var positionResponse Response
-	err = response.Unmarshal(&positionResponse)
-	if err != nil {
-		return nil, err
+	if params.Symbol == "" && params.BaseCoin == nil && params.SettleCoin == nil {
+		return nil, fmt.Errorf("one of symbol, baseCoin or settleCoin is required")
 	}
 
-	return &positionResponse, nil
+	followCursor := params.Cursor == nil
+	page := *params
+	var merged *Response
+	for {
+		response, err := i.client.Get("/v5/position/list", ConvertPositionRequestParams(&page))
+		if err != nil {
+			return nil, fmt.Errorf("error fetching position info: %w", err)
+		}
+
+		var positionResponse Response
+		if err := response.Unmarshal(&positionResponse); err != nil {
+			return nil, fmt.Errorf("error parsing position info response: %w", err)
+		}
+		if positionResponse.RetCode != 0 {
+			return &positionResponse, apiError(positionResponse.RetCode, positionResponse.RetMsg)
+		}
+
+		if merged == nil {
+			merged = &positionResponse
+		} else {
+			merged.Result.List = append(merged.Result.List, positionResponse.Result.List...)
+			merged.Result.NextPageCursor = positionResponse.Result.NextPageCursor
+			merged.Time = positionResponse.Time
+		}
+		if !followCursor || positionResponse.Result.NextPageCursor == "" {
+			break
+		}
+		cursor := positionResponse.Result.NextPageCursor
+		page.Cursor = &cursor
+	}
+	return merged, nil
 }
 
-// SetLeverage sets the leverage for a given symbol and account type.
+// SetLeverage sets the buy and sell leverage for a symbol. When the leverage is already at the
+// requested value the returned error wraps ErrLeverageNotModified.
 func (i *impl) SetLeverage(req *SetLeverageRequest) (*Response, error) {
+	if req.Category == nil || req.Symbol == nil || req.BuyLeverage == nil || req.SellLeverage == nil {
+		return nil, fmt.Errorf("category, symbol, buyLeverage and sellLeverage are required")
+	}
 	params := ConvertSetLeverageRequestToParams(req)
 	// Perform the POST request
 	response, err := i.client.Post("/v5/position/set-leverage", params)
 	if err != nil {
 		return nil, fmt.Errorf("error setting leverage: %w", err)
 	}
-	data, err := json.Marshal(response)
-	if err != nil {
-		return nil, err
-	}
 	var apiResponse Response
-	if err := json.Unmarshal(data, &apiResponse); err != nil {
+	if err := response.Unmarshal(&apiResponse); err != nil {
 		return nil, fmt.Errorf("error parsing response: %w", err)
 	}
 	if apiResponse.RetCode != 0 {
-		return nil, fmt.Errorf("API returned error: %s", apiResponse.RetMsg)
+		return &apiResponse, apiError(apiResponse.RetCode, apiResponse.RetMsg)
 	}
 
 	return &apiResponse, nil
 }
 
 // SwitchMarginMode switches between cross-margin mode and isolated margin mode for a symbol.
+// Bybit requires the buy and sell leverage to be sent along with the new mode. When the symbol is
+// already in the requested mode the returned error wraps ErrMarginModeNotModified.
 func (i *impl) SwitchMarginMode(req *SwitchMarginModeRequest) (*Response, error) {
+	if req.Category == nil || req.Symbol == nil || req.TradeMode == nil {
+		return nil, fmt.Errorf("category, symbol and tradeMode are required")
+	}
+	if req.BuyLeverage == nil || req.SellLeverage == nil {
+		return nil, fmt.Errorf("buyLeverage and sellLeverage are required")
+	}
 	// Convert payload to Params type expected by the client.Post method
 	params := ConvertSwitchMarginModeRequestToParams(req)
 	// Perform the POST request
@@ -142,60 +180,74 @@ func (i *impl) SwitchMarginMode(req *SwitchMarginModeRequest) (*Response, error)
 	if err != nil {
 		return nil, fmt.Errorf("error switching margin mode: %w", err)
 	}
-	data, err := json.Marshal(response)
-	if err != nil {
-		return nil, err
-	}
-	// Optionally, check the response.RetCode here and handle any errors
 	var apiResponse Response
-	if err := json.Unmarshal(data, &apiResponse); err != nil {
+	if err := response.Unmarshal(&apiResponse); err != nil {
 		return nil, fmt.Errorf("error parsing response: %w", err)
 	}
 	if apiResponse.RetCode != 0 {
-		return nil, fmt.Errorf("API returned error: %s", apiResponse.RetMsg)
+		return &apiResponse, apiError(apiResponse.RetCode, apiResponse.RetMsg)
 	}
 
 	return &apiResponse, nil
 }
+
+// SetTPSLMode sets the default Take Profit/Stop Loss mode for a symbol. In TPSLModeFull the TP/SL
+// applies to the whole position; TPSLModePartial allows several partial TP/SL orders.
 func (i *impl) SetTPSLMode(req *SetTPSLModeRequest) (*Response, error) {
+	if req.Category == nil || req.Symbol == nil || req.TPSLMode == nil {
+		return nil, fmt.Errorf("category, symbol and tpSlMode are required")
+	}
 	params := ConvertSetTPSLModeRequestToParams(req)
 	// Perform the POST request
 	response, err := i.client.Post("/v5/position/set-tpsl-mode", params)
 	if err != nil {
 		return nil, fmt.Errorf("error setting TP/SL mode: %w", err)
 	}
-	data, err := json.Marshal(response)
-	if err != nil {
-		return nil, err
-	}
 	// Parse the JSON response
 	var positionResponse Response
-	if err := json.Unmarshal(data, &positionResponse); err != nil {
+	if err := response.Unmarshal(&positionResponse); err != nil {
 		return nil, fmt.Errorf("error parsing TP/SL mode response: %w", err)
 	}
+	if positionResponse.RetCode != 0 {
+		return &positionResponse, apiError(positionResponse.RetCode, positionResponse.RetMsg)
+	}
 
 	return &positionResponse, nil
 }
+
+// SwitchPositionMode switches between one-way (merged single) and hedge (both sides) mode for
+// linear and inverse contracts, either for one symbol or for every symbol settled in a coin.
+// The position mode decides which positionIdx values orders must carry.
 func (i *impl) SwitchPositionMode(req *SwitchPositionModeRequest) (*Response, error) {
+	if req.Category == "" || req.Mode == nil {
+		return nil, fmt.Errorf("category and mode are required")
+	}
+	if req.Symbol == nil && req.Coin == nil {
+		return nil, fmt.Errorf("either symbol or coin is required")
+	}
 	params := ConvertSwitchPositionModeRequestToParams(req)
 	// Perform the POST request
 	response, err := i.client.Post("/v5/position/switch-mode", params)
 	if err != nil {
 		return nil, fmt.Errorf("error switching position mode: %w", err)
 	}
-	data, err := json.Marshal(response)
-	if err != nil {
-		return nil, err
-	}
 	// Parse the JSON response
 	var positionResponse Response
-	if err := json.Unmarshal(data, &positionResponse); err != nil {
+	if err := response.Unmarshal(&positionResponse); err != nil {
 		return nil, fmt.Errorf("error parsing switch position mode response: %w", err)
 	}
+	if positionResponse.RetCode != 0 {
+		return &positionResponse, apiError(positionResponse.RetCode, positionResponse.RetMsg)
+	}
 	return &positionResponse, nil
 }
 
+// SetRiskLimit moves a position to another risk limit tier. RiskIDForValue can be used to pick the
+// tier from market.GetRiskLimit before scaling into a larger position.
 func (i *impl) SetRiskLimit(req *SetRiskLimitRequest) (*Response, error) {
+	if req.Category == "" || req.Symbol == "" || req.RiskID <= 0 {
+		return nil, fmt.Errorf("category, symbol and riskId are required")
+	}
 	params := ConvertSetRiskLimitRequestToParams(req)
 
 	// Perform the POST request
@@ -203,94 +255,165 @@ func (i *impl) SetRiskLimit(req *SetRiskLimitRequest) (*Response, error) {
 	if err != nil {
 		return nil, fmt.Errorf("error setting risk limit: %w", err)
 	}
-	data, err := json.Marshal(response)
-	if err != nil {
-		return nil, err
-	}
 	// Parse the JSON response
 	var positionResponse Response
-	if err := json.Unmarshal(data, &positionResponse); err != nil {
+	if err := response.Unmarshal(&positionResponse); err != nil {
 		return nil, fmt.Errorf("error parsing set risk limit response: %w", err)
 	}
+	if positionResponse.RetCode != 0 {
+		return &positionResponse, apiError(positionResponse.RetCode, positionResponse.RetMsg)
+	}
 
 	return &positionResponse, nil
 }
 
+// SetTradingStop sets the take profit, stop loss and trailing stop of an existing position.
+// Passing "0" for any of them cancels it. In TPSLModePartial the tpSize and slSize fields
+// restrict the TP/SL to part of the position, optionally as limit orders.
 func (i *impl) SetTradingStop(req *SetTradingStopRequest) (*Response, error) {
+	if req.Category == "" || req.Symbol == "" || req.TPSLMode == "" {
+		return nil, fmt.Errorf("category, symbol and tpslMode are required")
+	}
+	if req.TPSLMode == TPSLModePartial && ((req.TakeProfit != nil && req.TpSize == nil) || (req.StopLoss != nil && req.SlSize == nil)) {
+		return nil, fmt.Errorf("tpSize and slSize are required in Partial mode")
+	}
 	params := ConvertSetTradingStopRequestToParams(req)
 
 	response, err := i.client.Post("/v5/position/trading-stop", params)
 	if err != nil {
 		return nil, fmt.Errorf("error setting trading stop: %w", err)
 	}
-	data, err := json.Marshal(response)
-	if err != nil {
-		return nil, err
-	}
 	var positionResponse Response
-	if err := json.Unmarshal(data, &positionResponse); err != nil {
+	if err := response.Unmarshal(&positionResponse); err != nil {
 		return nil, fmt.Errorf("error parsing set trading stop response: %w", err)
 	}
+	if positionResponse.RetCode != 0 {
+		return &positionResponse, apiError(positionResponse.RetCode, positionResponse.RetMsg)
+	}
 
 	return &positionResponse, nil
 }
+
+// SetAutoAddMargin turns auto-add-margin on or off for an isolated margin position. In hedge mode
+// PositionIdx selects the buy or sell side.
 func (i *impl) SetAutoAddMargin(req *SetAutoAddMarginRequest) (*Response, error) {
+	if req.Category == "" || req.Symbol == "" {
+		return nil, fmt.Errorf("category and symbol are required")
+	}
+	if req.AutoAddMargin != 0 && req.AutoAddMargin != 1 {
+		return nil, fmt.Errorf("autoAddMargin must be 0 or 1")
+	}
 	params := ConvertSetAutoAddMarginRequestToParams(req)
 	// Perform the POST request
 	response, err := i.client.Post("/v5/position/set-auto-add-margin", params)
 	if err != nil {
 		return nil, fmt.Errorf("error setting auto add margin: %w", err)
 	}
-	data, err := json.Marshal(response)
-	if err != nil {
-		return nil, err
-	}
 	// Parse the JSON response
 	var positionResponse Response
-	if err := json.Unmarshal(data, &positionResponse); err != nil {
+	if err := response.Unmarshal(&positionResponse); err != nil {
 		return nil, fmt.Errorf("error parsing set auto add margin response: %w", err)
 	}
+	if positionResponse.RetCode != 0 {
+		return &positionResponse, apiError(positionResponse.RetCode, positionResponse.RetMsg)
+	}
 
 	return &positionResponse, nil
 }
-func (i *impl) AddOrReduceMargin(req *AddReduceMarginRequest) (*Response, error) {
+
+// AddOrReduceMargin adds margin to (positive amount) or removes margin from (negative amount) an
+// isolated margin position and returns the updated position.
+func (i *impl) AddOrReduceMargin(req *AddReduceMarginRequest) (*AddReduceMarginResponse, error) {
+	if req.Category == "" || req.Symbol == "" || req.Margin == "" {
+		return nil, fmt.Errorf("category, symbol and margin are required")
+	}
 	params := ConvertAddReduceMarginRequestToParams(req)
 	// Perform the POST request
 	response, err := i.client.Post("/v5/position/add-margin", params)
 	if err != nil {
 		return nil, fmt.Errorf("error adding or reducing margin: %w", err)
 	}
-	data, err := json.Marshal(response)
-	if err != nil {
-		return nil, err
-	}
 	// Parse the JSON response
-	var positionResponse Response
-	if err := json.Unmarshal(data, &positionResponse); err != nil {
+	var marginResponse AddReduceMarginResponse
+	if err := response.Unmarshal(&marginResponse); err != nil {
 		return nil, fmt.Errorf("error parsing add or reduce margin response: %w", err)
 	}
+	if marginResponse.RetCode != 0 {
+		return &marginResponse, apiError(marginResponse.RetCode, marginResponse.RetMsg)
+	}
 
-	return &positionResponse, nil
+	return &marginResponse, nil
 }
 
-// GetClosedPnLup2Years retrieves closed PnL data with pagination controlled by the user.
+// GetClosedPnLup2Years retrieves a single page of closed PnL data with pagination controlled by the user.
 func (i *impl) GetClosedPnLup2Years(req *GetClosedPnLRequest) (*ClosedPnLResponse, error) {
-	params := map[string]any{
-		"category": req.Category,
-		"limit":    req.Limit,
-	}
-	if req.StartTime != nil {
-		params["startTime"] = strconv.FormatInt(*req.StartTime, 10)
+	if req.Category == "" {
+		return nil, fmt.Errorf("category is required")
 	}
-	if req.EndTime != nil {
-		params["endTime"] = strconv.FormatInt(*req.EndTime, 10)
+	return i.getClosedPnLPage(req)
+}
+
+// GetClosedPnL retrieves closed PnL records. When no cursor is supplied every page is fetched, and a
+// startTime/endTime range longer than ClosedPnLWindow is split into consecutive windows, newest first,
+// since Bybit only accepts ranges of up to seven days per query. A startTime without an endTime
+// ranges up to now.
+func (i *impl) GetClosedPnL(req *GetClosedPnLRequest) (*ClosedPnLResponse, error) {
+	if req.Category == "" {
+		return nil, fmt.Errorf("category is required")
 	}
 	if req.Cursor != nil {
-		params["cursor"] = req.Cursor
+		return i.getClosedPnLPage(req)
+	}
+
+	window := ClosedPnLWindow.Milliseconds()
+	var merged *ClosedPnLResponse
+	page := *req
+	if page.StartTime != nil && page.EndTime == nil {
+		now := client.GetCurrentTime()
+		page.EndTime = &now
+	}
+	windowed := page.StartTime != nil && page.EndTime != nil
+	for {
+		if windowed {
+			windowStart := *page.EndTime - window
+			if windowStart < *req.StartTime {
+				windowStart = *req.StartTime
+			}
+			page.StartTime = &windowStart
+		}
+
+		for {
+			response, err := i.getClosedPnLPage(&page)
+			if err != nil {
+				return response, err
+			}
+			if merged == nil {
+				merged = response
+			} else {
+				merged.Result.List = append(merged.Result.List, response.Result.List...)
+				merged.Time = response.Time
+			}
+			if response.Result.NextPageCursor == "" {
+				break
+			}
+			cursor := response.Result.NextPageCursor
+			page.Cursor = &cursor
+		}
+		page.Cursor = nil
+
+		if !windowed || *page.StartTime <= *req.StartTime {
+			break
+		}
+		windowEnd := *page.StartTime - 1
+		page.EndTime = &windowEnd
 	}
+	merged.Result.NextPageCursor = ""
+	return merged, nil
+}
 
+func (i *impl) getClosedPnLPage(req *GetClosedPnLRequest) (*ClosedPnLResponse, error) {
 	// Perform the API GET request
-	responseData, err := i.client.Get("/v5/position/closed-pnl", params)
+	responseData, err := i.client.Get("/v5/position/closed-pnl", ConvertGetClosedPnLRequestToParams(req))
 	if err != nil {
 		return nil, fmt.Errorf("error fetching closed PnL records: %w", err)
 	}
@@ -301,73 +424,84 @@ func (i *impl) GetClosedPnLup2Years(req *GetClosedPnLRequest) (*ClosedPnLRespons
 	if err != nil {
 		return nil, fmt.Errorf("error unmarshalling closed PnL response: %w", err)
 	}
+	if response.RetCode != 0 {
+		return &response, apiError(response.RetCode, response.RetMsg)
+	}
 
 	return &response, nil
 }
 
+// MovePositions transfers positions between UIDs of the same master account as a block trade.
+// Up to MaxMovePositionLegs legs can be moved in one request.
 func (i *impl) MovePositions(req *MovePositionRequest) (*MovePositionResponse, error) {
+	if req.FromUID == "" || req.ToUID == "" {
+		return nil, fmt.Errorf("fromUid and toUid are required")
+	}
+	if len(req.List) == 0 || len(req.List) > MaxMovePositionLegs {
+		return nil, fmt.Errorf("list must contain between 1 and %d positions", MaxMovePositionLegs)
+	}
 	params := ConvertMovePositionRequestToParams(req)
 	// Perform the POST request
 	response, err := i.client.Post("/v5/position/move-positions", params)
 	if err != nil {
 		return nil, fmt.Errorf("error moving positions: %w", err)
 	}
-	data, err := json.Marshal(response)
-	if err != nil {
-		return nil, err
-	}
 	var movePositionResponse MovePositionResponse
-	if err := json.Unmarshal(data, &movePositionResponse); err != nil {
+	if err := response.Unmarshal(&movePositionResponse); err != nil {
 		return nil, fmt.Errorf("error parsing move position response: %w", err)
 	}
+	if movePositionResponse.RetCode != 0 {
+		return &movePositionResponse, apiError(movePositionResponse.RetCode, movePositionResponse.RetMsg)
+	}
 
 	return &movePositionResponse, nil
 }
+
+// GetMovePositionHistory queries moved positions. When no cursor is supplied every page is fetched
+// and the entries are merged into a single response.
 func (i *impl) GetMovePositionHistory(req *GetMovePositionHistoryRequest) (*GetMovePositionHistoryResponse, error) {
-	var allEntries []MovePositionHistoryEntry
-	var finalResponse GetMovePositionHistoryResponse
+	followCursor := req.Cursor == nil
+	page := *req
+	var merged *GetMovePositionHistoryResponse
 
 	for {
-		// Construct query parameters
-		params := ConvertGetMovePositionHistoryRequestToParams(req)
-
 		// Perform the GET request
-		response, err := i.client.Get("/v5/position/move-history", params)
+		response, err := i.client.Get("/v5/position/move-history", ConvertGetMovePositionHistoryRequestToParams(&page))
 		if err != nil {
 			return nil, fmt.Errorf("error fetching move position history: %w", err)
 		}
-		data, err := json.Marshal(response)
-		if err != nil {
-			return nil, err
-		}
 		// Parse the JSON response
 		var historyResponse GetMovePositionHistoryResponse
-		if err := json.Unmarshal(data, &historyResponse); err != nil {
+		if err := response.Unmarshal(&historyResponse); err != nil {
 			return nil, fmt.Errorf("error parsing move position history response: %w", err)
 		}
-
-		// Accumulate entries from this page
-		allEntries = append(allEntries, historyResponse.Result.List...)
-
-		// Check if there's a next page
-		if historyResponse.Result.NextPageCursor == "" {
-			break // Exit loop if there's no next page
+		if historyResponse.RetCode != 0 {
+			return &historyResponse, apiError(historyResponse.RetCode, historyResponse.RetMsg)
 		}
 
-		// Prepare for the next request
-		req.Cursor = &historyResponse.Result.NextPageCursor
-		// Prepare the final consolidated response
-		finalResponse.RetCode = historyResponse.RetCode
-		finalResponse.RetMsg = historyResponse.RetMsg
-		finalResponse.Result.NextPageCursor = historyResponse.Result.NextPageCursor
-		finalResponse.Result.List = allEntries
-		finalResponse.RetExtInfo = historyResponse.RetExtInfo
-		finalResponse.Time = historyResponse.Time
+		if merged == nil {
+			merged = &historyResponse
+		} else {
+			merged.Result.List = append(merged.Result.List, historyResponse.Result.List...)
+			merged.Result.NextPageCursor = historyResponse.Result.NextPageCursor
+			merged.Time = historyResponse.Time
+		}
+		if !followCursor || historyResponse.Result.NextPageCursor == "" {
+			break
+		}
+		cursor := historyResponse.Result.NextPageCursor
+		page.Cursor = &cursor
 	}
 
-	return &finalResponse, nil
+	return merged, nil
 }
+
+// ConfirmNewRiskLimit confirms the new risk limit of a position after a risk limit change, which
+// removes the reduceOnly mark from the position.
 func (i *impl) ConfirmNewRiskLimit(req *ConfirmNewRiskLimitRequest) (*Response, error) {
+	if req.Category == "" || req.Symbol == "" {
+		return nil, fmt.Errorf("category and symbol are required")
+	}
 	params := ConvertConfirmNewRiskLimitRequestToParams(req)
 
 	// Perform the POST request
@@ -375,15 +509,14 @@ func (i *impl) ConfirmNewRiskLimit(req *ConfirmNewRiskLimitRequest) (*Response,
 	if err != nil {
 		return nil, fmt.Errorf("error confirming new risk limit: %w", err)
 	}
-	data, err := json.Marshal(response)
-	if err != nil {
-		return nil, err
-	}
 	// Parse the JSON response
 	var positionResponse Response
-	if err := json.Unmarshal(data, &positionResponse); err != nil {
+	if err := response.Unmarshal(&positionResponse); err != nil {
 		return nil, fmt.Errorf("error parsing confirm new risk limit response: %w", err)
 	}
+	if positionResponse.RetCode != 0 {
+		return &positionResponse, apiError(positionResponse.RetCode, positionResponse.RetMsg)
+	}
 
 	return &positionResponse, nil
 }
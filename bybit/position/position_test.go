@@ -0,0 +1,76 @@
+package position
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetClosedPnLWindows(t *testing.T) {
+	var queries []url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		queries = append(queries, q)
+		// Every window holds one record at its end time; the first window has a second page.
+		list := []PnLPosition{{Symbol: "BTCUSDT", OrderID: q.Get("endTime") + q.Get("cursor")}}
+		cursor := ""
+		if len(queries) == 1 {
+			cursor = "page-2"
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"retCode": 0, "result": map[string]any{"list": list, "nextPageCursor": cursor}})
+	}))
+	defer server.Close()
+	p := New(client.NewClientWithBaseURL("key", "secret", server.URL))
+
+	window := ClosedPnLWindow.Milliseconds()
+	end := int64(1_700_000_000_000)
+	start := end - 2*window - 1000
+	res, err := p.GetClosedPnL(&GetClosedPnLRequest{Category: "linear", StartTime: &start, EndTime: &end})
+	require.NoError(t, err)
+
+	require.Len(t, queries, 4)
+	type span struct{ start, end, cursor string }
+	spans := make([]span, len(queries))
+	for i, q := range queries {
+		spans[i] = span{q.Get("startTime"), q.Get("endTime"), q.Get("cursor")}
+	}
+	format := func(ms int64) string { return strconv.FormatInt(ms, 10) }
+	assert.Equal(t, []span{
+		{format(end - window), format(end), ""},
+		{format(end - window), format(end), "page-2"},
+		{format(end - 2*window - 1), format(end - window - 1), ""},
+		{format(start), format(end - 2*window - 2), ""},
+	}, spans)
+	assert.Len(t, res.Result.List, 4)
+	assert.Empty(t, res.Result.NextPageCursor)
+}
+
+func TestGetClosedPnLStartTimeOnly(t *testing.T) {
+	var queries []url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Query())
+		_ = json.NewEncoder(w).Encode(map[string]any{"retCode": 0, "result": map[string]any{"list": []PnLPosition{}}})
+	}))
+	defer server.Close()
+	p := New(client.NewClientWithBaseURL("key", "secret", server.URL))
+
+	before := client.GetCurrentTime()
+	start := before - 10*ClosedPnLWindow.Milliseconds()/7
+	_, err := p.GetClosedPnL(&GetClosedPnLRequest{Category: "linear", StartTime: &start})
+	require.NoError(t, err)
+
+	// Ten days up to now take two windows.
+	require.Len(t, queries, 2)
+	end, err := strconv.ParseInt(queries[0].Get("endTime"), 10, 64)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, end, before)
+	assert.Equal(t, strconv.FormatInt(end-ClosedPnLWindow.Milliseconds(), 10), queries[0].Get("startTime"))
+	assert.Equal(t, strconv.FormatInt(start, 10), queries[1].Get("startTime"))
+}
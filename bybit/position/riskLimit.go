@@ -0,0 +1,34 @@
+package position
+
+import (
+	"fmt"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/market"
+	"github.com/cploutarchou/crypto-sdk-suite/decimal"
+)
+
+// RiskIDForValue picks the lowest risk limit tier of symbol whose riskLimitValue covers
+// positionValue. The tiers are usually the result list of market.GetRiskLimit.
+func RiskIDForValue(tiers []market.RiskLimitTier, symbol string, positionValue decimal.Decimal) (int, error) {
+	bestID := 0
+	var bestLimit decimal.Decimal
+	for _, tier := range tiers {
+		if tier.Symbol != symbol {
+			continue
+		}
+		limit, err := decimal.NewFromString(tier.RiskLimitValue)
+		if err != nil {
+			return 0, fmt.Errorf("error parsing risk limit value of tier %d: %w", tier.ID, err)
+		}
+		if limit.LessThan(positionValue) {
+			continue
+		}
+		if bestID == 0 || limit.LessThan(bestLimit) {
+			bestID, bestLimit = tier.ID, limit
+		}
+	}
+	if bestID == 0 {
+		return 0, fmt.Errorf("no risk limit tier of %s covers a position value of %s", symbol, positionValue)
+	}
+	return bestID, nil
+}
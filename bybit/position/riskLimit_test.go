@@ -0,0 +1,29 @@
+package position
+
+import (
+	"testing"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/market"
+	"github.com/cploutarchou/crypto-sdk-suite/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRiskIDForValue(t *testing.T) {
+	tiers := []market.RiskLimitTier{
+		{ID: 3, Symbol: "BTCUSDT", RiskLimitValue: "6000000"},
+		{ID: 1, Symbol: "BTCUSDT", RiskLimitValue: "2000000"},
+		{ID: 2, Symbol: "BTCUSDT", RiskLimitValue: "4000000"},
+		{ID: 7, Symbol: "ETHUSDT", RiskLimitValue: "1000000"},
+	}
+
+	id, err := RiskIDForValue(tiers, "BTCUSDT", decimal.RequireFromString("2500000"))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, id)
+
+	id, err = RiskIDForValue(tiers, "BTCUSDT", decimal.RequireFromString("2000000"))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, id)
+
+	_, err = RiskIDForValue(tiers, "BTCUSDT", decimal.RequireFromString("7000000"))
+	assert.Error(t, err)
+}
@@ -1,13 +1,19 @@
 package position
 
+import (
+	"time"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/market"
+)
+
 // RequestParams represents the query parameters for fetching position information.
 type RequestParams struct {
-	Category   string  `json:"category"`
-	Symbol     string  `json:"symbol"`
-	BaseCoin   *string `json:"baseCoin"`
-	SettleCoin *string `json:"settleCoin"`
-	Limit      *int    `json:"limit"`
-	Cursor     *string `json:"cursor"`
+	Category   market.Category `json:"category"`   // Required: "linear", "inverse" or "option"
+	Symbol     string          `json:"symbol"`     // Optional: Symbol name; one of symbol, baseCoin or settleCoin is required
+	BaseCoin   *string         `json:"baseCoin"`   // Optional: Option only
+	SettleCoin *string         `json:"settleCoin"` // Optional: Settle coin, e.g. USDT
+	Limit      *int            `json:"limit"`      // Optional: Page size [1, 200]
+	Cursor     *string         `json:"cursor"`     // Optional: nextPageCursor of a previous page; disables automatic paging
 }
 
 // Response represents the response structure for position information.
@@ -15,9 +21,9 @@ type Response struct {
 	RetCode int    `json:"retCode"`
 	RetMsg  string `json:"retMsg"`
 	Result  struct {
-		List           []Details `json:"list"`
-		NextPageCursor string    `json:"nextPageCursor"`
-		Category       string    `json:"category"`
+		List           []Details       `json:"list"`
+		NextPageCursor string          `json:"nextPageCursor"`
+		Category       market.Category `json:"category"`
 	} `json:"result"`
 	RetExtInfo any   `json:"retExtInfo"`
 	Time       int64 `json:"time"`
@@ -48,7 +54,13 @@ type Details struct {
 	TakeProfit             string `json:"takeProfit"`
 	StopLoss               string `json:"stopLoss"`
 	TrailingStop           string `json:"trailingStop"`
+	SessionAvgPrice        string `json:"sessionAvgPrice"`
+	Delta                  string `json:"delta"`
+	Gamma                  string `json:"gamma"`
+	Vega                   string `json:"vega"`
+	Theta                  string `json:"theta"`
 	UnrealisedPnl          string `json:"unrealisedPnl"`
+	CurRealisedPnl         string `json:"curRealisedPnl"`
 	CumRealisedPnl         string `json:"cumRealisedPnl"`
 	Seq                    int64  `json:"seq"`
 	IsReduceOnly           bool   `json:"isReduceOnly"`
@@ -60,96 +72,130 @@ type Details struct {
 
 // SetLeverageRequest represents the payload for setting leverage.
 type SetLeverageRequest struct {
-	Category     *string `json:"category"`
-	Symbol       *string `json:"symbol"`
-	BuyLeverage  *string `json:"buyLeverage"`
-	SellLeverage *string `json:"sellLeverage"`
+	Category     *market.Category `json:"category"`
+	Symbol       *string          `json:"symbol"`
+	BuyLeverage  *string          `json:"buyLeverage"`
+	SellLeverage *string          `json:"sellLeverage"`
 }
 
+// Trade modes accepted by SwitchMarginMode.
+const (
+	TradeModeCross    = 0
+	TradeModeIsolated = 1
+)
+
+// SwitchMarginModeRequest represents the payload for switching between cross and isolated margin.
 type SwitchMarginModeRequest struct {
-	Category     *string `json:"category"`
-	Symbol       *string `json:"symbol"`
-	TradeMode    *int    `json:"tradeMode"`
-	BuyLeverage  *string `json:"buyLeverage"`
-	SellLeverage *string `json:"sellLeverage"`
+	Category     *market.Category `json:"category"`     // Required: "linear" or "inverse"
+	Symbol       *string          `json:"symbol"`       // Required: Symbol name
+	TradeMode    *int             `json:"tradeMode"`    // Required: TradeModeCross or TradeModeIsolated
+	BuyLeverage  *string          `json:"buyLeverage"`  // Required: Buy leverage to apply with the new mode
+	SellLeverage *string          `json:"sellLeverage"` // Required: Sell leverage to apply with the new mode
 }
 
+// TP/SL modes accepted by SetTPSLMode and SetTradingStop.
+const (
+	TPSLModeFull    = "Full"
+	TPSLModePartial = "Partial"
+)
+
 // SetTPSLModeRequest represents the payload for setting the TP/SL mode.
 type SetTPSLModeRequest struct {
-	Category *string `json:"category"`
-	Symbol   *string `json:"symbol"`
-	TPSLMode *string `json:"tpSlMode"` // "Full" or "Partial"
+	Category *market.Category `json:"category"`
+	Symbol   *string          `json:"symbol"`
+	TPSLMode *string          `json:"tpSlMode"` // TPSLModeFull or TPSLModePartial
 }
 
+// Position modes accepted by SwitchPositionMode.
+const (
+	PositionModeMergedSingle = 0 // One-way mode, orders use positionIdx 0
+	PositionModeBothSides    = 3 // Hedge mode, orders use positionIdx 1 (buy) or 2 (sell)
+)
+
 // SwitchPositionModeRequest represents the payload for switching the position mode.
 type SwitchPositionModeRequest struct {
-	Category string  `json:"category"`         // Required: "linear" for USDT Perp, "inverse" for Inverse Futures
-	Symbol   *string `json:"symbol,omitempty"` // Optional: Symbol name; either symbol or coin is required
-	Coin     *string `json:"coin,omitempty"`   // Optional: Coin; either symbol or coin is required
-	Mode     *int    `json:"mode"`             // Required: 0 for Merged Single, 3 for Both Sides
+	Category market.Category `json:"category"`         // Required: "linear" for USDT Perp, "inverse" for Inverse Futures
+	Symbol   *string         `json:"symbol,omitempty"` // Optional: Symbol name; either symbol or coin is required
+	Coin     *string         `json:"coin,omitempty"`   // Optional: Coin; either symbol or coin is required
+	Mode     *int            `json:"mode"`             // Required: PositionModeMergedSingle or PositionModeBothSides
 }
 
 // SetRiskLimitRequest represents the payload for setting the risk limit of a position.
 type SetRiskLimitRequest struct {
-	Category    string `json:"category"`    // Required: "linear" or "inverse"
-	Symbol      string `json:"symbol"`      // Required: Symbol name
-	RiskID      int    `json:"riskId"`      // Required: Risk limit ID
-	PositionIdx *int   `json:"positionIdx"` // Optional: Position index (for hedge mode)
+	Category    market.Category `json:"category"`    // Required: "linear" or "inverse"
+	Symbol      string          `json:"symbol"`      // Required: Symbol name
+	RiskID      int             `json:"riskId"`      // Required: Risk limit ID
+	PositionIdx *int            `json:"positionIdx"` // Optional: Position index (for hedge mode)
 }
 
 // SetTradingStopRequest represents the payload for setting trading stops (TP, SL, TS).
 type SetTradingStopRequest struct {
-	Category     string  `json:"category"`               // Required
-	Symbol       string  `json:"symbol"`                 // Required
-	TakeProfit   *string `json:"takeProfit,omitempty"`   // Optional, 0 to cancel
-	StopLoss     *string `json:"stopLoss,omitempty"`     // Optional, 0 to cancel
-	TrailingStop *string `json:"trailingStop,omitempty"` // Optional, 0 to cancel
-	TpTriggerBy  *string `json:"tpTriggerBy,omitempty"`  // Optional
-	SlTriggerBy  *string `json:"slTriggerBy,omitempty"`  // Optional
-	ActivePrice  *string `json:"activePrice,omitempty"`  // Optional
-	TPSLMode     string  `json:"tpslMode"`               // Required
-	TpSize       *string `json:"tpSize,omitempty"`       // Optional
-	SlSize       *string `json:"slSize,omitempty"`       // Optional
-	TpLimitPrice *string `json:"tpLimitPrice,omitempty"` // Optional
-	SlLimitPrice *string `json:"slLimitPrice,omitempty"` // Optional
-	TpOrderType  *string `json:"tpOrderType,omitempty"`  // Optional
-	SlOrderType  *string `json:"slOrderType,omitempty"`  // Optional
-	PositionIdx  int     `json:"positionIdx"`            // Required
+	Category     market.Category `json:"category"`               // Required
+	Symbol       string          `json:"symbol"`                 // Required
+	TakeProfit   *string         `json:"takeProfit,omitempty"`   // Optional, 0 to cancel
+	StopLoss     *string         `json:"stopLoss,omitempty"`     // Optional, 0 to cancel
+	TrailingStop *string         `json:"trailingStop,omitempty"` // Optional, 0 to cancel
+	TpTriggerBy  *string         `json:"tpTriggerBy,omitempty"`  // Optional
+	SlTriggerBy  *string         `json:"slTriggerBy,omitempty"`  // Optional
+	ActivePrice  *string         `json:"activePrice,omitempty"`  // Optional
+	TPSLMode     string          `json:"tpslMode"`               // Required: TPSLModeFull or TPSLModePartial
+	TpSize       *string         `json:"tpSize,omitempty"`       // Optional
+	SlSize       *string         `json:"slSize,omitempty"`       // Optional
+	TpLimitPrice *string         `json:"tpLimitPrice,omitempty"` // Optional
+	SlLimitPrice *string         `json:"slLimitPrice,omitempty"` // Optional
+	TpOrderType  *string         `json:"tpOrderType,omitempty"`  // Optional
+	SlOrderType  *string         `json:"slOrderType,omitempty"`  // Optional
+	PositionIdx  int             `json:"positionIdx"`            // Required: 0 one-way, 1 hedge buy, 2 hedge sell
 }
 
 // SetAutoAddMarginRequest represents the payload for toggling auto-add-margin.
 type SetAutoAddMarginRequest struct {
-	Category      string `json:"category"`              // Required: "linear" or "inverse"
-	Symbol        string `json:"symbol"`                // Required: Symbol name
-	AutoAddMargin int    `json:"autoAddMargin"`         // Required: 0 for off, 1 for on
-	PositionIdx   *int   `json:"positionIdx,omitempty"` // Optional: Position index for hedge mode
+	Category      market.Category `json:"category"`              // Required: "linear" or "inverse"
+	Symbol        string          `json:"symbol"`                // Required: Symbol name
+	AutoAddMargin int             `json:"autoAddMargin"`         // Required: 0 for off, 1 for on
+	PositionIdx   *int            `json:"positionIdx,omitempty"` // Optional: Position index for hedge mode
 }
 
 // AddReduceMarginRequest represents the payload for adding or reducing margin.
 type AddReduceMarginRequest struct {
-	Category    string `json:"category"`    // Required: "linear" or "inverse"
-	Symbol      string `json:"symbol"`      // Required: Symbol name
-	Margin      string `json:"margin"`      // Required: Amount to add (positive) or reduce (negative)
-	PositionIdx *int   `json:"positionIdx"` // Optional: Position index for hedge mode
+	Category    market.Category `json:"category"`    // Required: "linear" or "inverse"
+	Symbol      string          `json:"symbol"`      // Required: Symbol name
+	Margin      string          `json:"margin"`      // Required: Amount to add (positive) or reduce (negative)
+	PositionIdx *int            `json:"positionIdx"` // Optional: Position index for hedge mode
+}
+
+// AddReduceMarginResponse represents the response of AddOrReduceMargin, carrying the updated position.
+type AddReduceMarginResponse struct {
+	RetCode int    `json:"retCode"`
+	RetMsg  string `json:"retMsg"`
+	Result  struct {
+		Category market.Category `json:"category"`
+		Details
+	} `json:"result"`
+	RetExtInfo any   `json:"retExtInfo"`
+	Time       int64 `json:"time"`
 }
 
+// ClosedPnLWindow is the longest startTime/endTime range Bybit accepts in a single closed PnL query.
+const ClosedPnLWindow = 7 * 24 * time.Hour
+
 // GetClosedPnLRequest represents the query parameters for fetching closed PnL records.
 type GetClosedPnLRequest struct {
-	Category  string  `json:"category"`            // Required: "linear" or "inverse"
-	Symbol    *string `json:"symbol,omitempty"`    // Optional: Symbol name
-	StartTime *int64  `json:"startTime,omitempty"` // Optional: The start timestamp (ms)
-	EndTime   *int64  `json:"endTime,omitempty"`   // Optional: The end timestamp (ms)
-	Limit     *int    `json:"limit,omitempty"`     // Optional: Limit for data size per page
-	Cursor    *string `json:"cursor,omitempty"`    // Optional: Cursor for pagination
+	Category  market.Category `json:"category"`            // Required: "linear" or "inverse"
+	Symbol    *string         `json:"symbol,omitempty"`    // Optional: Symbol name
+	StartTime *int64          `json:"startTime,omitempty"` // Optional: The start timestamp (ms)
+	EndTime   *int64          `json:"endTime,omitempty"`   // Optional: The end timestamp (ms)
+	Limit     *int            `json:"limit,omitempty"`     // Optional: Limit for data size per page
+	Cursor    *string         `json:"cursor,omitempty"`    // Optional: Cursor for pagination
 }
 
 type ClosedPnLResponse struct {
 	RetCode int    `json:"retCode"`
 	RetMsg  string `json:"retMsg"`
 	Result  struct {
-		NextPageCursor string        `json:"nextPageCursor"`
-		Category       string        `json:"category"`
-		List           []PnLPosition `json:"list"`
+		NextPageCursor string          `json:"nextPageCursor"`
+		Category       market.Category `json:"category"`
+		List           []PnLPosition   `json:"list"`
 	} `json:"result"`
 	RetExtInfo struct {
 	} `json:"retExtInfo"`
@@ -180,13 +226,16 @@ type PnLPosition struct {
 
 // MovePositionRequestLeg represents a single leg of a move position request.
 type MovePositionRequestLeg struct {
-	Category string `json:"category"` // "linear", "spot", "option"
-	Symbol   string `json:"symbol"`
-	Price    string `json:"price"`
-	Side     string `json:"side"` // "Buy" or "Sell"
-	Qty      string `json:"qty"`
+	Category market.Category `json:"category"` // "linear", "spot", "option"
+	Symbol   string          `json:"symbol"`
+	Price    string          `json:"price"`
+	Side     string          `json:"side"` // "Buy" or "Sell"
+	Qty      string          `json:"qty"`
 }
 
+// MaxMovePositionLegs is the maximum number of positions MovePositions accepts in one request.
+const MaxMovePositionLegs = 25
+
 // MovePositionRequest encapsulates the payload for moving positions.
 type MovePositionRequest struct {
 	FromUID string                   `json:"fromUid"`
@@ -203,38 +252,40 @@ type MovePositionResponse struct {
 		Status       string `json:"status"`
 		RejectParty  string `json:"rejectParty"`
 	} `json:"result"`
+	RetExtInfo any   `json:"retExtInfo"`
+	Time       int64 `json:"time"`
 }
 
 // GetMovePositionHistoryRequest represents the query parameters for fetching move position history.
 type GetMovePositionHistoryRequest struct {
-	Category     *string `json:"category,omitempty"`     // Optional: Product type
-	Symbol       *string `json:"symbol,omitempty"`       // Optional: Symbol name
-	StartTime    *int64  `json:"startTime,omitempty"`    // Optional: Start timestamp
-	EndTime      *int64  `json:"endTime,omitempty"`      // Optional: End timestamp
-	Status       *string `json:"status,omitempty"`       // Optional: Order status
-	BlockTradeId *string `json:"blockTradeId,omitempty"` // Optional: Block trade ID
-	Limit        *int    `json:"limit,omitempty"`        // Optional: Data size limit per page
-	Cursor       *string `json:"cursor,omitempty"`       // Optional: Pagination cursor
+	Category     *market.Category `json:"category,omitempty"`     // Optional: Product type
+	Symbol       *string          `json:"symbol,omitempty"`       // Optional: Symbol name
+	StartTime    *int64           `json:"startTime,omitempty"`    // Optional: Start timestamp
+	EndTime      *int64           `json:"endTime,omitempty"`      // Optional: End timestamp
+	Status       *string          `json:"status,omitempty"`       // Optional: Order status
+	BlockTradeId *string          `json:"blockTradeId,omitempty"` // Optional: Block trade ID
+	Limit        *int             `json:"limit,omitempty"`        // Optional: Data size limit per page
+	Cursor       *string          `json:"cursor,omitempty"`       // Optional: Pagination cursor
 }
 
 // MovePositionHistoryEntry represents a single entry in the move position history.
 type MovePositionHistoryEntry struct {
-	BlockTradeId  string `json:"blockTradeId"`
-	Category      string `json:"category"`
-	OrderId       string `json:"orderId"`
-	UserId        int    `json:"userId"`
-	Symbol        string `json:"symbol"`
-	Side          string `json:"side"`
-	Price         string `json:"price"`
-	Qty           string `json:"qty"`
-	ExecFee       string `json:"execFee"`
-	Status        string `json:"status"`
-	ExecId        string `json:"execId"`
-	ResultCode    int    `json:"resultCode"`
-	ResultMessage string `json:"resultMessage"`
-	CreatedAt     int64  `json:"createdAt"`
-	UpdatedAt     int64  `json:"updatedAt"`
-	RejectParty   string `json:"rejectParty"`
+	BlockTradeId  string          `json:"blockTradeId"`
+	Category      market.Category `json:"category"`
+	OrderId       string          `json:"orderId"`
+	UserId        int             `json:"userId"`
+	Symbol        string          `json:"symbol"`
+	Side          string          `json:"side"`
+	Price         string          `json:"price"`
+	Qty           string          `json:"qty"`
+	ExecFee       string          `json:"execFee"`
+	Status        string          `json:"status"`
+	ExecId        string          `json:"execId"`
+	ResultCode    int             `json:"resultCode"`
+	ResultMessage string          `json:"resultMessage"`
+	CreatedAt     int64           `json:"createdAt"`
+	UpdatedAt     int64           `json:"updatedAt"`
+	RejectParty   string          `json:"rejectParty"`
 }
 
 // GetMovePositionHistoryResponse represents the response from fetching move position history.
@@ -251,6 +302,6 @@ type GetMovePositionHistoryResponse struct {
 
 // ConfirmNewRiskLimitRequest represents the payload for confirming a new risk limit.
 type ConfirmNewRiskLimitRequest struct {
-	Category string `json:"category"` // Required: "linear" or "inverse"
-	Symbol   string `json:"symbol"`   // Required: Symbol name
+	Category market.Category `json:"category"` // Required: "linear" or "inverse"
+	Symbol   string          `json:"symbol"`   // Required: Symbol name
 }
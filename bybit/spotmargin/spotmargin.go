@@ -0,0 +1,179 @@
+package spotmargin
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+)
+
+// SpotMargin defines the interface for the spot margin trade endpoints of the Bybit API.
+type SpotMargin interface {
+	// SwitchMode turns spot margin trading of a unified trading account on or off.
+	// on: bool - true to turn spot margin trading on, false to turn it off.
+	// returns: *SwitchModeResponse - the resulting spot margin mode.
+	//          error - an error if the request fails.
+	SwitchMode(on bool) (*SwitchModeResponse, error)
+
+	// SetLeverage sets the spot margin leverage of a unified trading account.
+	// leverage: string - the leverage, between MinLeverage and MaxLeverage.
+	// returns: *SetLeverageResponse - the response from the API.
+	//          error - an error if the request fails.
+	SetLeverage(leverage string) (*SetLeverageResponse, error)
+
+	// GetState retrieves the spot margin mode and leverage of a unified trading account.
+	// returns: *StateResponse - the spot margin state.
+	//          error - an error if the request fails.
+	GetState() (*StateResponse, error)
+
+	// GetVIPMarginData retrieves the borrowable coins, rates and limits per VIP level.
+	// vipLevel: string - the VIP level, e.g. "No VIP"; empty returns every level.
+	// currency: string - the coin; empty returns every coin.
+	// returns: *VIPMarginDataResponse - the margin data.
+	//          error - an error if the request fails.
+	GetVIPMarginData(vipLevel, currency string) (*VIPMarginDataResponse, error)
+
+	// ClassicSwitch turns spot margin trading of a classic account on or off.
+	// on: bool - true to turn spot margin trading on, false to turn it off.
+	// returns: *ClassicSwitchResponse - the resulting switch status.
+	//          error - an error if the request fails.
+	ClassicSwitch(on bool) (*ClassicSwitchResponse, error)
+
+	// GetClassicInterestQuota retrieves the interest rate and borrow quota of a coin for a classic account.
+	// coin: string - the coin, e.g. USDT.
+	// returns: *InterestQuotaResponse - the interest rate and borrowable amounts.
+	//          error - an error if the request fails.
+	GetClassicInterestQuota(coin string) (*InterestQuotaResponse, error)
+}
+
+type impl struct {
+	client *client.Client
+}
+
+// New creates a new instance of the SpotMargin interface, which can be used to interact with the Bybit API.
+func New(c *client.Client) SpotMargin {
+	return &impl{client: c}
+}
+
+// SwitchMode turns spot margin trading of a unified trading account on or off.
+func (i *impl) SwitchMode(on bool) (*SwitchModeResponse, error) {
+	mode := ModeOff
+	if on {
+		mode = ModeOn
+	}
+	response, err := i.client.Post("/v5/spot-margin-trade/switch-mode", client.Params{"spotMarginMode": mode})
+	if err != nil {
+		return nil, fmt.Errorf("error switching spot margin mode: %w", err)
+	}
+	var switchResponse SwitchModeResponse
+	if err := response.Unmarshal(&switchResponse); err != nil {
+		return nil, fmt.Errorf("error parsing switch mode response: %w", err)
+	}
+	if switchResponse.RetCode != 0 {
+		return &switchResponse, fmt.Errorf("API returned error: %s", switchResponse.RetMsg)
+	}
+	return &switchResponse, nil
+}
+
+// SetLeverage sets the spot margin leverage of a unified trading account. Spot margin trading
+// must be turned on first.
+func (i *impl) SetLeverage(leverage string) (*SetLeverageResponse, error) {
+	value, err := strconv.ParseFloat(leverage, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid leverage %q: %w", leverage, err)
+	}
+	if value < MinLeverage || value > MaxLeverage {
+		return nil, fmt.Errorf("leverage must be between %d and %d", MinLeverage, MaxLeverage)
+	}
+	response, err := i.client.Post("/v5/spot-margin-trade/set-leverage", client.Params{"leverage": leverage})
+	if err != nil {
+		return nil, fmt.Errorf("error setting spot margin leverage: %w", err)
+	}
+	var leverageResponse SetLeverageResponse
+	if err := response.Unmarshal(&leverageResponse); err != nil {
+		return nil, fmt.Errorf("error parsing set leverage response: %w", err)
+	}
+	if leverageResponse.RetCode != 0 {
+		return &leverageResponse, fmt.Errorf("API returned error: %s", leverageResponse.RetMsg)
+	}
+	return &leverageResponse, nil
+}
+
+// GetState retrieves the spot margin mode and leverage of a unified trading account.
+func (i *impl) GetState() (*StateResponse, error) {
+	response, err := i.client.Get("/v5/spot-margin-trade/state", client.Params{})
+	if err != nil {
+		return nil, fmt.Errorf("error fetching spot margin state: %w", err)
+	}
+	var stateResponse StateResponse
+	if err := response.Unmarshal(&stateResponse); err != nil {
+		return nil, fmt.Errorf("error parsing spot margin state response: %w", err)
+	}
+	if stateResponse.RetCode != 0 {
+		return &stateResponse, fmt.Errorf("API returned error: %s", stateResponse.RetMsg)
+	}
+	return &stateResponse, nil
+}
+
+// GetVIPMarginData retrieves the borrowable coins, hourly borrow rates and borrow limits per VIP level.
+func (i *impl) GetVIPMarginData(vipLevel, currency string) (*VIPMarginDataResponse, error) {
+	params := client.Params{}
+	if vipLevel != "" {
+		params["vipLevel"] = vipLevel
+	}
+	if currency != "" {
+		params["currency"] = currency
+	}
+	response, err := i.client.Get("/v5/spot-margin-trade/data", params)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching spot margin data: %w", err)
+	}
+	var dataResponse VIPMarginDataResponse
+	if err := response.Unmarshal(&dataResponse); err != nil {
+		return nil, fmt.Errorf("error parsing spot margin data response: %w", err)
+	}
+	if dataResponse.RetCode != 0 {
+		return &dataResponse, fmt.Errorf("API returned error: %s", dataResponse.RetMsg)
+	}
+	return &dataResponse, nil
+}
+
+// ClassicSwitch turns spot margin trading of a classic account on or off.
+func (i *impl) ClassicSwitch(on bool) (*ClassicSwitchResponse, error) {
+	// Unlike spotMarginMode, the classic switch is sent as the integer 1 or 0.
+	switchValue := 0
+	if on {
+		switchValue = 1
+	}
+	response, err := i.client.Post("/v5/spot-cross-margin-trade/switch", client.Params{"switch": switchValue})
+	if err != nil {
+		return nil, fmt.Errorf("error switching classic spot margin trading: %w", err)
+	}
+	var switchResponse ClassicSwitchResponse
+	if err := response.Unmarshal(&switchResponse); err != nil {
+		return nil, fmt.Errorf("error parsing classic switch response: %w", err)
+	}
+	if switchResponse.RetCode != 0 {
+		return &switchResponse, fmt.Errorf("API returned error: %s", switchResponse.RetMsg)
+	}
+	return &switchResponse, nil
+}
+
+// GetClassicInterestQuota retrieves the interest rate and borrow quota of a coin for a classic account.
+func (i *impl) GetClassicInterestQuota(coin string) (*InterestQuotaResponse, error) {
+	if coin == "" {
+		return nil, fmt.Errorf("coin is required")
+	}
+	response, err := i.client.Get("/v5/spot-cross-margin-trade/interest-quota", client.Params{"coin": coin})
+	if err != nil {
+		return nil, fmt.Errorf("error fetching interest quota: %w", err)
+	}
+	var quotaResponse InterestQuotaResponse
+	if err := response.Unmarshal(&quotaResponse); err != nil {
+		return nil, fmt.Errorf("error parsing interest quota response: %w", err)
+	}
+	if quotaResponse.RetCode != 0 {
+		return &quotaResponse, fmt.Errorf("API returned error: %s", quotaResponse.RetMsg)
+	}
+	return &quotaResponse, nil
+}
@@ -0,0 +1,36 @@
+package spotmargin
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassicSwitchSendsInteger(t *testing.T) {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v5/spot-cross-margin-trade/switch", r.URL.Path)
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		bodies = append(bodies, string(body))
+		fmt.Fprint(w, `{"retCode":0,"result":{"switchStatus":1}}`)
+	}))
+	defer server.Close()
+	s := New(client.NewClientWithBaseURL("key", "secret", server.URL))
+
+	res, err := s.ClassicSwitch(true)
+	require.NoError(t, err)
+	assert.Equal(t, 1, res.Result.SwitchStatus)
+	_, err = s.ClassicSwitch(false)
+	require.NoError(t, err)
+
+	require.Len(t, bodies, 2)
+	assert.JSONEq(t, `{"switch":1}`, bodies[0])
+	assert.JSONEq(t, `{"switch":0}`, bodies[1])
+}
@@ -0,0 +1,96 @@
+package spotmargin
+
+// Bounds of the spot margin leverage of a unified trading account.
+const (
+	MinLeverage = 2
+	MaxLeverage = 10
+)
+
+// Values of the spotMarginMode field.
+const (
+	ModeOff = "0"
+	ModeOn  = "1"
+)
+
+// SwitchModeResponse represents the response from turning spot margin trading on or off.
+type SwitchModeResponse struct {
+	RetCode int    `json:"retCode"`
+	RetMsg  string `json:"retMsg"`
+	Result  struct {
+		SpotMarginMode string `json:"spotMarginMode"`
+	} `json:"result"`
+	RetExtInfo any   `json:"retExtInfo"`
+	Time       int64 `json:"time"`
+}
+
+// SetLeverageResponse represents the response from setting the spot margin leverage.
+type SetLeverageResponse struct {
+	RetCode    int    `json:"retCode"`
+	RetMsg     string `json:"retMsg"`
+	Result     any    `json:"result"`
+	RetExtInfo any    `json:"retExtInfo"`
+	Time       int64  `json:"time"`
+}
+
+// StateResponse represents the response from querying the spot margin state.
+type StateResponse struct {
+	RetCode int    `json:"retCode"`
+	RetMsg  string `json:"retMsg"`
+	Result  struct {
+		SpotLeverage      string `json:"spotLeverage"`
+		SpotMarginMode    string `json:"spotMarginMode"`
+		EffectiveLeverage string `json:"effectiveLeverage"`
+	} `json:"result"`
+	RetExtInfo any   `json:"retExtInfo"`
+	Time       int64 `json:"time"`
+}
+
+// MarginCoinData holds the borrow and collateral data of a coin for a VIP level.
+type MarginCoinData struct {
+	Borrowable         bool   `json:"borrowable"`
+	CollateralRatio    string `json:"collateralRatio"`
+	Currency           string `json:"currency"`
+	HourlyBorrowRate   string `json:"hourlyBorrowRate"`
+	LiquidationOrder   string `json:"liquidationOrder"`
+	MarginCollateral   bool   `json:"marginCollateral"`
+	MaxBorrowingAmount string `json:"maxBorrowingAmount"`
+}
+
+// VIPMarginDataResponse represents the response from querying the borrow data per VIP level.
+type VIPMarginDataResponse struct {
+	RetCode int    `json:"retCode"`
+	RetMsg  string `json:"retMsg"`
+	Result  struct {
+		VipCoinList []struct {
+			List     []MarginCoinData `json:"list"`
+			VipLevel string           `json:"vipLevel"`
+		} `json:"vipCoinList"`
+	} `json:"result"`
+	RetExtInfo any   `json:"retExtInfo"`
+	Time       int64 `json:"time"`
+}
+
+// ClassicSwitchResponse represents the response from turning classic spot margin trading on or off.
+type ClassicSwitchResponse struct {
+	RetCode int    `json:"retCode"`
+	RetMsg  string `json:"retMsg"`
+	Result  struct {
+		SwitchStatus int `json:"switchStatus"` // 0 off, 1 on
+	} `json:"result"`
+	RetExtInfo any   `json:"retExtInfo"`
+	Time       int64 `json:"time"`
+}
+
+// InterestQuotaResponse represents the response from querying the classic spot margin interest and quota of a coin.
+type InterestQuotaResponse struct {
+	RetCode int    `json:"retCode"`
+	RetMsg  string `json:"retMsg"`
+	Result  struct {
+		Coin           string `json:"coin"`
+		InterestRate   string `json:"interestRate"`
+		LoanAbleAmount string `json:"loanAbleAmount"`
+		MaxLoanAmount  string `json:"maxLoanAmount"`
+	} `json:"result"`
+	RetExtInfo any   `json:"retExtInfo"`
+	Time       int64 `json:"time"`
+}
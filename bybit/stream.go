@@ -0,0 +1,458 @@
+package bybit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/trade"
+	wsCli "github.com/cploutarchou/crypto-sdk-suite/bybit/ws/client"
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/ws/public/kline"
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/ws/public/liquidation"
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/ws/public/ticker"
+)
+
+// authTimeout is how long the private connection waits for its auth response.
+const authTimeout = 10 * time.Second
+
+// Message is a single topic push received on either the public or the private connection.
+type Message struct {
+	ID           string          `json:"id"`
+	Topic        string          `json:"topic"`
+	Type         string          `json:"type"`
+	TS           int64           `json:"ts"`
+	CreationTime int64           `json:"creationTime"`
+	Data         json.RawMessage `json:"data"`
+}
+
+// Stream manages one public and one private WebSocket connection. Subscriptions are
+// remembered per connection and replayed after every reconnect, and the private
+// connection is re-authenticated before its topics are restored.
+type Stream struct {
+	public  *wsCli.Client
+	private *wsCli.Client
+
+	mu              sync.RWMutex
+	publicHandlers  map[string]func(Message)
+	privateHandlers map[string]func(Message)
+	connected       bool
+
+	// OnError is called with connection and decoding errors. Errors are logged when it is nil.
+	OnError func(err error)
+
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+// NewStream creates a Stream for the given category. The private connection is only
+// opened when an API key is supplied.
+func NewStream(key, secretKey string, isTestNet bool, category string) (*Stream, error) {
+	publicClient, err := wsCli.NewPublicClient(isTestNet, category)
+	if err != nil {
+		return nil, err
+	}
+	var privateClient *wsCli.Client
+	if key != "" {
+		privateClient, err = wsCli.NewPrivateClient(key, secretKey, isTestNet, "", category)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return newStream(publicClient, privateClient), nil
+}
+
+// newStream takes over reconnecting the clients, so every new connection is authenticated and
+// resubscribed before it is read from.
+func newStream(publicClient, privateClient *wsCli.Client) *Stream {
+	publicClient.ManualReconnect = true
+	if privateClient != nil {
+		privateClient.ManualReconnect = true
+	}
+	return &Stream{
+		public:          publicClient,
+		private:         privateClient,
+		publicHandlers:  make(map[string]func(Message)),
+		privateHandlers: make(map[string]func(Message)),
+		closed:          make(chan struct{}),
+	}
+}
+
+// Connect opens both connections, authenticates the private one and sends every
+// subscription registered so far. When the private connection fails the public one is closed
+// as well, and the stream cannot be reused.
+func (s *Stream) Connect() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.connected {
+		return nil
+	}
+
+	if err := s.open(s.public); err != nil {
+		return fmt.Errorf("failed to connect public stream: %w", err)
+	}
+	if s.private != nil {
+		if err := s.open(s.private); err != nil {
+			s.Close()
+			return fmt.Errorf("failed to connect private stream: %w", err)
+		}
+		go s.run(s.private)
+	}
+	go s.run(s.public)
+
+	s.connected = true
+	return nil
+}
+
+// open dials the client, authenticates it when it is private and replays its subscriptions.
+// Callers must hold s.mu.
+func (s *Stream) open(c *wsCli.Client) error {
+	if err := c.Connect(); err != nil {
+		return err
+	}
+	if c.CurrentConn() == nil {
+		return errors.New("connection was not established")
+	}
+	if err := s.authenticate(c); err != nil {
+		return err
+	}
+	return s.resubscribe(c)
+}
+
+// authenticate authenticates the client if it is private and waits for the response. It reads
+// from the connection, so it must run before the connection's read loop starts or on the read
+// loop itself.
+func (s *Stream) authenticate(c *wsCli.Client) error {
+	if err := c.AuthenticateIfRequired(); err != nil {
+		return fmt.Errorf("failed to authenticate: %w", err)
+	}
+	if c == s.private {
+		return s.awaitAuth(c)
+	}
+	return nil
+}
+
+// resubscribe sends every topic registered for the client. Callers must hold s.mu (read or
+// write), so no subscription made meanwhile is missed or sent twice.
+func (s *Stream) resubscribe(c *wsCli.Client) error {
+	topics := make([]string, 0, len(s.handlersFor(c)))
+	for topic := range s.handlersFor(c) {
+		topics = append(topics, topic)
+	}
+	if len(topics) == 0 {
+		return nil
+	}
+	return send(c, "subscribe", topics...)
+}
+
+// authResponse is Bybit's reply to the auth operation.
+type authResponse struct {
+	Op      string `json:"op"`
+	Success bool   `json:"success"`
+	RetMsg  string `json:"ret_msg"`
+}
+
+// awaitAuth reads from c until the auth response arrives, so private topics are not subscribed
+// before the connection is authenticated. Other messages read meanwhile are dropped; no topic
+// pushes are expected before the topics are subscribed.
+func (s *Stream) awaitAuth(c *wsCli.Client) error {
+	conn := c.CurrentConn()
+	if conn == nil {
+		return errors.New("connection was not established")
+	}
+	if err := conn.SetReadDeadline(time.Now().Add(authTimeout)); err != nil {
+		return err
+	}
+	defer conn.SetReadDeadline(time.Time{})
+	for {
+		_, raw, err := conn.ReadMessage()
+		if err != nil {
+			return fmt.Errorf("failed to read auth response: %w", err)
+		}
+		var res authResponse
+		if err := json.Unmarshal(raw, &res); err != nil || res.Op != "auth" {
+			continue
+		}
+		if !res.Success {
+			return fmt.Errorf("authentication rejected: %s", res.RetMsg)
+		}
+		return nil
+	}
+}
+
+func (s *Stream) handlersFor(c *wsCli.Client) map[string]func(Message) {
+	if c == s.private {
+		return s.privateHandlers
+	}
+	return s.publicHandlers
+}
+
+// run reads messages from the client until the stream is closed, reconnecting on read errors.
+func (s *Stream) run(c *wsCli.Client) {
+	for {
+		select {
+		case <-s.closed:
+			return
+		default:
+		}
+
+		conn := c.CurrentConn()
+		if conn == nil {
+			s.reconnect(c)
+			continue
+		}
+		_, msg, err := conn.ReadMessage()
+		if err != nil {
+			select {
+			case <-s.closed:
+				return
+			default:
+			}
+			s.reportError(fmt.Errorf("read failed: %w", err))
+			s.reconnect(c)
+			continue
+		}
+		s.dispatch(c, msg)
+	}
+}
+
+func (s *Stream) reconnect(c *wsCli.Client) {
+	if err := c.Reconnect(); err != nil {
+		s.reportError(err)
+		return
+	}
+	// The lock is only taken once authenticated, so subscribing is not blocked while the auth
+	// response is awaited.
+	if err := s.authenticate(c); err != nil {
+		s.reportError(err)
+		// An unauthenticated connection delivers nothing; fail the next read to try again.
+		if conn := c.CurrentConn(); conn != nil {
+			_ = conn.Close()
+		}
+		return
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if err := s.resubscribe(c); err != nil {
+		s.reportError(err)
+	}
+}
+
+func (s *Stream) dispatch(c *wsCli.Client, raw []byte) {
+	c.RecordMessage(raw)
+	var msg Message
+	if err := json.Unmarshal(raw, &msg); err != nil {
+		s.reportError(fmt.Errorf("failed to unmarshal message: %w", err))
+		return
+	}
+	if msg.Topic == "" {
+		// Operation replies (subscribe, auth, pong) carry no topic.
+		return
+	}
+
+	s.mu.RLock()
+	handler, ok := s.handlersFor(c)[msg.Topic]
+	s.mu.RUnlock()
+	if ok {
+		handler(msg)
+	}
+}
+
+func (s *Stream) reportError(err error) {
+	if s.OnError != nil {
+		s.OnError(err)
+		return
+	}
+	log.Printf("Stream error: %v", err)
+}
+
+// SubscribePublic registers a handler for a raw public topic, e.g. "orderbook.50.BTCUSDT".
+func (s *Stream) SubscribePublic(topic string, handler func(Message)) error {
+	return s.subscribe(s.public, topic, handler)
+}
+
+// SubscribePrivate registers a handler for a raw private topic, e.g. "order" or "wallet".
+func (s *Stream) SubscribePrivate(topic string, handler func(Message)) error {
+	if s.private == nil {
+		return errors.New("private stream requires an API key")
+	}
+	return s.subscribe(s.private, topic, handler)
+}
+
+func (s *Stream) subscribe(c *wsCli.Client, topic string, handler func(Message)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlersFor(c)[topic] = handler
+	if !s.connected {
+		return nil
+	}
+	return send(c, "subscribe", topic)
+}
+
+// Unsubscribe removes the handler for a topic on whichever connection owns it.
+func (s *Stream) Unsubscribe(topic string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, c := range []*wsCli.Client{s.public, s.private} {
+		if c == nil {
+			continue
+		}
+		handlers := s.handlersFor(c)
+		if _, ok := handlers[topic]; !ok {
+			continue
+		}
+		delete(handlers, topic)
+		if !s.connected {
+			return nil
+		}
+		return send(c, "unsubscribe", topic)
+	}
+	return fmt.Errorf("not subscribed to topic %s", topic)
+}
+
+// SubscribeTicker subscribes to ticker updates for a symbol.
+func (s *Stream) SubscribeTicker(symbol string, callback func(ticker.Data)) error {
+	return s.SubscribePublic(fmt.Sprintf("tickers.%s", symbol), func(msg Message) {
+		var data ticker.Data
+		if err := json.Unmarshal(msg.Data, &data); err != nil {
+			s.reportError(fmt.Errorf("failed to decode ticker: %w", err))
+			return
+		}
+		callback(data)
+	})
+}
+
+// SubscribeOptionTicker subscribes to ticker updates for an option contract, including Greeks and IV.
+// The stream must be created with the "option" category.
+func (s *Stream) SubscribeOptionTicker(symbol string, callback func(ticker.OptionData)) error {
+	return s.SubscribePublic(fmt.Sprintf("tickers.%s", symbol), func(msg Message) {
+		var data ticker.OptionData
+		if err := json.Unmarshal(msg.Data, &data); err != nil {
+			s.reportError(fmt.Errorf("failed to decode option ticker: %w", err))
+			return
+		}
+		callback(data)
+	})
+}
+
+// SubscribeKline subscribes to kline updates for a symbol and interval.
+func (s *Stream) SubscribeKline(symbol, interval string, callback func(kline.Data)) error {
+	return s.SubscribePublic(fmt.Sprintf("kline.%s.%s", interval, symbol), func(msg Message) {
+		var data []kline.Data
+		if err := json.Unmarshal(msg.Data, &data); err != nil {
+			s.reportError(fmt.Errorf("failed to decode kline: %w", err))
+			return
+		}
+		for i := range data {
+			callback(data[i])
+		}
+	})
+}
+
+// SubscribeLiquidation subscribes to liquidation updates for a symbol.
+func (s *Stream) SubscribeLiquidation(symbol string, callback func(liquidation.Data)) error {
+	return s.SubscribePublic(fmt.Sprintf("liquidation.%s", symbol), func(msg Message) {
+		var data liquidation.Data
+		if err := json.Unmarshal(msg.Data, &data); err != nil {
+			s.reportError(fmt.Errorf("failed to decode liquidation: %w", err))
+			return
+		}
+		callback(data)
+	})
+}
+
+// SubscribeOrderbook subscribes to orderbook snapshots and deltas for a symbol at the given depth.
+func (s *Stream) SubscribeOrderbook(symbol string, depth int, handler func(Message)) error {
+	return s.SubscribePublic(fmt.Sprintf("orderbook.%d.%s", depth, symbol), handler)
+}
+
+// SubscribeTrades subscribes to public trades for a symbol.
+func (s *Stream) SubscribeTrades(symbol string, handler func(Message)) error {
+	return s.SubscribePublic(fmt.Sprintf("publicTrade.%s", symbol), handler)
+}
+
+// SubscribeOrders subscribes to private order updates across all categories.
+func (s *Stream) SubscribeOrders(handler func(Message)) error {
+	return s.SubscribePrivate("order", handler)
+}
+
+// SubscribeOrderUpdates subscribes to private order updates and decodes them, e.g. to feed
+// trade.OCO.Update or trade.OrderTracker.Update.
+func (s *Stream) SubscribeOrderUpdates(callback func(trade.OrderDetails)) error {
+	return s.SubscribeOrders(func(msg Message) {
+		var data []trade.OrderDetails
+		if err := json.Unmarshal(msg.Data, &data); err != nil {
+			s.reportError(fmt.Errorf("failed to decode order update: %w", err))
+			return
+		}
+		for i := range data {
+			callback(data[i])
+		}
+	})
+}
+
+// SubscribeExecutions subscribes to private execution updates across all categories.
+func (s *Stream) SubscribeExecutions(handler func(Message)) error {
+	return s.SubscribePrivate("execution", handler)
+}
+
+// SubscribeExecutionUpdates subscribes to private execution updates and decodes them, e.g. to
+// feed trade.OrderTracker.UpdateExecution.
+func (s *Stream) SubscribeExecutionUpdates(callback func(trade.Execution)) error {
+	return s.SubscribeExecutions(func(msg Message) {
+		var data []trade.Execution
+		if err := json.Unmarshal(msg.Data, &data); err != nil {
+			s.reportError(fmt.Errorf("failed to decode execution: %w", err))
+			return
+		}
+		for i := range data {
+			callback(data[i])
+		}
+	})
+}
+
+// SubscribePositions subscribes to private position updates across all categories.
+func (s *Stream) SubscribePositions(handler func(Message)) error {
+	return s.SubscribePrivate("position", handler)
+}
+
+// SubscribeWallet subscribes to private wallet updates.
+func (s *Stream) SubscribeWallet(handler func(Message)) error {
+	return s.SubscribePrivate("wallet", handler)
+}
+
+// Latency returns the latency measurements of the public and the private connection.
+func (s *Stream) Latency() (public, private wsCli.LatencyStats) {
+	public = s.public.Latency()
+	if s.private != nil {
+		private = s.private.Latency()
+	}
+	return public, private
+}
+
+// Close closes both connections and stops the read loops.
+func (s *Stream) Close() {
+	s.closeOnce.Do(func() {
+		close(s.closed)
+		s.public.Close()
+		if s.private != nil {
+			s.private.Close()
+		}
+	})
+}
+
+func send(c *wsCli.Client, op string, topics ...string) error {
+	msg, err := json.Marshal(map[string]any{
+		"op":   op,
+		"args": topics,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s message: %w", op, err)
+	}
+	if err := c.Send(msg); err != nil {
+		return fmt.Errorf("failed to %s: %w", op, err)
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package bybit
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	wsCli "github.com/cploutarchou/crypto-sdk-suite/bybit/ws/client"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type wsRequest struct {
+	Op   string   `json:"op"`
+	Args []string `json:"args"`
+}
+
+// fakeStreamServer accepts websocket connections, records every request and answers auth
+// requests successfully.
+type fakeStreamServer struct {
+	*httptest.Server
+	requests chan wsRequest
+	conns    chan *websocket.Conn
+}
+
+func newFakeStreamServer(t *testing.T) *fakeStreamServer {
+	f := &fakeStreamServer{requests: make(chan wsRequest, 100), conns: make(chan *websocket.Conn, 10)}
+	upgrader := websocket.Upgrader{}
+	f.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		f.conns <- conn
+		for {
+			_, raw, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			var req wsRequest
+			if err := json.Unmarshal(raw, &req); err != nil {
+				continue
+			}
+			f.requests <- req
+			if req.Op == "auth" {
+				_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"op":"auth","success":true}`))
+			}
+		}
+	}))
+	t.Cleanup(f.Close)
+	return f
+}
+
+func (f *fakeStreamServer) newStream(t *testing.T, private bool) *Stream {
+	url := "ws" + strings.TrimPrefix(f.URL, "http")
+	publicClient, err := wsCli.NewPublicClient(true, "linear")
+	require.NoError(t, err)
+	publicClient.SetURL(url)
+	var privateClient *wsCli.Client
+	if private {
+		privateClient, err = wsCli.NewPrivateClient("key", "secret", true, "", "linear")
+		require.NoError(t, err)
+		privateClient.SetURL(url)
+		privateClient.ReconnectDelay = 10 * time.Millisecond
+	}
+	s := newStream(publicClient, privateClient)
+	s.OnError = func(err error) { t.Log(err) }
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (f *fakeStreamServer) nextRequest(t *testing.T) wsRequest {
+	select {
+	case req := <-f.requests:
+		return req
+	case <-time.After(5 * time.Second):
+		t.Fatal("no request received")
+		return wsRequest{}
+	}
+}
+
+func (f *fakeStreamServer) nextConn(t *testing.T) *websocket.Conn {
+	select {
+	case conn := <-f.conns:
+		return conn
+	case <-time.After(5 * time.Second):
+		t.Fatal("no connection received")
+		return nil
+	}
+}
+
+func TestStreamAuthenticatesBeforeSubscribing(t *testing.T) {
+	server := newFakeStreamServer(t)
+	s := server.newStream(t, true)
+	require.NoError(t, s.SubscribePrivate("order", func(Message) {}))
+	require.NoError(t, s.Connect())
+
+	assert.Equal(t, "auth", server.nextRequest(t).Op)
+	assert.Equal(t, wsRequest{Op: "subscribe", Args: []string{"order"}}, server.nextRequest(t))
+}
+
+func TestStreamResubscribesAfterReconnect(t *testing.T) {
+	server := newFakeStreamServer(t)
+	s := server.newStream(t, true)
+	require.NoError(t, s.SubscribePrivate("order", func(Message) {}))
+	require.NoError(t, s.Connect())
+	server.nextConn(t) // public
+	private := server.nextConn(t)
+	server.nextRequest(t)
+	server.nextRequest(t)
+
+	require.NoError(t, private.Close())
+	assert.Equal(t, "auth", server.nextRequest(t).Op)
+	assert.Equal(t, wsRequest{Op: "subscribe", Args: []string{"order"}}, server.nextRequest(t))
+
+	require.NoError(t, s.SubscribePrivate("wallet", func(Message) {}))
+	assert.Equal(t, wsRequest{Op: "subscribe", Args: []string{"wallet"}}, server.nextRequest(t))
+}
+
+func TestStreamDispatch(t *testing.T) {
+	server := newFakeStreamServer(t)
+	s := server.newStream(t, false)
+	received := make(chan Message, 1)
+	require.NoError(t, s.SubscribePublic("tickers.BTCUSDT", func(msg Message) { received <- msg }))
+	require.NoError(t, s.Connect())
+	conn := server.nextConn(t)
+	server.nextRequest(t)
+
+	for _, push := range []string{
+		`{"op":"subscribe","success":true}`,
+		`{"topic":"tickers.ETHUSDT","data":{"symbol":"ETHUSDT"}}`,
+		`{"topic":"tickers.BTCUSDT","type":"snapshot","ts":1700000000000,"data":{"symbol":"BTCUSDT"}}`,
+	} {
+		require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(push)))
+	}
+	select {
+	case msg := <-received:
+		assert.Equal(t, "tickers.BTCUSDT", msg.Topic)
+		assert.Equal(t, int64(1700000000000), msg.TS)
+		assert.JSONEq(t, `{"symbol":"BTCUSDT"}`, string(msg.Data))
+	case <-time.After(5 * time.Second):
+		t.Fatal("push was not dispatched")
+	}
+}
@@ -0,0 +1,75 @@
+package system
+
+import (
+	"strconv"
+	"time"
+)
+
+// Maintenance states.
+const (
+	StateScheduled  = "scheduled"
+	StateInProgress = "in_progress"
+	StateCompleted  = "completed"
+)
+
+// Maintenance is an announced platform maintenance window.
+type Maintenance struct {
+	ID           string `json:"id"`
+	Title        string `json:"title"`
+	State        string `json:"state"`
+	Begin        string `json:"begin"` // Start timestamp in milliseconds
+	End          string `json:"end"`   // End timestamp in milliseconds
+	Href         string `json:"href"`
+	ServiceTypes []int  `json:"serviceTypes"`
+	Product      []int  `json:"product"`
+	UIDSuffix    []int  `json:"uidSuffix"`
+	MaintainType string `json:"maintainType"`
+	Env          string `json:"env"`
+}
+
+// Window returns the start and end of the maintenance.
+func (m Maintenance) Window() (begin, end time.Time, err error) {
+	beginMs, err := strconv.ParseInt(m.Begin, 10, 64)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	endMs, err := strconv.ParseInt(m.End, 10, 64)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return time.UnixMilli(beginMs), time.UnixMilli(endMs), nil
+}
+
+// Covers reports whether t falls within the maintenance window. Completed maintenances never cover t.
+func (m Maintenance) Covers(t time.Time) bool {
+	if m.State == StateCompleted {
+		return false
+	}
+	begin, end, err := m.Window()
+	if err != nil {
+		return false
+	}
+	return !t.Before(begin) && t.Before(end)
+}
+
+// StatusResponse represents the response from querying the system status.
+type StatusResponse struct {
+	RetCode int    `json:"retCode"`
+	RetMsg  string `json:"retMsg"`
+	Result  struct {
+		List []Maintenance `json:"list"`
+	} `json:"result"`
+	RetExtInfo any   `json:"retExtInfo"`
+	Time       int64 `json:"time"`
+}
+
+// ActiveAt returns the maintenances whose window covers t.
+func (r *StatusResponse) ActiveAt(t time.Time) []Maintenance {
+	var active []Maintenance
+	for _, m := range r.Result.List {
+		if m.Covers(t) {
+			active = append(active, m)
+		}
+	}
+	return active
+}
@@ -0,0 +1,26 @@
+package system
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActiveAt(t *testing.T) {
+	var status StatusResponse
+	status.Result.List = []Maintenance{
+		{ID: "a", State: StateInProgress, Begin: "1000", End: "2000"},
+		{ID: "b", State: StateScheduled, Begin: "1500", End: "3000"},
+		{ID: "c", State: StateCompleted, Begin: "1000", End: "2000"},
+		{ID: "d", State: StateScheduled, Begin: "bad", End: "3000"},
+	}
+
+	active := status.ActiveAt(time.UnixMilli(1600))
+	assert.Len(t, active, 2)
+	assert.Equal(t, "a", active[0].ID)
+	assert.Equal(t, "b", active[1].ID)
+
+	assert.Empty(t, status.ActiveAt(time.UnixMilli(500)))
+	assert.Len(t, status.ActiveAt(time.UnixMilli(2000)), 1)
+}
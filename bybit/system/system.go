@@ -0,0 +1,71 @@
+package system
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+)
+
+// System defines the interface for the platform status endpoint of the Bybit API.
+type System interface {
+	// GetStatus retrieves the announced platform maintenances.
+	// id: string - the maintenance ID; empty returns every maintenance.
+	// state: string - StateScheduled, StateInProgress or StateCompleted; empty returns every state.
+	// returns: *StatusResponse - the maintenance windows.
+	//          error - an error if the request fails.
+	GetStatus(id, state string) (*StatusResponse, error)
+
+	// InMaintenance reports whether a maintenance window covers the current time.
+	// returns: []Maintenance - the maintenances in progress; empty when trading can continue.
+	//          error - an error if the request fails.
+	InMaintenance() ([]Maintenance, error)
+}
+
+type impl struct {
+	client *client.Client
+}
+
+// New creates a new instance of the System interface, which can be used to interact with the Bybit API.
+func New(c *client.Client) System {
+	return &impl{client: c}
+}
+
+// GetStatus retrieves the scheduled, in progress and completed platform maintenances.
+func (i *impl) GetStatus(id, state string) (*StatusResponse, error) {
+	params := client.Params{}
+	if id != "" {
+		params["id"] = id
+	}
+	if state != "" {
+		switch state {
+		case StateScheduled, StateInProgress, StateCompleted:
+		default:
+			return nil, fmt.Errorf("invalid state %q", state)
+		}
+		params["state"] = state
+	}
+	response, err := i.client.Get("/v5/system/status", params)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching system status: %w", err)
+	}
+	var statusResponse StatusResponse
+	if err := response.Unmarshal(&statusResponse); err != nil {
+		return nil, fmt.Errorf("error parsing system status response: %w", err)
+	}
+	if statusResponse.RetCode != 0 {
+		return &statusResponse, fmt.Errorf("API returned error: %s", statusResponse.RetMsg)
+	}
+	return &statusResponse, nil
+}
+
+// InMaintenance lets a scheduler pause trading during announced downtime. Scheduled maintenances
+// are included as well as in progress ones, since the state is only updated once the window has
+// started.
+func (i *impl) InMaintenance() ([]Maintenance, error) {
+	status, err := i.GetStatus("", "")
+	if err != nil {
+		return nil, err
+	}
+	return status.ActiveAt(time.Now()), nil
+}
@@ -0,0 +1,95 @@
+package trade
+
+import (
+	"fmt"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/market"
+)
+
+// MaxBatchOrders is the number of orders Bybit accepts in one batch request per category.
+var MaxBatchOrders = map[market.Category]int{
+	market.CategoryLinear:  20,
+	market.CategoryInverse: 20,
+	market.CategoryOption:  20,
+	market.CategorySpot:    10,
+}
+
+// BatchItemStatus is the per-order status Bybit reports in retExtInfo for batch requests.
+type BatchItemStatus struct {
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+}
+
+// BatchResult is the outcome of a single order within a batch request. Err is nil when the
+// order was accepted and otherwise matches the errors returned by the single-order calls.
+type BatchResult struct {
+	Index       int
+	Symbol      string
+	OrderID     string
+	OrderLinkID string
+	Err         error
+}
+
+// validateBatch checks the batch size against the category's limit.
+func validateBatch(category market.Category, n int) error {
+	limit, ok := MaxBatchOrders[category]
+	if !ok {
+		return fmt.Errorf("batch orders are not supported for category %q", category)
+	}
+	if n == 0 {
+		return fmt.Errorf("batch request has no orders")
+	}
+	if n > limit {
+		return fmt.Errorf("batch request has %d orders, %s allows at most %d", n, category, limit)
+	}
+	return nil
+}
+
+// batchResults pairs result entries with their retExtInfo status by position.
+func batchResults(results []BatchResult, statuses []BatchItemStatus) []BatchResult {
+	for i := range results {
+		results[i].Index = i
+		if i < len(statuses) && statuses[i].Code != 0 {
+			results[i].Err = apiError(statuses[i].Code, statuses[i].Msg)
+		}
+	}
+	return results
+}
+
+// Results returns the outcome of each order in request order.
+func (r *BatchPlaceOrderResponse) Results() []BatchResult {
+	results := make([]BatchResult, len(r.Result.List))
+	for i, item := range r.Result.List {
+		results[i] = BatchResult{Symbol: item.Symbol, OrderID: item.OrderID, OrderLinkID: item.OrderLinkID}
+	}
+	return batchResults(results, r.RetExtInfo.List)
+}
+
+// Results returns the outcome of each amendment in request order.
+func (r *BatchAmendOrderResponse) Results() []BatchResult {
+	results := make([]BatchResult, len(r.Result.List))
+	for i, item := range r.Result.List {
+		results[i] = BatchResult{Symbol: item.Symbol, OrderID: item.OrderID, OrderLinkID: item.OrderLinkID}
+	}
+	return batchResults(results, r.RetExtInfo.List)
+}
+
+// Results returns the outcome of each cancellation in request order.
+func (r *BatchCancelOrderResponse) Results() []BatchResult {
+	results := make([]BatchResult, len(r.Result.List))
+	for i, item := range r.Result.List {
+		results[i] = BatchResult{Symbol: item.Symbol, OrderID: item.OrderID, OrderLinkID: item.OrderLinkID}
+	}
+	return batchResults(results, r.RetExtInfo.List)
+}
+
+// Failed returns only the orders that were rejected.
+func Failed(results []BatchResult) []BatchResult {
+	var failed []BatchResult
+	for _, result := range results {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
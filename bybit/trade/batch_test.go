@@ -0,0 +1,73 @@
+package trade
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateBatch(t *testing.T) {
+	assert.NoError(t, validateBatch("spot", 10))
+	assert.Error(t, validateBatch("spot", 11))
+	assert.NoError(t, validateBatch("linear", 20))
+	assert.Error(t, validateBatch("linear", 0))
+	assert.Error(t, validateBatch("futures", 1))
+}
+
+func TestBatchPlaceOrderResponseResults(t *testing.T) {
+	body := `{"retCode":0,"retMsg":"OK","result":{"list":[
+		{"category":"linear","symbol":"BTCUSDT","orderId":"1","orderLinkId":"a"},
+		{"category":"linear","symbol":"BTCUSDT","orderId":"","orderLinkId":"b"}]},
+		"retExtInfo":{"list":[{"code":0,"msg":"OK"},{"code":110007,"msg":"Insufficient balance"}]}}`
+	var response BatchPlaceOrderResponse
+	require.NoError(t, json.Unmarshal([]byte(body), &response))
+
+	results := response.Results()
+	require.Len(t, results, 2)
+	assert.NoError(t, results[0].Err)
+	assert.Equal(t, "1", results[0].OrderID)
+	assert.Error(t, results[1].Err)
+	assert.Equal(t, "b", results[1].OrderLinkID)
+
+	failed := Failed(results)
+	require.Len(t, failed, 1)
+	assert.Equal(t, 1, failed[0].Index)
+}
+
+func TestConvertBatchPlaceOrderRequestToParams(t *testing.T) {
+	price := "30000"
+	params := ConvertBatchPlaceOrderRequestToParams(&BatchPlaceOrderRequest{
+		Category: "linear",
+		Request:  []OrderRequest{{Symbol: "BTCUSDT", Side: "Buy", OrderType: "Limit", Qty: "0.001", Price: &price}},
+	})
+	body, err := json.Marshal(params)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"category":"linear","request":[{"symbol":"BTCUSDT","side":"Buy","orderType":"Limit","qty":"0.001","price":"30000"}]}`, string(body))
+}
+
+func TestConvertBatchAmendOrderRequestToParams(t *testing.T) {
+	orderID, qty := "1", "0.002"
+	params := ConvertBatchAmendOrderRequestToParams(&BatchAmendOrderRequest{
+		Category: "linear",
+		Request:  []AmendOrderRequest{{Symbol: "BTCUSDT", OrderID: &orderID, Qty: &qty}},
+	})
+	body, err := json.Marshal(params)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"category":"linear","request":[{"symbol":"BTCUSDT","orderId":"1","qty":"0.002"}]}`, string(body))
+}
+
+func TestConvertBatchCancelOrderRequestToParams(t *testing.T) {
+	orderID, orderLinkID := "1", "b"
+	params := ConvertBatchCancelOrderRequestToParams(&BatchCancelOrderRequest{
+		Category: "spot",
+		Request: []CancelOrderRequest{
+			{Symbol: "BTCUSDT", OrderID: &orderID},
+			{Symbol: "ETHUSDT", OrderLinkID: &orderLinkID},
+		},
+	})
+	body, err := json.Marshal(params)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"category":"spot","request":[{"symbol":"BTCUSDT","orderId":"1"},{"symbol":"ETHUSDT","orderLinkId":"b"}]}`, string(body))
+}
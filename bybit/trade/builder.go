@@ -0,0 +1,299 @@
+package trade
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/market"
+	"github.com/cploutarchou/crypto-sdk-suite/decimal"
+)
+
+// OrderBuilder assembles a PlaceOrderRequest. Setters fill the optional pointer fields and Build
+// checks field combinations that Bybit would otherwise reject or silently misinterpret.
+//
+//	req, err := trade.NewLimitOrder("BTCUSDT").Buy().Qty("0.01").Price("30000").PostOnly().Build()
+type OrderBuilder struct {
+	req     PlaceOrderRequest
+	trigger triggerKind
+}
+
+// NewLimitOrder starts a good-till-cancelled linear limit order for symbol.
+func NewLimitOrder(symbol string) *OrderBuilder {
+	return &OrderBuilder{req: PlaceOrderRequest{
+		Category:    market.CategoryLinear,
+		Symbol:      symbol,
+		OrderType:   OrderTypeLimit,
+		TimeInForce: TimeInForceGTC,
+	}}
+}
+
+// NewMarketOrder starts a linear market order for symbol.
+func NewMarketOrder(symbol string) *OrderBuilder {
+	return &OrderBuilder{req: PlaceOrderRequest{
+		Category:  market.CategoryLinear,
+		Symbol:    symbol,
+		OrderType: OrderTypeMarket,
+	}}
+}
+
+// Category sets the product category, linear by default.
+func (b *OrderBuilder) Category(category market.Category) *OrderBuilder {
+	b.req.Category = category
+	return b
+}
+
+// Buy sets the side to Buy.
+func (b *OrderBuilder) Buy() *OrderBuilder {
+	b.req.Side = SideBuy
+	return b
+}
+
+// Sell sets the side to Sell.
+func (b *OrderBuilder) Sell() *OrderBuilder {
+	b.req.Side = SideSell
+	return b
+}
+
+// Side sets the side.
+func (b *OrderBuilder) Side(side Side) *OrderBuilder {
+	b.req.Side = side
+	return b
+}
+
+// Qty sets the order quantity.
+func (b *OrderBuilder) Qty(qty string) *OrderBuilder {
+	b.req.Qty = qty
+	return b
+}
+
+// Price sets the limit price.
+func (b *OrderBuilder) Price(price string) *OrderBuilder {
+	b.req.Price = price
+	return b
+}
+
+// QtyDecimal sets the order quantity from a decimal, avoiding hand-formatted floats.
+func (b *OrderBuilder) QtyDecimal(qty decimal.Decimal) *OrderBuilder {
+	return b.Qty(qty.String())
+}
+
+// PriceDecimal sets the limit price from a decimal.
+func (b *OrderBuilder) PriceDecimal(price decimal.Decimal) *OrderBuilder {
+	return b.Price(price.String())
+}
+
+// MarketUnit sets the coin a spot market order's quantity is expressed in.
+func (b *OrderBuilder) MarketUnit(unit MarketUnit) *OrderBuilder {
+	b.req.MarketUnit = &unit
+	return b
+}
+
+// TimeInForce overrides the time in force.
+func (b *OrderBuilder) TimeInForce(tif TimeInForce) *OrderBuilder {
+	b.req.TimeInForce = tif
+	return b
+}
+
+// PostOnly makes the order maker-only. Bybit cancels it if it would take liquidity.
+func (b *OrderBuilder) PostOnly() *OrderBuilder {
+	return b.TimeInForce(TimeInForcePostOnly)
+}
+
+// IOC makes the order immediate-or-cancel.
+func (b *OrderBuilder) IOC() *OrderBuilder {
+	return b.TimeInForce(TimeInForceIOC)
+}
+
+// FOK makes the order fill-or-kill.
+func (b *OrderBuilder) FOK() *OrderBuilder {
+	return b.TimeInForce(TimeInForceFOK)
+}
+
+// OrderLinkID sets the client order id.
+func (b *OrderBuilder) OrderLinkID(id string) *OrderBuilder {
+	b.req.OrderLinkID = id
+	return b
+}
+
+// PositionIdx sets the position index: 0 in one-way mode, 1 or 2 for the buy or sell side in
+// hedge mode.
+func (b *OrderBuilder) PositionIdx(idx int) *OrderBuilder {
+	b.req.PositionIdx = &idx
+	return b
+}
+
+// ReduceOnly prevents the order from increasing the position.
+func (b *OrderBuilder) ReduceOnly() *OrderBuilder {
+	reduceOnly := true
+	b.req.ReduceOnly = &reduceOnly
+	return b
+}
+
+// CloseOnTrigger lets a conditional close order cancel other orders to free margin.
+func (b *OrderBuilder) CloseOnTrigger() *OrderBuilder {
+	closeOnTrigger := true
+	b.req.CloseOnTrigger = &closeOnTrigger
+	return b
+}
+
+// Leverage borrows for a spot margin order.
+func (b *OrderBuilder) Leverage() *OrderBuilder {
+	b.req.IsLeverage = 1
+	return b
+}
+
+// TriggerPrice turns the order into a conditional order. Derivatives orders also need
+// TriggerDirection.
+func (b *OrderBuilder) TriggerPrice(price string) *OrderBuilder {
+	b.req.TriggerPrice = &price
+	return b
+}
+
+// TriggerPriceDecimal sets the trigger price from a decimal.
+func (b *OrderBuilder) TriggerPriceDecimal(price decimal.Decimal) *OrderBuilder {
+	return b.TriggerPrice(price.String())
+}
+
+// TriggerDirection sets whether the trigger fires when the price rises to (1) or falls to (2)
+// the trigger price.
+func (b *OrderBuilder) TriggerDirection(direction int) *OrderBuilder {
+	b.req.TriggerDirection = &direction
+	return b
+}
+
+// TriggerBy sets the price type the trigger watches.
+func (b *OrderBuilder) TriggerBy(triggerBy TriggerBy) *OrderBuilder {
+	b.req.TriggerBy = &triggerBy
+	return b
+}
+
+// TakeProfit attaches a take profit triggered at price.
+func (b *OrderBuilder) TakeProfit(price string) *OrderBuilder {
+	b.req.TakeProfit = &price
+	return b
+}
+
+// TakeProfitLimit attaches a partial take profit that places a limit order at limitPrice once
+// price is reached.
+func (b *OrderBuilder) TakeProfitLimit(price, limitPrice string) *OrderBuilder {
+	orderType, mode := OrderTypeLimit, "Partial"
+	b.req.TakeProfit = &price
+	b.req.TpLimitPrice = &limitPrice
+	b.req.TpOrderType = &orderType
+	b.req.TpslMode = &mode
+	return b
+}
+
+// StopLoss attaches a stop loss triggered at price.
+func (b *OrderBuilder) StopLoss(price string) *OrderBuilder {
+	b.req.StopLoss = &price
+	return b
+}
+
+// StopLossLimit attaches a partial stop loss that places a limit order at limitPrice once price
+// is reached.
+func (b *OrderBuilder) StopLossLimit(price, limitPrice string) *OrderBuilder {
+	orderType, mode := OrderTypeLimit, "Partial"
+	b.req.StopLoss = &price
+	b.req.SlLimitPrice = &limitPrice
+	b.req.SlOrderType = &orderType
+	b.req.TpslMode = &mode
+	return b
+}
+
+// TpTriggerBy sets the price type the take profit watches.
+func (b *OrderBuilder) TpTriggerBy(triggerBy TriggerBy) *OrderBuilder {
+	b.req.TpTriggerBy = &triggerBy
+	return b
+}
+
+// SlTriggerBy sets the price type the stop loss watches.
+func (b *OrderBuilder) SlTriggerBy(triggerBy TriggerBy) *OrderBuilder {
+	b.req.SlTriggerBy = &triggerBy
+	return b
+}
+
+// SmpType sets the self-match prevention mode.
+func (b *OrderBuilder) SmpType(smpType SmpType) *OrderBuilder {
+	b.req.SmpType = &smpType
+	return b
+}
+
+// Build validates the order and returns the request.
+func (b *OrderBuilder) Build() (*PlaceOrderRequest, error) {
+	if err := b.resolveTrigger(); err != nil {
+		return nil, err
+	}
+	if err := b.validate(); err != nil {
+		return nil, err
+	}
+	req := b.req
+	return &req, nil
+}
+
+func (b *OrderBuilder) validate() error {
+	r := &b.req
+	switch {
+	case r.Symbol == "":
+		return errors.New("symbol is required")
+	case !r.Side.Valid():
+		return errors.New("side is required, call Buy or Sell")
+	case r.Qty == "":
+		return errors.New("qty is required")
+	}
+
+	isSpot := r.Category == market.CategorySpot
+	if r.OrderType == OrderTypeLimit && r.Price == "" {
+		return errors.New("limit orders require a price")
+	}
+	if r.OrderType == OrderTypeMarket {
+		if r.Price != "" {
+			return errors.New("market orders cannot have a price")
+		}
+		if r.TimeInForce == TimeInForcePostOnly {
+			return errors.New("market orders cannot be post-only")
+		}
+	}
+	if r.TimeInForce != "" && !r.TimeInForce.Valid() {
+		return fmt.Errorf("invalid timeInForce %q", r.TimeInForce)
+	}
+	for _, triggerBy := range []*TriggerBy{r.TriggerBy, r.TpTriggerBy, r.SlTriggerBy} {
+		if triggerBy != nil && !triggerBy.Valid() {
+			return fmt.Errorf("invalid trigger price type %q", *triggerBy)
+		}
+	}
+	if r.SmpType != nil && !r.SmpType.Valid() {
+		return fmt.Errorf("invalid smpType %q", *r.SmpType)
+	}
+	if r.MarketUnit != nil && (!isSpot || r.OrderType != OrderTypeMarket) {
+		return errors.New("marketUnit is only supported for spot market orders")
+	}
+	if r.TriggerDirection != nil && r.TriggerPrice == nil {
+		return errors.New("triggerDirection requires triggerPrice")
+	}
+	if r.TriggerPrice != nil && !isSpot && r.TriggerDirection == nil {
+		return fmt.Errorf("%s conditional orders require triggerDirection", r.Category)
+	}
+	if r.TriggerDirection != nil && *r.TriggerDirection != TriggerDirectionRise && *r.TriggerDirection != TriggerDirectionFall {
+		return fmt.Errorf("invalid triggerDirection %d: must be 1 (rise) or 2 (fall)", *r.TriggerDirection)
+	}
+	if r.TriggerBy != nil && r.TriggerPrice == nil {
+		return errors.New("triggerBy requires triggerPrice")
+	}
+	if r.TpTriggerBy != nil && r.TakeProfit == nil {
+		return errors.New("tpTriggerBy requires takeProfit")
+	}
+	if r.SlTriggerBy != nil && r.StopLoss == nil {
+		return errors.New("slTriggerBy requires stopLoss")
+	}
+	if isSpot && (r.ReduceOnly != nil || r.CloseOnTrigger != nil || r.PositionIdx != nil) {
+		return errors.New("reduceOnly, closeOnTrigger and positionIdx are not supported for spot")
+	}
+	if !isSpot && r.IsLeverage != 0 {
+		return fmt.Errorf("isLeverage is only supported for spot, not %s", r.Category)
+	}
+	if r.ReduceOnly != nil && (r.TakeProfit != nil || r.StopLoss != nil) {
+		return errors.New("reduce-only orders cannot carry take profit or stop loss")
+	}
+	return nil
+}
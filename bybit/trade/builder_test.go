@@ -0,0 +1,101 @@
+package trade
+
+import (
+	"testing"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/market"
+	"github.com/cploutarchou/crypto-sdk-suite/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderBuilder(t *testing.T) {
+	req, err := NewLimitOrder("BTCUSDT").Buy().Qty("0.01").Price("30000").PostOnly().TakeProfit("32000").Build()
+	require.NoError(t, err)
+	assert.Equal(t, market.CategoryLinear, req.Category)
+	assert.Equal(t, OrderTypeLimit, req.OrderType)
+	assert.Equal(t, TimeInForcePostOnly, req.TimeInForce)
+	require.NotNil(t, req.TakeProfit)
+	assert.Equal(t, "32000", *req.TakeProfit)
+
+	req, err = NewLimitOrder("BTCUSDT").Sell().Qty("0.01").Price("30000").StopLossLimit("31000", "31100").Build()
+	require.NoError(t, err)
+	assert.Equal(t, "Partial", *req.TpslMode)
+	assert.Equal(t, OrderTypeLimit, *req.SlOrderType)
+}
+
+func TestOrderBuilderValidation(t *testing.T) {
+	tests := map[string]*OrderBuilder{
+		"missing side":                NewLimitOrder("BTCUSDT").Qty("1").Price("1"),
+		"limit without price":         NewLimitOrder("BTCUSDT").Buy().Qty("1"),
+		"market with price":           NewMarketOrder("BTCUSDT").Buy().Qty("1").Price("1"),
+		"direction without trigger":   NewMarketOrder("BTCUSDT").Buy().Qty("1").TriggerDirection(1),
+		"linear trigger no direction": NewMarketOrder("BTCUSDT").Buy().Qty("1").TriggerPrice("100"),
+		"spot reduce only":            NewMarketOrder("BTCUSDT").Category("spot").Sell().Qty("1").ReduceOnly(),
+		"linear leverage":             NewMarketOrder("BTCUSDT").Buy().Qty("1").Leverage(),
+		"unknown side":                NewMarketOrder("BTCUSDT").Side("Long").Qty("1"),
+		"unknown trigger type":        NewLimitOrder("BTCUSDT").Buy().Qty("1").Price("1").TakeProfit("2").TpTriggerBy("Mark"),
+	}
+	for name, builder := range tests {
+		_, err := builder.Build()
+		assert.Error(t, err, name)
+	}
+
+	_, err := NewMarketOrder("BTCUSDT").Category("spot").Buy().Qty("100").TriggerPrice("30000").Build()
+	assert.NoError(t, err)
+}
+
+func TestOrderBuilderDecimals(t *testing.T) {
+	qty := decimal.New(1, -3)
+	price := decimal.RequireFromString("30000.10")
+	req, err := NewLimitOrder("BTCUSDT").Buy().QtyDecimal(qty).PriceDecimal(price).Build()
+	require.NoError(t, err)
+	assert.Equal(t, "0.001", req.Qty)
+	assert.Equal(t, "30000.1", req.Price)
+}
+
+func TestOrderBuilderMarketUnit(t *testing.T) {
+	req, err := NewMarketOrder("BTCUSDT").Category("spot").Buy().Qty("500").MarketUnit(MarketUnitQuoteCoin).Build()
+	require.NoError(t, err)
+	assert.Equal(t, MarketUnitQuoteCoin, *req.MarketUnit)
+	assert.Equal(t, MarketUnitQuoteCoin, ConvertPlaceOrderRequestToParams(req)["marketUnit"])
+
+	_, err = NewMarketOrder("BTCUSDT").Buy().Qty("1").MarketUnit(MarketUnitBaseCoin).Build()
+	assert.Error(t, err, "linear orders have no marketUnit")
+}
+
+func TestConditionalOrderDirections(t *testing.T) {
+	tests := []struct {
+		name    string
+		builder *OrderBuilder
+		want    int
+	}{
+		{"stop buy", NewStopMarketOrder("BTCUSDT", SideBuy, "31000"), TriggerDirectionRise},
+		{"stop sell", NewStopMarketOrder("BTCUSDT", SideSell, "29000"), TriggerDirectionFall},
+		{"stop limit sell", NewStopLimitOrder("BTCUSDT", SideSell, "29000", "28900"), TriggerDirectionFall},
+		{"take profit buy", NewTakeProfitOrder("BTCUSDT", SideBuy, "29000"), TriggerDirectionFall},
+		{"take profit limit sell", NewTakeProfitLimitOrder("BTCUSDT", SideSell, "31000", "31000"), TriggerDirectionRise},
+	}
+	for _, tt := range tests {
+		req, err := tt.builder.Qty("0.01").Build()
+		require.NoError(t, err, tt.name)
+		require.NotNil(t, req.TriggerDirection, tt.name)
+		assert.Equal(t, tt.want, *req.TriggerDirection, tt.name)
+		assert.Equal(t, TriggerByLastPrice, *req.TriggerBy, tt.name)
+	}
+
+	req, err := NewStopLimitOrder("BTCUSDT", SideSell, "29000", "28900").Qty("0.01").TriggerBy(TriggerByMarkPrice).Build()
+	require.NoError(t, err)
+	assert.Equal(t, OrderTypeLimit, req.OrderType)
+	assert.Equal(t, "28900", req.Price)
+	assert.Equal(t, TriggerByMarkPrice, *req.TriggerBy)
+
+	_, err = NewStopMarketOrder("BTCUSDT", SideSell, "29000").Qty("0.01").TriggerDirection(TriggerDirectionRise).Build()
+	assert.Error(t, err)
+
+	req, err = NewTakeProfitOrder("BTCUSDT", SideSell, "31000").Category("spot").Qty("0.01").Build()
+	require.NoError(t, err)
+	assert.Nil(t, req.TriggerDirection)
+	assert.Nil(t, req.TriggerBy)
+	assert.Equal(t, "StopOrder", *req.OrderFilter)
+}
@@ -0,0 +1,110 @@
+package trade
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/market"
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/position"
+	"github.com/cploutarchou/crypto-sdk-suite/decimal"
+)
+
+// CloseOrder builds the reduce-only market order that closes p in the given category. The order
+// carries the position's own index so it targets the right side in hedge mode. It reports false
+// for empty position slots.
+func CloseOrder(category market.Category, p position.Details) (*PlaceOrderRequest, bool) {
+	side := Side(p.Side)
+	if !side.Valid() {
+		return nil, false
+	}
+	if size, err := decimal.NewFromString(p.Size); err != nil || size.Sign() <= 0 {
+		return nil, false
+	}
+	reduceOnly := true
+	positionIdx := p.PositionIdx
+	return &PlaceOrderRequest{
+		Category:    category,
+		Symbol:      p.Symbol,
+		Side:        side.Opposite(),
+		OrderType:   OrderTypeMarket,
+		Qty:         p.Size,
+		TimeInForce: TimeInForceIOC,
+		PositionIdx: &positionIdx,
+		ReduceOnly:  &reduceOnly,
+	}, true
+}
+
+func (t *tradeImpl) ClosePosition(category market.Category, symbol string, positionIdx int) ([]*PlaceOrderResponse, error) {
+	return t.closePosition(t.PlaceOrder, category, symbol, positionIdx, "")
+}
+
+func (t *tradeImpl) ClosePositionAt(category market.Category, symbol string, positionIdx int, price string) ([]*PlaceOrderResponse, error) {
+	if price == "" {
+		return nil, errors.New("price is required")
+	}
+	return t.closePosition(t.PlaceOrder, category, symbol, positionIdx, price)
+}
+
+// placeFunc sends a single order, e.g. Trade.PlaceOrder.
+type placeFunc func(req *PlaceOrderRequest) (*PlaceOrderResponse, error)
+
+// wrapper is implemented by the decorators of this package that send every order through their
+// own PlaceOrder.
+type wrapper interface {
+	unwrap() Trade
+}
+
+// closePositionVia closes positions like t.ClosePositionAt, or t.ClosePosition when price is
+// empty, but sends the orders with place so a decorator's PlaceOrder is not bypassed. Trades
+// that neither are nor wrap the Bybit client close the positions themselves.
+func closePositionVia(t Trade, place placeFunc, category market.Category, symbol string, positionIdx int, price string) ([]*PlaceOrderResponse, error) {
+	for {
+		switch inner := t.(type) {
+		case *tradeImpl:
+			return inner.closePosition(place, category, symbol, positionIdx, price)
+		case wrapper:
+			t = inner.unwrap()
+		default:
+			if price == "" {
+				return t.ClosePosition(category, symbol, positionIdx)
+			}
+			return t.ClosePositionAt(category, symbol, positionIdx, price)
+		}
+	}
+}
+
+func (t *tradeImpl) closePosition(place placeFunc, category market.Category, symbol string, positionIdx int, price string) ([]*PlaceOrderResponse, error) {
+	if category == market.CategorySpot {
+		return nil, errors.New("spot has no positions to close")
+	}
+	if symbol == "" {
+		return nil, errors.New("symbol is required")
+	}
+	if positionIdx < 0 || positionIdx > 2 {
+		return nil, fmt.Errorf("invalid positionIdx %d: must be 0, 1 or 2", positionIdx)
+	}
+	positions, err := position.New(t.client).GetPositionInfo(&position.RequestParams{Category: category, Symbol: symbol})
+	if err != nil {
+		return nil, fmt.Errorf("error fetching %s position: %w", symbol, err)
+	}
+
+	var responses []*PlaceOrderResponse
+	for _, p := range positions.Result.List {
+		if positionIdx != 0 && p.PositionIdx != positionIdx {
+			continue
+		}
+		order, ok := CloseOrder(category, p)
+		if !ok {
+			continue
+		}
+		if price != "" {
+			order.OrderType, order.Price, order.TimeInForce = OrderTypeLimit, price, TimeInForceGTC
+		}
+		res, err := place(order)
+		if err != nil {
+			return responses, fmt.Errorf("error closing %s %s position: %w", p.Symbol, p.Side, err)
+		}
+		responses = append(responses, res)
+	}
+	return responses, nil
+}
@@ -0,0 +1,25 @@
+package trade
+
+import (
+	"testing"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/market"
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/position"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloseOrder(t *testing.T) {
+	order, ok := CloseOrder("inverse", position.Details{Symbol: "BTCUSD", Side: "Sell", Size: "100", PositionIdx: 2})
+	require.True(t, ok)
+	assert.Equal(t, market.CategoryInverse, order.Category)
+	assert.Equal(t, SideBuy, order.Side)
+	assert.Equal(t, OrderTypeMarket, order.OrderType)
+	assert.Equal(t, 2, *order.PositionIdx)
+	assert.True(t, *order.ReduceOnly)
+
+	_, ok = CloseOrder("linear", position.Details{Symbol: "BTCUSDT", Side: "Buy", Size: "0.000"})
+	assert.False(t, ok)
+	_, ok = CloseOrder("linear", position.Details{Symbol: "BTCUSDT", Side: "None", Size: "1"})
+	assert.False(t, ok)
+}
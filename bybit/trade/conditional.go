@@ -0,0 +1,100 @@
+package trade
+
+import (
+	"fmt"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/market"
+)
+
+// triggerKind records which conditional constructor started an OrderBuilder, so Build can derive
+// the trigger direction from the final side.
+type triggerKind int
+
+const (
+	triggerNone triggerKind = iota
+	// triggerStop fires when the price moves against a resting position: buys trigger on a rise,
+	// sells on a fall.
+	triggerStop
+	// triggerTakeProfit fires when the price moves in favour of a position: buys trigger on a
+	// fall, sells on a rise.
+	triggerTakeProfit
+)
+
+// NewStopMarketOrder starts a linear conditional market order that is placed once the last price
+// crosses triggerPrice: a Buy when the price rises to it, a Sell when the price falls to it.
+//
+//	req, err := trade.NewStopMarketOrder("BTCUSDT", trade.SideSell, "29000").Qty("0.01").ReduceOnly().Build()
+func NewStopMarketOrder(symbol string, side Side, triggerPrice string) *OrderBuilder {
+	return newConditionalOrder(NewMarketOrder(symbol), triggerStop, side, triggerPrice)
+}
+
+// NewStopLimitOrder works like NewStopMarketOrder but places a limit order at price once
+// triggerPrice is reached.
+func NewStopLimitOrder(symbol string, side Side, triggerPrice, price string) *OrderBuilder {
+	return newConditionalOrder(NewLimitOrder(symbol).Price(price), triggerStop, side, triggerPrice)
+}
+
+// NewTakeProfitOrder starts a linear conditional market order that is placed once the price
+// reaches a favourable level: a Buy when the price falls to triggerPrice, a Sell when it rises
+// to it.
+func NewTakeProfitOrder(symbol string, side Side, triggerPrice string) *OrderBuilder {
+	return newConditionalOrder(NewMarketOrder(symbol), triggerTakeProfit, side, triggerPrice)
+}
+
+// NewTakeProfitLimitOrder works like NewTakeProfitOrder but places a limit order at price once
+// triggerPrice is reached.
+func NewTakeProfitLimitOrder(symbol string, side Side, triggerPrice, price string) *OrderBuilder {
+	return newConditionalOrder(NewLimitOrder(symbol).Price(price), triggerTakeProfit, side, triggerPrice)
+}
+
+func newConditionalOrder(b *OrderBuilder, kind triggerKind, side Side, triggerPrice string) *OrderBuilder {
+	b.trigger = kind
+	return b.Side(side).TriggerPrice(triggerPrice).TriggerBy(TriggerByLastPrice)
+}
+
+// direction returns the trigger direction of the kind for side.
+func (k triggerKind) direction(side Side) int {
+	rises := side == SideBuy
+	if k == triggerTakeProfit {
+		rises = !rises
+	}
+	if rises {
+		return TriggerDirectionRise
+	}
+	return TriggerDirectionFall
+}
+
+// resolveTrigger fills in the trigger direction of orders started by a conditional constructor.
+// Spot conditional orders have no direction or trigger price type and are sent with orderFilter
+// StopOrder instead; Bybit derives the direction from the last price at placement. An explicit
+// direction that contradicts the order kind is rejected because such an order would never
+// trigger as intended.
+func (b *OrderBuilder) resolveTrigger() error {
+	if b.trigger == triggerNone || !b.req.Side.Valid() {
+		return nil
+	}
+	r := &b.req
+	if r.Category == market.CategorySpot {
+		filter := "StopOrder"
+		r.OrderFilter = &filter
+		r.TriggerDirection = nil
+		r.TriggerBy = nil
+		return nil
+	}
+	want := b.trigger.direction(r.Side)
+	if r.TriggerDirection != nil && *r.TriggerDirection != want {
+		return fmt.Errorf("triggerDirection %d contradicts a %s %s order, which needs %d", *r.TriggerDirection, r.Side, b.trigger, want)
+	}
+	r.TriggerDirection = &want
+	return nil
+}
+
+func (k triggerKind) String() string {
+	switch k {
+	case triggerStop:
+		return "stop"
+	case triggerTakeProfit:
+		return "take-profit"
+	}
+	return "plain"
+}
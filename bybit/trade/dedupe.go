@@ -0,0 +1,232 @@
+package trade
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/market"
+)
+
+// ErrDuplicateOrder is returned by DedupeTrade.PlaceOrder when an order with the same
+// orderLinkId was already placed. The accompanying response carries the ids of that order, so
+// retrying callers can treat it as success.
+var ErrDuplicateOrder = errors.New("order with this orderLinkId was already submitted")
+
+// ErrOrderLinkIDRequired is returned by DedupeTrade.PlaceOrder for orders without an orderLinkId.
+var ErrOrderLinkIDRequired = errors.New("orderLinkId is required for deduplicated orders")
+
+// ErrSubmissionInFlight is returned by DedupeTrade.PlaceOrder while another call is still
+// submitting an order with the same orderLinkId. Retry once that call has returned.
+var ErrSubmissionInFlight = errors.New("order with this orderLinkId is still being submitted")
+
+// retCodeDuplicateOrderLinkID is the retCode Bybit rejects an order with when its orderLinkId
+// was already used. The order under that id exists, so its reservation must be kept.
+const retCodeDuplicateOrderLinkID = 110072
+
+// DedupeRecord is what a DedupeStore remembers about a submitted order. OrderID is empty while
+// the outcome of the submission is unknown, e.g. after a timeout.
+type DedupeRecord struct {
+	Category    market.Category `json:"category"`
+	Symbol      string          `json:"symbol"`
+	OrderLinkID string          `json:"orderLinkId"`
+	OrderID     string          `json:"orderId"`
+	SubmittedAt time.Time       `json:"submittedAt"`
+}
+
+// DedupeStore remembers submitted orders by orderLinkId. Implementations must be safe for
+// concurrent use, and Reserve must be atomic so two callers cannot both reserve the same id.
+type DedupeStore interface {
+	// Reserve stores rec unless its orderLinkId is already known, in which case the stored
+	// record is returned with false.
+	Reserve(rec DedupeRecord) (DedupeRecord, bool, error)
+	// Complete records the order id assigned to a reserved orderLinkId.
+	Complete(orderLinkID, orderID string) error
+	// Release forgets an orderLinkId whose submission was definitely rejected.
+	Release(orderLinkID string) error
+}
+
+// MemoryDedupeStore is an in-process DedupeStore. Records older than TTL are forgotten; a zero
+// TTL keeps them until Release.
+type MemoryDedupeStore struct {
+	TTL time.Duration
+
+	mu      sync.Mutex
+	records map[string]DedupeRecord
+}
+
+// NewMemoryDedupeStore returns an empty store that forgets records after ttl.
+func NewMemoryDedupeStore(ttl time.Duration) *MemoryDedupeStore {
+	return &MemoryDedupeStore{TTL: ttl, records: make(map[string]DedupeRecord)}
+}
+
+func (m *MemoryDedupeStore) Reserve(rec DedupeRecord) (DedupeRecord, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.records == nil {
+		m.records = make(map[string]DedupeRecord)
+	}
+	m.evict(rec.SubmittedAt)
+	if existing, ok := m.records[rec.OrderLinkID]; ok {
+		return existing, false, nil
+	}
+	m.records[rec.OrderLinkID] = rec
+	return rec, true, nil
+}
+
+func (m *MemoryDedupeStore) Complete(orderLinkID, orderID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	rec, ok := m.records[orderLinkID]
+	if !ok {
+		return fmt.Errorf("orderLinkId %s is not reserved", orderLinkID)
+	}
+	rec.OrderID = orderID
+	m.records[orderLinkID] = rec
+	return nil
+}
+
+func (m *MemoryDedupeStore) Release(orderLinkID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.records, orderLinkID)
+	return nil
+}
+
+// evict drops records older than TTL. Callers must hold m.mu.
+func (m *MemoryDedupeStore) evict(now time.Time) {
+	if m.TTL <= 0 {
+		return
+	}
+	for id, rec := range m.records {
+		if now.Sub(rec.SubmittedAt) > m.TTL {
+			delete(m.records, id)
+		}
+	}
+}
+
+// DedupeTrade wraps a Trade so an order is never submitted twice under the same orderLinkId.
+// When a previous submission of the id is still unresolved, e.g. because the request timed out,
+// the open orders and the order history are checked first and the order is only resent if Bybit
+// has no record of it. Concurrent calls for the same id fail with ErrSubmissionInFlight.
+//
+// Only PlaceOrder is deduplicated; every other method passes straight through. Helpers
+// implemented by the wrapped Trade, such as PlaceMarketOrder, call its own PlaceOrder and are
+// not deduplicated; build the request with an orderLinkId and call PlaceOrder instead.
+type DedupeTrade struct {
+	Trade
+	store DedupeStore
+	now   func() time.Time
+
+	mu       sync.Mutex
+	inflight map[string]struct{}
+}
+
+var _ Trade = (*DedupeTrade)(nil)
+
+// NewDedupe wraps t, remembering submissions in store.
+func NewDedupe(t Trade, store DedupeStore) *DedupeTrade {
+	return &DedupeTrade{Trade: t, store: store, now: time.Now}
+}
+
+func (d *DedupeTrade) PlaceOrder(req *PlaceOrderRequest) (*PlaceOrderResponse, error) {
+	if req.OrderLinkID == "" {
+		return nil, ErrOrderLinkIDRequired
+	}
+	if !d.claim(req.OrderLinkID) {
+		return nil, ErrSubmissionInFlight
+	}
+	defer d.unclaim(req.OrderLinkID)
+
+	rec, reserved, err := d.store.Reserve(DedupeRecord{
+		Category:    req.Category,
+		Symbol:      req.Symbol,
+		OrderLinkID: req.OrderLinkID,
+		SubmittedAt: d.now(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reserving orderLinkId: %w", err)
+	}
+	if !reserved {
+		if rec.OrderID == "" {
+			rec.OrderID, err = d.lookup(req)
+			if err != nil {
+				return nil, err
+			}
+			if rec.OrderID == "" {
+				return d.submit(req)
+			}
+			if err := d.store.Complete(rec.OrderLinkID, rec.OrderID); err != nil {
+				return nil, fmt.Errorf("error recording order id: %w", err)
+			}
+		}
+		return duplicateResponse(rec), ErrDuplicateOrder
+	}
+	return d.submit(req)
+}
+
+// claim marks orderLinkID as being submitted by the caller and reports whether no other call
+// was submitting it already. Only the claiming call may complete or release the reservation.
+func (d *DedupeTrade) claim(orderLinkID string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if _, ok := d.inflight[orderLinkID]; ok {
+		return false
+	}
+	if d.inflight == nil {
+		d.inflight = make(map[string]struct{})
+	}
+	d.inflight[orderLinkID] = struct{}{}
+	return true
+}
+
+func (d *DedupeTrade) unclaim(orderLinkID string) {
+	d.mu.Lock()
+	delete(d.inflight, orderLinkID)
+	d.mu.Unlock()
+}
+
+// submit sends a reserved order and records the outcome. The reservation is kept when the
+// outcome is unknown, so a retry checks for the order before resending.
+func (d *DedupeTrade) submit(req *PlaceOrderRequest) (*PlaceOrderResponse, error) {
+	res, err := d.Trade.PlaceOrder(req)
+	if err != nil {
+		var apiErr *client.APIError
+		answered := errors.As(err, &apiErr)
+		if (answered && apiErr.Code == retCodeDuplicateOrderLinkID) || (res != nil && res.RetCode == retCodeDuplicateOrderLinkID) {
+			return res, err
+		}
+		if res != nil || answered {
+			// Bybit answered and rejected the order, so the id may be used again.
+			if releaseErr := d.store.Release(req.OrderLinkID); releaseErr != nil {
+				return res, errors.Join(err, releaseErr)
+			}
+		}
+		return res, err
+	}
+	if err := d.store.Complete(req.OrderLinkID, res.Result.OrderID); err != nil {
+		return res, fmt.Errorf("order placed but its id was not recorded: %w", err)
+	}
+	return res, nil
+}
+
+// lookup returns the id of the order Bybit knows under req's orderLinkId, or "" if there is none.
+func (d *DedupeTrade) lookup(req *PlaceOrderRequest) (string, error) {
+	order, err := findOrder(d.Trade, req.Category, req.Symbol, "", req.OrderLinkID)
+	if err != nil {
+		return "", fmt.Errorf("error checking for a previous submission: %w", err)
+	}
+	if order == nil {
+		return "", nil
+	}
+	return order.OrderID, nil
+}
+
+func duplicateResponse(rec DedupeRecord) *PlaceOrderResponse {
+	res := &PlaceOrderResponse{}
+	res.Result.OrderID = rec.OrderID
+	res.Result.OrderLinkID = rec.OrderLinkID
+	return res
+}
@@ -0,0 +1,188 @@
+package trade
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// flakyTrade accepts every order but can report a timeout for it, like a request whose
+// response was lost.
+type flakyTrade struct {
+	Trade
+	placed  []OrderDetails
+	timeout bool
+	reject  bool
+	resting bool // placed orders are reported as open rather than in the history
+}
+
+func (f *flakyTrade) PlaceOrder(req *PlaceOrderRequest) (*PlaceOrderResponse, error) {
+	if f.reject {
+		return &PlaceOrderResponse{RetCode: 10001}, errors.New("API returned error: params error")
+	}
+	order := OrderDetails{OrderID: "id-" + req.OrderLinkID, OrderLinkID: req.OrderLinkID}
+	f.placed = append(f.placed, order)
+	if f.timeout {
+		return nil, errors.New("context deadline exceeded")
+	}
+	res := &PlaceOrderResponse{}
+	res.Result.OrderID, res.Result.OrderLinkID = order.OrderID, order.OrderLinkID
+	return res, nil
+}
+
+func (f *flakyTrade) GetOpenOrders(req *GetOpenOrdersRequest) (*GetOpenOrdersResponse, error) {
+	res := &GetOpenOrdersResponse{}
+	for _, order := range f.placed {
+		if f.resting && order.OrderLinkID == *req.OrderLinkID {
+			res.Result.List = append(res.Result.List, order)
+		}
+	}
+	return res, nil
+}
+
+func (f *flakyTrade) GetOrderHistory(req *GetOrderHistoryRequest) (*GetOrderHistoryResponse, error) {
+	res := &GetOrderHistoryResponse{}
+	for _, order := range f.placed {
+		if f.resting {
+			break
+		}
+		if order.OrderLinkID == *req.OrderLinkID {
+			res.Result.List = append(res.Result.List, order)
+		}
+	}
+	return res, nil
+}
+
+func TestDedupeAfterTimeout(t *testing.T) {
+	fake := &flakyTrade{timeout: true}
+	d := NewDedupe(fake, NewMemoryDedupeStore(time.Hour))
+	req, err := NewLimitOrder("BTCUSDT").Buy().Qty("0.01").Price("30000").OrderLinkID("dedupe-1").Build()
+	require.NoError(t, err)
+
+	_, err = d.PlaceOrder(req)
+	require.Error(t, err)
+
+	fake.timeout = false
+	res, err := d.PlaceOrder(req)
+	assert.True(t, errors.Is(err, ErrDuplicateOrder))
+	assert.Equal(t, "id-dedupe-1", res.Result.OrderID)
+	assert.Len(t, fake.placed, 1, "the order must not be submitted twice")
+
+	res, err = d.PlaceOrder(req)
+	assert.True(t, errors.Is(err, ErrDuplicateOrder))
+	assert.Equal(t, "id-dedupe-1", res.Result.OrderID)
+	assert.Len(t, fake.placed, 1)
+}
+
+func TestDedupeFindsRestingOrders(t *testing.T) {
+	fake := &flakyTrade{timeout: true, resting: true}
+	d := NewDedupe(fake, NewMemoryDedupeStore(time.Hour))
+	req, err := NewLimitOrder("BTCUSDT").Buy().Qty("0.01").Price("30000").OrderLinkID("dedupe-4").Build()
+	require.NoError(t, err)
+
+	_, err = d.PlaceOrder(req)
+	require.Error(t, err)
+
+	fake.timeout = false
+	res, err := d.PlaceOrder(req)
+	assert.True(t, errors.Is(err, ErrDuplicateOrder))
+	assert.Equal(t, "id-dedupe-4", res.Result.OrderID)
+	assert.Len(t, fake.placed, 1, "an open order must not be submitted again")
+}
+
+// slowTrade blocks in PlaceOrder until release is closed and rejects repeated orderLinkIds the
+// way Bybit does.
+type slowTrade struct {
+	Trade
+	entered chan struct{}
+	release chan struct{}
+
+	mu     sync.Mutex
+	placed map[string]bool
+}
+
+func (s *slowTrade) PlaceOrder(req *PlaceOrderRequest) (*PlaceOrderResponse, error) {
+	s.entered <- struct{}{}
+	<-s.release
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.placed[req.OrderLinkID] {
+		return &PlaceOrderResponse{RetCode: retCodeDuplicateOrderLinkID}, apiError(retCodeDuplicateOrderLinkID, "OrderLinkedID is duplicate")
+	}
+	s.placed[req.OrderLinkID] = true
+	res := &PlaceOrderResponse{}
+	res.Result.OrderID, res.Result.OrderLinkID = "id-"+req.OrderLinkID, req.OrderLinkID
+	return res, nil
+}
+
+func TestDedupeConcurrentSubmissions(t *testing.T) {
+	fake := &slowTrade{entered: make(chan struct{}, 2), release: make(chan struct{}), placed: map[string]bool{}}
+	d := NewDedupe(fake, NewMemoryDedupeStore(time.Hour))
+	req, err := NewMarketOrder("BTCUSDT").Buy().Qty("0.01").OrderLinkID("dedupe-5").Build()
+	require.NoError(t, err)
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := d.PlaceOrder(req)
+		done <- err
+	}()
+	<-fake.entered
+
+	_, err = d.PlaceOrder(req)
+	assert.Equal(t, ErrSubmissionInFlight, err)
+
+	close(fake.release)
+	require.NoError(t, <-done, "the first submission must keep its reservation")
+	res, err := d.PlaceOrder(req)
+	assert.True(t, errors.Is(err, ErrDuplicateOrder))
+	assert.Equal(t, "id-dedupe-5", res.Result.OrderID)
+	assert.Len(t, fake.entered, 0, "the order must reach Bybit once")
+}
+
+func TestDedupeResubmitsUnknownOrders(t *testing.T) {
+	store := NewMemoryDedupeStore(0)
+	_, _, err := store.Reserve(DedupeRecord{OrderLinkID: "dedupe-2"})
+	require.NoError(t, err)
+
+	fake := &flakyTrade{}
+	d := NewDedupe(fake, store)
+	req, err := NewMarketOrder("BTCUSDT").Sell().Qty("0.01").OrderLinkID("dedupe-2").Build()
+	require.NoError(t, err)
+	res, err := d.PlaceOrder(req)
+	require.NoError(t, err)
+	assert.Equal(t, "id-dedupe-2", res.Result.OrderID)
+	assert.Len(t, fake.placed, 1)
+}
+
+func TestDedupeReleasesRejectedOrders(t *testing.T) {
+	fake := &flakyTrade{reject: true}
+	d := NewDedupe(fake, NewMemoryDedupeStore(time.Hour))
+	req, err := NewMarketOrder("BTCUSDT").Sell().Qty("0.01").OrderLinkID("dedupe-3").Build()
+	require.NoError(t, err)
+	_, err = d.PlaceOrder(req)
+	require.Error(t, err)
+
+	fake.reject = false
+	_, err = d.PlaceOrder(req)
+	require.NoError(t, err)
+	assert.Len(t, fake.placed, 1)
+
+	_, err = d.PlaceOrder(&PlaceOrderRequest{Category: "linear", Symbol: "BTCUSDT"})
+	assert.Equal(t, ErrOrderLinkIDRequired, err)
+}
+
+func TestMemoryDedupeStoreExpires(t *testing.T) {
+	store := NewMemoryDedupeStore(time.Minute)
+	start := time.Now()
+	_, ok, err := store.Reserve(DedupeRecord{OrderLinkID: "a", SubmittedAt: start})
+	require.NoError(t, err)
+	assert.True(t, ok)
+	_, ok, _ = store.Reserve(DedupeRecord{OrderLinkID: "a", SubmittedAt: start.Add(30 * time.Second)})
+	assert.False(t, ok)
+	_, ok, _ = store.Reserve(DedupeRecord{OrderLinkID: "a", SubmittedAt: start.Add(2 * time.Minute)})
+	assert.True(t, ok)
+}
@@ -0,0 +1,145 @@
+package trade
+
+// OrderStatus is the lifecycle state Bybit reports for an order.
+type OrderStatus string
+
+const (
+	OrderStatusNew                     OrderStatus = "New"
+	OrderStatusPartiallyFilled         OrderStatus = "PartiallyFilled"
+	OrderStatusUntriggered             OrderStatus = "Untriggered"
+	OrderStatusRejected                OrderStatus = "Rejected"
+	OrderStatusPartiallyFilledCanceled OrderStatus = "PartiallyFilledCanceled"
+	OrderStatusFilled                  OrderStatus = "Filled"
+	OrderStatusCancelled               OrderStatus = "Cancelled"
+	OrderStatusTriggered               OrderStatus = "Triggered"
+	OrderStatusDeactivated             OrderStatus = "Deactivated"
+)
+
+// IsFinal reports whether the order can no longer change state.
+func (s OrderStatus) IsFinal() bool {
+	switch s {
+	case OrderStatusRejected, OrderStatusPartiallyFilledCanceled, OrderStatusFilled,
+		OrderStatusCancelled, OrderStatusDeactivated:
+		return true
+	}
+	return false
+}
+
+// ExecType identifies what produced an execution.
+type ExecType string
+
+const (
+	ExecTypeTrade        ExecType = "Trade"
+	ExecTypeAdlTrade     ExecType = "AdlTrade"
+	ExecTypeFunding      ExecType = "Funding"
+	ExecTypeBustTrade    ExecType = "BustTrade"
+	ExecTypeDelivery     ExecType = "Delivery"
+	ExecTypeSettle       ExecType = "Settle"
+	ExecTypeBlockTrade   ExecType = "BlockTrade"
+	ExecTypeMovePosition ExecType = "MovePosition"
+)
+
+// Side is the direction of an order.
+type Side string
+
+const (
+	SideBuy  Side = "Buy"
+	SideSell Side = "Sell"
+)
+
+// Valid reports whether s is a side Bybit accepts.
+func (s Side) Valid() bool {
+	return s == SideBuy || s == SideSell
+}
+
+// Opposite returns the side that closes a position opened on s.
+func (s Side) Opposite() Side {
+	if s == SideBuy {
+		return SideSell
+	}
+	return SideBuy
+}
+
+// OrderType is the execution type of an order, also used for TP/SL orders.
+type OrderType string
+
+const (
+	OrderTypeLimit  OrderType = "Limit"
+	OrderTypeMarket OrderType = "Market"
+)
+
+// Valid reports whether t is an order type Bybit accepts.
+func (t OrderType) Valid() bool {
+	return t == OrderTypeLimit || t == OrderTypeMarket
+}
+
+// TimeInForce controls how long an order rests on the book.
+type TimeInForce string
+
+const (
+	TimeInForceGTC      TimeInForce = "GTC"
+	TimeInForceIOC      TimeInForce = "IOC"
+	TimeInForceFOK      TimeInForce = "FOK"
+	TimeInForcePostOnly TimeInForce = "PostOnly"
+	// TimeInForceRPI places a retail price improvement order, available to approved market makers.
+	TimeInForceRPI TimeInForce = "RPI"
+)
+
+// Valid reports whether t is a time in force Bybit accepts.
+func (t TimeInForce) Valid() bool {
+	switch t {
+	case TimeInForceGTC, TimeInForceIOC, TimeInForceFOK, TimeInForcePostOnly, TimeInForceRPI:
+		return true
+	}
+	return false
+}
+
+// TriggerBy is the price type a conditional order or TP/SL watches.
+type TriggerBy string
+
+const (
+	TriggerByLastPrice  TriggerBy = "LastPrice"
+	TriggerByIndexPrice TriggerBy = "IndexPrice"
+	TriggerByMarkPrice  TriggerBy = "MarkPrice"
+)
+
+// Valid reports whether t is a trigger price type Bybit accepts.
+func (t TriggerBy) Valid() bool {
+	return t == TriggerByLastPrice || t == TriggerByIndexPrice || t == TriggerByMarkPrice
+}
+
+// Trigger directions of a conditional derivatives order.
+const (
+	// TriggerDirectionRise fires when the price rises to the trigger price.
+	TriggerDirectionRise = 1
+	// TriggerDirectionFall fires when the price falls to the trigger price.
+	TriggerDirectionFall = 2
+)
+
+// SmpType is the self-match prevention mode of an order.
+type SmpType string
+
+const (
+	SmpTypeNone        SmpType = "None"
+	SmpTypeCancelMaker SmpType = "CancelMaker"
+	SmpTypeCancelTaker SmpType = "CancelTaker"
+	SmpTypeCancelBoth  SmpType = "CancelBoth"
+)
+
+// Valid reports whether t is a self-match prevention mode Bybit accepts.
+func (t SmpType) Valid() bool {
+	switch t {
+	case SmpTypeNone, SmpTypeCancelMaker, SmpTypeCancelTaker, SmpTypeCancelBoth:
+		return true
+	}
+	return false
+}
+
+// MarketUnit selects which coin the quantity of a spot market order is expressed in. Bybit
+// defaults to the quote coin for buys and the base coin for sells.
+type MarketUnit string
+
+const (
+	MarketUnitBaseCoin  MarketUnit = "baseCoin"
+	MarketUnitQuoteCoin MarketUnit = "quoteCoin"
+)
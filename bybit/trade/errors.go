@@ -0,0 +1,34 @@
+package trade
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+)
+
+// ErrOrderNotFound is returned when the order does not exist or is no longer open. Bybit reports
+// orders that filled just before the request the same way, so callers cancelling an order can
+// usually treat it as done.
+var ErrOrderNotFound = errors.New("order not found")
+
+// ErrOrderAlreadyClosed is returned when the order has already been filled or cancelled.
+var ErrOrderAlreadyClosed = errors.New("order already filled or cancelled")
+
+// knownErrors maps retCodes that callers commonly want to match on to sentinel errors.
+var knownErrors = map[int]error{
+	110001: ErrOrderNotFound,
+	110008: ErrOrderAlreadyClosed,
+	110010: ErrOrderAlreadyClosed,
+	170213: ErrOrderNotFound,
+}
+
+// apiError converts a non-zero retCode into an error. Known codes wrap their sentinel as well as
+// the *client.APIError so both errors.Is and errors.As work on the result.
+func apiError(code int, msg string) error {
+	err := &client.APIError{Code: code, Msg: msg}
+	if sentinel, ok := knownErrors[code]; ok {
+		return fmt.Errorf("%w: %w", sentinel, err)
+	}
+	return err
+}
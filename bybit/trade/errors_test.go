@@ -0,0 +1,24 @@
+package trade
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIError(t *testing.T) {
+	err := apiError(110001, "order not exists or too late to cancel")
+	assert.ErrorIs(t, err, ErrOrderNotFound)
+	var apiErr *client.APIError
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, 110001, apiErr.Code)
+
+	assert.ErrorIs(t, apiError(170213, "Order does not exist."), ErrOrderNotFound)
+	assert.ErrorIs(t, apiError(110008, "order has been finished or canceled"), ErrOrderAlreadyClosed)
+
+	err = apiError(10001, "params error")
+	assert.NotErrorIs(t, err, ErrOrderNotFound)
+	assert.NotErrorIs(t, err, ErrOrderAlreadyClosed)
+}
@@ -1,10 +1,11 @@
 package trade
 
 import (
-	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/market"
 )
 
 // ConvertAmendOrderRequestToParams converts an AmendOrderRequest to a client.Params map.
@@ -154,11 +155,14 @@ func ConvertGetOrderHistoryRequestToParams(req *GetOrderHistoryRequest) client.P
 	if req.OrderID != nil {
 		params["orderId"] = *req.OrderID
 	}
+	if req.OrderLinkID != nil {
+		params["orderLinkId"] = *req.OrderLinkID
+	}
 	if req.OrderFilter != nil {
 		params["orderFilter"] = *req.OrderFilter
 	}
 	if req.OrderStatus != nil {
-		params["orderStatus"] = *req.OrderStatus
+		params["orderStatus"] = string(*req.OrderStatus)
 	}
 	if req.StartTime != nil {
 		params["startTime"] = strconv.FormatInt(*req.StartTime, 10)
@@ -197,7 +201,7 @@ func ConvertGetTradeHistoryRequestToParams(req *GetTradeHistoryRequest) client.P
 		params["endTime"] = strconv.FormatInt(*req.EndTime, 10)
 	}
 	if req.ExecType != nil {
-		params["execType"] = *req.ExecType
+		params["execType"] = string(*req.ExecType)
 	}
 	if req.Limit != nil {
 		params["limit"] = strconv.Itoa(*req.Limit)
@@ -208,165 +212,99 @@ func ConvertGetTradeHistoryRequestToParams(req *GetTradeHistoryRequest) client.P
 	return params
 }
 
+// ConvertBatchPlaceOrderRequestToParams builds the JSON body for a batch place request. The
+// orders are sent as a nested request array, which is what the batch endpoints expect.
 func ConvertBatchPlaceOrderRequestToParams(req *BatchPlaceOrderRequest) client.Params {
-	params := client.Params{}
-	params["category"] = req.Category
-
-	for i, order := range req.Request {
-		prefix := fmt.Sprintf("request[%d].", i)
-		params[prefix+"symbol"] = order.Symbol
-		params[prefix+"side"] = order.Side
-		params[prefix+"orderType"] = order.OrderType
-		params[prefix+"qty"] = order.Qty
-
-		if order.Price != nil {
-			params[prefix+"price"] = *order.Price
-		}
-		if order.TriggerDirection != nil {
-			params[prefix+"triggerDirection"] = strconv.Itoa(*order.TriggerDirection)
-		}
-		if order.TriggerPrice != nil {
-			params[prefix+"triggerPrice"] = *order.TriggerPrice
-		}
-		if order.TriggerBy != nil {
-			params[prefix+"triggerBy"] = *order.TriggerBy
-		}
-		if order.OrderIv != nil {
-			params[prefix+"orderIv"] = *order.OrderIv
-		}
-		if order.TimeInForce != nil {
-			params[prefix+"timeInForce"] = *order.TimeInForce
-		}
-		if order.PositionIdx != nil {
-			params[prefix+"positionIdx"] = strconv.Itoa(*order.PositionIdx)
-		}
-		if order.OrderLinkID != nil {
-			params[prefix+"orderLinkId"] = *order.OrderLinkID
-		}
-		if order.TakeProfit != nil {
-			params[prefix+"takeProfit"] = *order.TakeProfit
-		}
-		if order.StopLoss != nil {
-			params[prefix+"stopLoss"] = *order.StopLoss
-		}
-		if order.TpTriggerBy != nil {
-			params[prefix+"tpTriggerBy"] = *order.TpTriggerBy
-		}
-		if order.SlTriggerBy != nil {
-			params[prefix+"slTriggerBy"] = *order.SlTriggerBy
-		}
-		if order.ReduceOnly != nil {
-			params[prefix+"reduceOnly"] = strconv.FormatBool(*order.ReduceOnly)
-		}
-		if order.CloseOnTrigger != nil {
-			params[prefix+"closeOnTrigger"] = strconv.FormatBool(*order.CloseOnTrigger)
-		}
-		if order.SmpType != nil {
-			params[prefix+"smpType"] = *order.SmpType
-		}
-		if order.Mmp != nil {
-			params[prefix+"mmp"] = strconv.FormatBool(*order.Mmp)
-		}
-		if order.TpslMode != nil {
-			params[prefix+"tpslMode"] = *order.TpslMode
-		}
-		if order.TpLimitPrice != nil {
-			params[prefix+"tpLimitPrice"] = *order.TpLimitPrice
-		}
-		if order.SlLimitPrice != nil {
-			params[prefix+"slLimitPrice"] = *order.SlLimitPrice
-		}
-		if order.TpOrderType != nil {
-			params[prefix+"tpOrderType"] = *order.TpOrderType
-		}
-		if order.SlOrderType != nil {
-			params[prefix+"slOrderType"] = *order.SlOrderType
-		}
+	return client.Params{
+		"category": req.Category,
+		"request":  req.Request,
 	}
-
-	return params
 }
-func ConvertBatchAmendOrderRequestToParams(req *BatchAmendOrderRequest) client.Params {
-	params := client.Params{}
-	params["category"] = req.Category
-
-	for i, order := range req.Request {
-		base := fmt.Sprintf("request[%d].", i)
-		params[base+"symbol"] = order.Symbol
 
-		if order.OrderID != nil {
-			params[base+"orderId"] = *order.OrderID
-		}
-		if order.OrderLinkID != nil {
-			params[base+"orderLinkId"] = *order.OrderLinkID
-		}
-		if order.OrderIv != nil {
-			params[base+"orderIv"] = *order.OrderIv
-		}
-		if order.TriggerPrice != nil {
-			params[base+"triggerPrice"] = *order.TriggerPrice
-		}
-		if order.Qty != nil {
-			params[base+"qty"] = *order.Qty
-		}
-		if order.Price != nil {
-			params[base+"price"] = *order.Price
-		}
-		if order.TpslMode != nil {
-			params[base+"tpslMode"] = *order.TpslMode
-		}
-		if order.TakeProfit != nil {
-			params[base+"takeProfit"] = *order.TakeProfit
-		}
-		if order.StopLoss != nil {
-			params[base+"stopLoss"] = *order.StopLoss
-		}
-		if order.TpTriggerBy != nil {
-			params[base+"tpTriggerBy"] = *order.TpTriggerBy
-		}
-		if order.SlTriggerBy != nil {
-			params[base+"slTriggerBy"] = *order.SlTriggerBy
-		}
-		if order.TriggerBy != nil {
-			params[base+"triggerBy"] = *order.TriggerBy
-		}
-		if order.TpLimitPrice != nil {
-			params[base+"tpLimitPrice"] = *order.TpLimitPrice
-		}
-		if order.SlLimitPrice != nil {
-			params[base+"slLimitPrice"] = *order.SlLimitPrice
-		}
+// ConvertBatchAmendOrderRequestToParams builds the JSON body for a batch amend request. Each
+// item uses the same fields as a single amend, without the category.
+func ConvertBatchAmendOrderRequestToParams(req *BatchAmendOrderRequest) client.Params {
+	items := make([]client.Params, len(req.Request))
+	for i := range req.Request {
+		items[i] = ConvertAmendOrderRequestToParams(&req.Request[i])
+		delete(items[i], "category")
+	}
+	return client.Params{
+		"category": req.Category,
+		"request":  items,
 	}
-
-	return params
 }
 
-// ConvertBatchCancelOrderRequestToParams creates a Params representation for logging/debugging.
+// ConvertBatchCancelOrderRequestToParams builds the JSON body for a batch cancel request. Each
+// item may reference its order by orderId or orderLinkId.
 func ConvertBatchCancelOrderRequestToParams(req *BatchCancelOrderRequest) client.Params {
-	params := client.Params{}
-	params["category"] = req.Category
-
-	for i, orderReq := range req.Request {
-		index := fmt.Sprintf("request[%d]", i)
-		params[index+".symbol"] = orderReq.Symbol
-
-		if orderReq.OrderID != nil {
-			params[index+".orderId"] = *orderReq.OrderID
-		}
-		if orderReq.OrderLinkID != nil {
-			params[index+".orderLinkId"] = *orderReq.OrderLinkID
-		}
-		if orderReq.OrderFilter != nil {
-			params[index+".orderFilter"] = *orderReq.OrderFilter
-		}
+	items := make([]client.Params, len(req.Request))
+	for i := range req.Request {
+		items[i] = ConvertCancelOrderRequestToParams(&req.Request[i])
+		delete(items[i], "category")
+	}
+	return client.Params{
+		"category": req.Category,
+		"request":  items,
 	}
-
-	return params
 }
 
 // NewDCPParams creates a new Params map for setting the DCP time window.
 func NewDCPParams(timeWindow int) client.Params {
 	params := make(client.Params)
-	params["timeWindow"] = strconv.Itoa(timeWindow) // Convert int to string
+	params["timeWindow"] = timeWindow
 	return params
 }
+
+// historyWindows splits the millisecond range [start, end] into consecutive windows no longer
+// than size and not overlapping, newest first to match the order Bybit returns records in.
+func historyWindows(start, end int64, size time.Duration) [][2]int64 {
+	step := size.Milliseconds()
+	var windows [][2]int64
+	for to := end; to > start; {
+		from := to - step
+		if from < start {
+			from = start
+		}
+		windows = append(windows, [2]int64{from, to})
+		to = from - 1
+	}
+	if len(windows) == 0 {
+		windows = append(windows, [2]int64{start, end})
+	}
+	return windows
+}
+
+// findOrder returns the order with orderID, or with orderLinkID when orderID is empty. Orders
+// that have left the open orders are looked up in the order history. It returns nil when Bybit
+// knows neither.
+func findOrder(t Trade, category market.Category, symbol, orderID, orderLinkID string) (*OrderDetails, error) {
+	open := &GetOpenOrdersRequest{Category: category, Symbol: &symbol}
+	history := &GetOrderHistoryRequest{Category: category, Symbol: &symbol}
+	if orderID != "" {
+		open.OrderID, history.OrderID = &orderID, &orderID
+	} else {
+		open.OrderLinkID, history.OrderLinkID = &orderLinkID, &orderLinkID
+	}
+	match := func(list []OrderDetails) *OrderDetails {
+		for i := range list {
+			if (orderID != "" && list[i].OrderID == orderID) || (orderID == "" && list[i].OrderLinkID == orderLinkID) {
+				return &list[i]
+			}
+		}
+		return nil
+	}
+
+	res, err := t.GetOpenOrders(open)
+	if err != nil {
+		return nil, err
+	}
+	if order := match(res.Result.List); order != nil {
+		return order, nil
+	}
+	past, err := t.GetOrderHistory(history)
+	if err != nil {
+		return nil, err
+	}
+	return match(past.Result.List), nil
+}
@@ -0,0 +1,53 @@
+package trade
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistoryWindows(t *testing.T) {
+	day := (24 * time.Hour).Milliseconds()
+
+	windows := historyWindows(0, 10*day, 7*24*time.Hour)
+	assert.Equal(t, [][2]int64{{3 * day, 10 * day}, {0, 3*day - 1}}, windows)
+
+	windows = historyWindows(0, day, 7*24*time.Hour)
+	assert.Equal(t, [][2]int64{{0, day}}, windows)
+
+	windows = historyWindows(day, day, 7*24*time.Hour)
+	assert.Equal(t, [][2]int64{{day, day}}, windows)
+}
+
+func TestOrderStatusIsFinal(t *testing.T) {
+	assert.True(t, OrderStatusFilled.IsFinal())
+	assert.True(t, OrderStatusCancelled.IsFinal())
+	assert.False(t, OrderStatusNew.IsFinal())
+	assert.False(t, OrderStatusUntriggered.IsFinal())
+}
+
+func TestExecutionDecode(t *testing.T) {
+	body := `{"symbol":"BTCUSDT","execFee":"-0.0006","execPrice":"30000.5","execQty":"0.002","execType":"Trade","execValue":"60.001","feeRate":"-0.0001","isMaker":true}`
+	var execution Execution
+	require.NoError(t, json.Unmarshal([]byte(body), &execution))
+	assert.Equal(t, ExecTypeTrade, execution.ExecType)
+	assert.Equal(t, "-0.0006", execution.ExecFee.String())
+	assert.Equal(t, -1, execution.FeeRate.Sign())
+	assert.Equal(t, "0.002", execution.ExecQty.String())
+}
+
+func TestEnumsMarshalAsStrings(t *testing.T) {
+	triggerBy := TriggerByMarkPrice
+	body, err := json.Marshal(OrderRequest{Symbol: "BTCUSDT", Side: SideBuy, OrderType: OrderTypeMarket, Qty: "1", TriggerBy: &triggerBy})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"symbol":"BTCUSDT","side":"Buy","orderType":"Market","qty":"1","triggerBy":"MarkPrice"}`, string(body))
+
+	var order OrderDetails
+	require.NoError(t, json.Unmarshal([]byte(`{"side":"Sell","orderType":"Limit","timeInForce":"PostOnly"}`), &order))
+	assert.Equal(t, SideSell, order.Side)
+	assert.Equal(t, SideBuy, order.Side.Opposite())
+	assert.Equal(t, TimeInForcePostOnly, order.TimeInForce)
+}
@@ -0,0 +1,291 @@
+package trade
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+)
+
+// JournalKind is the type of a journal entry.
+type JournalKind string
+
+const (
+	// JournalIntent is written before a request is sent.
+	JournalIntent JournalKind = "intent"
+	// JournalResponse is written after the API answered, including non-zero retCodes.
+	JournalResponse JournalKind = "response"
+	// JournalError is written when the request failed without a response, e.g. on a timeout.
+	// The request may still have reached the exchange, so it does not resolve the intent.
+	JournalError JournalKind = "error"
+	// JournalOrderUpdate and JournalExecution record websocket pushes.
+	JournalOrderUpdate JournalKind = "order"
+	JournalExecution   JournalKind = "execution"
+)
+
+// JournalEntry is one record of the order journal. Responses and errors reference their intent
+// through Ref.
+type JournalEntry struct {
+	Seq   int64           `json:"seq"`
+	Time  time.Time       `json:"time"`
+	Kind  JournalKind     `json:"kind"`
+	Op    string          `json:"op,omitempty"`
+	Ref   int64           `json:"ref,omitempty"`
+	Data  json.RawMessage `json:"data,omitempty"`
+	Error string          `json:"error,omitempty"`
+}
+
+// Journal is an append-only record of what the SDK sent and received.
+type Journal interface {
+	// Append durably records entry, assigning its Seq and Time.
+	Append(entry *JournalEntry) error
+}
+
+// FileJournal appends entries as JSON lines to a file and syncs it after every write, so an
+// intent is on disk before its request leaves the process.
+type FileJournal struct {
+	mu   sync.Mutex
+	file *os.File
+	seq  int64
+}
+
+var _ Journal = (*FileJournal)(nil)
+
+// OpenFileJournal opens or creates the journal at path and continues its sequence numbers. A
+// torn final line is truncated so new entries start on a line of their own.
+func OpenFileJournal(path string) (*FileJournal, error) {
+	entries, err := ReadJournal(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	if err := truncateTornLine(path); err != nil {
+		return nil, err
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	j := &FileJournal{file: file}
+	if len(entries) > 0 {
+		j.seq = entries[len(entries)-1].Seq
+	}
+	return j, nil
+}
+
+func (j *FileJournal) Append(entry *JournalEntry) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	entry.Seq = j.seq + 1
+	if entry.Time.IsZero() {
+		entry.Time = time.Now()
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("error encoding journal entry: %w", err)
+	}
+	if _, err := j.file.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("error writing journal: %w", err)
+	}
+	if err := j.file.Sync(); err != nil {
+		return fmt.Errorf("error syncing journal: %w", err)
+	}
+	j.seq = entry.Seq
+	return nil
+}
+
+// truncateTornLine removes anything after the last newline of the file at path.
+func truncateTornLine(path string) error {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	end := bytes.LastIndexByte(data, '\n') + 1
+	if end == len(data) {
+		return nil
+	}
+	return os.Truncate(path, int64(end))
+}
+
+// Close closes the journal file.
+func (j *FileJournal) Close() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.file.Close()
+}
+
+// ReadJournal returns every entry of the journal at path. A torn final line left by a crash
+// during a write is ignored.
+func ReadJournal(path string) ([]JournalEntry, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var entries []JournalEntry
+	reader := bufio.NewReader(file)
+	for line := 1; ; line++ {
+		data, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			// Anything after the last newline was never completely written.
+			return entries, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		var entry JournalEntry
+		if err := json.Unmarshal(data, &entry); err != nil {
+			return nil, fmt.Errorf("error parsing journal line %d: %w", line, err)
+		}
+		entries = append(entries, entry)
+	}
+}
+
+// Unresolved returns the intents that have no response entry, i.e. requests whose outcome is
+// unknown after a crash or a transport error. Check them against the exchange before
+// resubmitting.
+func Unresolved(entries []JournalEntry) []JournalEntry {
+	resolved := make(map[int64]bool)
+	for _, entry := range entries {
+		if entry.Kind == JournalResponse {
+			resolved[entry.Ref] = true
+		}
+	}
+	var pending []JournalEntry
+	for _, entry := range entries {
+		if entry.Kind == JournalIntent && !resolved[entry.Seq] {
+			pending = append(pending, entry)
+		}
+	}
+	return pending
+}
+
+// JournaledTrade wraps a Trade so every mutating request is journaled before it is sent and its
+// outcome after it returns. A request is not sent when its intent cannot be written. Feed
+// websocket pushes to Update and UpdateExecution to record them as well. Helpers implemented
+// by the wrapped Trade, such as PlaceMarketOrder, call its own PlaceOrder and are not journaled;
+// build the request and call PlaceOrder on the JournaledTrade instead.
+type JournaledTrade struct {
+	Trade
+	journal Journal
+
+	// OnError is called when an outcome or update cannot be journaled. Errors are logged when it
+	// is nil.
+	OnError func(err error)
+}
+
+var _ Trade = (*JournaledTrade)(nil)
+
+// NewJournaled wraps t, recording to journal.
+func NewJournaled(t Trade, journal Journal) *JournaledTrade {
+	return &JournaledTrade{Trade: t, journal: journal}
+}
+
+func (j *JournaledTrade) PlaceOrder(req *PlaceOrderRequest) (*PlaceOrderResponse, error) {
+	return journaled(j, "PlaceOrder", req, j.Trade.PlaceOrder)
+}
+
+func (j *JournaledTrade) AmendOrder(req *AmendOrderRequest) (*AmendOrderResponse, error) {
+	return journaled(j, "AmendOrder", req, j.Trade.AmendOrder)
+}
+
+func (j *JournaledTrade) CancelOrder(req *CancelOrderRequest) (*CancelOrderResponse, error) {
+	return journaled(j, "CancelOrder", req, j.Trade.CancelOrder)
+}
+
+func (j *JournaledTrade) CancelAllOrders(req *CancelAllOrdersRequest) (*CancelAllOrdersResponse, error) {
+	return journaled(j, "CancelAllOrders", req, j.Trade.CancelAllOrders)
+}
+
+func (j *JournaledTrade) BatchPlaceOrders(req *BatchPlaceOrderRequest) (*BatchPlaceOrderResponse, error) {
+	return journaled(j, "BatchPlaceOrders", req, j.Trade.BatchPlaceOrders)
+}
+
+func (j *JournaledTrade) BatchPlaceOrder(req *BatchPlaceOrderRequest) (*BatchPlaceOrderResponse, error) {
+	return j.BatchPlaceOrders(req)
+}
+
+func (j *JournaledTrade) BatchAmendOrders(req *BatchAmendOrderRequest) (*BatchAmendOrderResponse, error) {
+	return journaled(j, "BatchAmendOrders", req, j.Trade.BatchAmendOrders)
+}
+
+func (j *JournaledTrade) BatchCancelOrders(req *BatchCancelOrderRequest) (*BatchCancelOrderResponse, error) {
+	return journaled(j, "BatchCancelOrders", req, j.Trade.BatchCancelOrders)
+}
+
+func (j *JournaledTrade) SetDisconnectCancelAll(req *SetDisconnectCancelAllRequest) (*APIResponse, error) {
+	return journaled(j, "SetDisconnectCancelAll", req, j.Trade.SetDisconnectCancelAll)
+}
+
+func (j *JournaledTrade) unwrap() Trade {
+	return j.Trade
+}
+
+// Update records an order update received from the private websocket.
+func (j *JournaledTrade) Update(order OrderDetails) {
+	j.record(JournalOrderUpdate, order)
+}
+
+// UpdateExecution records an execution received from the private websocket.
+func (j *JournaledTrade) UpdateExecution(exec Execution) {
+	j.record(JournalExecution, exec)
+}
+
+func (j *JournaledTrade) record(kind JournalKind, v any) {
+	data, err := json.Marshal(v)
+	if err == nil {
+		err = j.journal.Append(&JournalEntry{Kind: kind, Data: data})
+	}
+	if err != nil {
+		j.reportError(fmt.Errorf("error journaling %s: %w", kind, err))
+	}
+}
+
+func (j *JournaledTrade) reportError(err error) {
+	if j.OnError != nil {
+		j.OnError(err)
+		return
+	}
+	log.Printf("Journal error: %v", err)
+}
+
+// journaled writes the intent for req, calls send and writes its outcome.
+func journaled[Req, Res any](j *JournaledTrade, op string, req Req, send func(Req) (Res, error)) (Res, error) {
+	var zero Res
+	data, err := json.Marshal(req)
+	if err != nil {
+		return zero, fmt.Errorf("error encoding %s intent: %w", op, err)
+	}
+	intent := &JournalEntry{Kind: JournalIntent, Op: op, Data: data}
+	if err := j.journal.Append(intent); err != nil {
+		return zero, fmt.Errorf("%s not sent: %w", op, err)
+	}
+
+	res, sendErr := send(req)
+	outcome := &JournalEntry{Kind: JournalResponse, Op: op, Ref: intent.Seq}
+	if sendErr != nil {
+		outcome.Error = sendErr.Error()
+	}
+	var apiErr *client.APIError
+	if data, err := json.Marshal(res); err == nil && string(data) != "null" {
+		outcome.Data = data
+	} else if sendErr != nil && !errors.As(sendErr, &apiErr) {
+		// No response reached us, so the outcome on the exchange is unknown.
+		outcome.Kind = JournalError
+	}
+	if err := j.journal.Append(outcome); err != nil {
+		j.reportError(fmt.Errorf("error journaling %s outcome: %w", op, err))
+	}
+	return res, sendErr
+}
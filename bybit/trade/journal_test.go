@@ -0,0 +1,100 @@
+package trade
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJournaledTrade(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "orders.journal")
+	journal, err := OpenFileJournal(path)
+	require.NoError(t, err)
+
+	fake := &flakyTrade{}
+	j := NewJournaled(fake, journal)
+	req, err := NewLimitOrder("BTCUSDT").Buy().Qty("0.01").Price("30000").OrderLinkID("journal-1").Build()
+	require.NoError(t, err)
+	_, err = j.PlaceOrder(req)
+	require.NoError(t, err)
+
+	fake.timeout = true
+	req.OrderLinkID = "journal-2"
+	_, err = j.PlaceOrder(req)
+	require.Error(t, err)
+
+	j.Update(OrderDetails{OrderID: "id-journal-1", OrderStatus: OrderStatusFilled})
+	require.NoError(t, journal.Close())
+
+	entries, err := ReadJournal(path)
+	require.NoError(t, err)
+	require.Len(t, entries, 5)
+	assert.Equal(t, JournalIntent, entries[0].Kind)
+	assert.Equal(t, "PlaceOrder", entries[0].Op)
+	assert.Contains(t, string(entries[0].Data), "journal-1")
+	assert.Equal(t, JournalResponse, entries[1].Kind)
+	assert.Equal(t, entries[0].Seq, entries[1].Ref)
+	assert.Equal(t, JournalError, entries[3].Kind)
+	assert.Equal(t, JournalOrderUpdate, entries[4].Kind)
+	// The timed out order may be live on the exchange, so its intent stays pending.
+	pending := Unresolved(entries)
+	require.Len(t, pending, 1)
+	assert.Equal(t, entries[2].Seq, pending[0].Seq)
+	assert.Contains(t, string(pending[0].Data), "journal-2")
+
+	// Reopening continues the sequence.
+	journal, err = OpenFileJournal(path)
+	require.NoError(t, err)
+	defer journal.Close()
+	entry := &JournalEntry{Kind: JournalIntent, Op: "CancelOrder"}
+	require.NoError(t, journal.Append(entry))
+	assert.Equal(t, int64(6), entry.Seq)
+}
+
+func TestReadJournalRecovery(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "orders.journal")
+	journal, err := OpenFileJournal(path)
+	require.NoError(t, err)
+	require.NoError(t, journal.Append(&JournalEntry{Kind: JournalIntent, Op: "PlaceOrder"}))
+	require.NoError(t, journal.Close())
+
+	// Simulate a crash in the middle of writing the next entry.
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+	require.NoError(t, err)
+	_, err = file.WriteString(`{"seq":2,"kind":"resp`)
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	entries, err := ReadJournal(path)
+	require.NoError(t, err)
+	pending := Unresolved(entries)
+	require.Len(t, pending, 1)
+	assert.Equal(t, int64(1), pending[0].Seq)
+
+	journal, err = OpenFileJournal(path)
+	require.NoError(t, err)
+	require.NoError(t, journal.Append(&JournalEntry{Kind: JournalError, Ref: 1}))
+	require.NoError(t, journal.Append(&JournalEntry{Kind: JournalResponse, Ref: 1}))
+	require.NoError(t, journal.Close())
+	entries, err = ReadJournal(path)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	assert.Len(t, Unresolved(entries[:2]), 1, "an error entry must not resolve the intent")
+	assert.Empty(t, Unresolved(entries))
+}
+
+type failingJournal struct{}
+
+func (failingJournal) Append(*JournalEntry) error { return errors.New("disk full") }
+
+func TestJournaledTradeDoesNotSendWithoutIntent(t *testing.T) {
+	fake := &flakyTrade{}
+	j := NewJournaled(fake, failingJournal{})
+	_, err := j.PlaceOrder(&PlaceOrderRequest{Category: "linear", Symbol: "BTCUSDT", OrderLinkID: "journal-3"})
+	require.Error(t, err)
+	assert.Empty(t, fake.placed)
+}
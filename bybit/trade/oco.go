@@ -0,0 +1,260 @@
+package trade
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/market"
+)
+
+// OCOEventType identifies what happened to an OCO pair.
+type OCOEventType int
+
+const (
+	// OCOFilled means one leg filled, at least partially, and the sibling was cancelled.
+	OCOFilled OCOEventType = iota
+	// OCOCancelled means a leg was cancelled or rejected outside the OCO, so the sibling was
+	// cancelled too, or Cancel was called.
+	OCOCancelled
+	// OCOFailed means the sibling could not be cancelled, or had filled as well. Both legs may
+	// now be working or filled and the caller must intervene.
+	OCOFailed
+)
+
+// ErrOCOBothFilled is the Err of an OCOFailed event when the sibling filled before it could be
+// cancelled, leaving the position with the exposure of both legs.
+var ErrOCOBothFilled = errors.New("both OCO legs filled")
+
+// OCOEvent reports the outcome of an OCO pair. Order is the leg that triggered the event.
+type OCOEvent struct {
+	Type  OCOEventType
+	Order OrderDetails
+	Err   error
+}
+
+// OCO emulates a one-cancels-other pair on top of two ordinary orders. Feed it order updates
+// from the private order stream with Update, or let Poll query them, and the sibling of the
+// first leg that fills or is cancelled is cancelled automatically. OnEvent, when set, is called
+// once with the final outcome.
+type OCO struct {
+	trade    Trade
+	category market.Category
+	symbol   string
+	legs     [2]string // orderLinkIds
+
+	// OnEvent is called once when the pair resolves. Set it before feeding updates.
+	OnEvent func(OCOEvent)
+
+	mu       sync.Mutex
+	resolved bool
+	result   *OCOEvent
+	done     chan struct{}
+}
+
+// PlaceOCO places both legs and returns a handle tracking them. The legs must share category
+// and symbol; missing orderLinkIds are generated so updates can be matched. When the second
+// leg is rejected the first is cancelled before the error is returned.
+func PlaceOCO(t Trade, first, second *PlaceOrderRequest) (*OCO, error) {
+	if first.Category != second.Category || first.Symbol != second.Symbol {
+		return nil, errors.New("OCO legs must have the same category and symbol")
+	}
+	o := &OCO{trade: t, category: first.Category, symbol: first.Symbol, done: make(chan struct{})}
+	for i, leg := range []*PlaceOrderRequest{first, second} {
+		if leg.OrderLinkID == "" {
+			id, err := newOrderLinkID("oco")
+			if err != nil {
+				return nil, err
+			}
+			leg.OrderLinkID = id
+		}
+		o.legs[i] = leg.OrderLinkID
+	}
+
+	if _, err := t.PlaceOrder(first); err != nil {
+		return nil, fmt.Errorf("error placing first OCO leg: %w", err)
+	}
+	if _, err := t.PlaceOrder(second); err != nil {
+		if _, cancelErr := o.cancel(o.legs[0]); cancelErr != nil {
+			return nil, fmt.Errorf("error placing second OCO leg: %w (first leg %s is still open: %v)", err, o.legs[0], cancelErr)
+		}
+		return nil, fmt.Errorf("error placing second OCO leg: %w", err)
+	}
+	return o, nil
+}
+
+// Legs returns the orderLinkIds of both legs.
+func (o *OCO) Legs() [2]string {
+	return o.legs
+}
+
+// Done is closed once the pair has resolved.
+func (o *OCO) Done() <-chan struct{} {
+	return o.done
+}
+
+// Result returns the final event, or nil while the pair has not resolved.
+func (o *OCO) Result() *OCOEvent {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.result == nil {
+		return nil
+	}
+	result := *o.result
+	return &result
+}
+
+// Update applies an order update. Updates for other orders are ignored, so the whole private
+// order stream can be passed in.
+func (o *OCO) Update(order OrderDetails) {
+	leg := o.legIndex(order.OrderLinkID)
+	if leg < 0 {
+		return
+	}
+	var eventType OCOEventType
+	switch order.OrderStatus {
+	case OrderStatusFilled, OrderStatusPartiallyFilled, OrderStatusPartiallyFilledCanceled:
+		eventType = OCOFilled
+	case OrderStatusCancelled, OrderStatusRejected, OrderStatusDeactivated:
+		eventType = OCOCancelled
+	default:
+		return
+	}
+
+	if !o.claim() {
+		return
+	}
+	event := OCOEvent{Type: eventType, Order: order}
+	sibling, err := o.cancel(o.legs[1-leg])
+	switch {
+	case err != nil:
+		event.Type, event.Err = OCOFailed, err
+	case sibling != nil && eventType == OCOFilled:
+		event.Type, event.Err = OCOFailed, fmt.Errorf("%w: %s and %s", ErrOCOBothFilled, order.OrderLinkID, sibling.OrderLinkID)
+	case sibling != nil:
+		// The leg was cancelled after its sibling filled.
+		event.Type, event.Order = OCOFilled, *sibling
+	}
+	o.finish(event)
+}
+
+// Poll queries both legs every interval and applies the updates until the pair resolves or ctx
+// is done. Use it when no private websocket is available.
+func (o *OCO) Poll(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		for _, id := range o.legs {
+			// A leg that filled or was cancelled has left the open orders, so it is looked up in
+			// the order history as well.
+			order, err := findOrder(o.trade, o.category, o.symbol, "", id)
+			if err != nil {
+				return fmt.Errorf("error polling OCO leg %s: %w", id, err)
+			}
+			if order != nil {
+				o.Update(*order)
+			}
+		}
+		select {
+		case <-o.done:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Cancel cancels both legs and resolves the pair as cancelled.
+func (o *OCO) Cancel() error {
+	if !o.claim() {
+		return nil
+	}
+	event := OCOEvent{Type: OCOCancelled}
+	var filled []OrderDetails
+	var errs []error
+	for _, id := range o.legs {
+		order, err := o.cancel(id)
+		if order != nil {
+			filled = append(filled, *order)
+		}
+		errs = append(errs, err)
+	}
+	err := errors.Join(errs...)
+	switch {
+	case err != nil:
+		event.Type, event.Err = OCOFailed, err
+	case len(filled) == 2:
+		err = fmt.Errorf("%w: %s and %s", ErrOCOBothFilled, filled[0].OrderLinkID, filled[1].OrderLinkID)
+		event.Type, event.Err = OCOFailed, err
+	case len(filled) == 1:
+		event.Type, event.Order = OCOFilled, filled[0]
+	}
+	o.finish(event)
+	return err
+}
+
+// claim marks the pair as resolving and reports whether the caller won the race to do so.
+func (o *OCO) claim() bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.resolved {
+		return false
+	}
+	o.resolved = true
+	return true
+}
+
+func (o *OCO) finish(event OCOEvent) {
+	o.mu.Lock()
+	o.result = &event
+	o.mu.Unlock()
+	close(o.done)
+	if o.OnEvent != nil {
+		o.OnEvent(event)
+	}
+}
+
+func (o *OCO) legIndex(orderLinkID string) int {
+	for i, id := range o.legs {
+		if id == orderLinkID {
+			return i
+		}
+	}
+	return -1
+}
+
+// cancel cancels a leg. When the leg is already gone its final state is looked up, and the leg
+// is returned if it had filled, at least partially. A leg Bybit no longer knows is treated as
+// cancelled.
+func (o *OCO) cancel(orderLinkID string) (*OrderDetails, error) {
+	_, err := o.trade.CancelOrder(&CancelOrderRequest{Category: o.category, Symbol: o.symbol, OrderLinkID: &orderLinkID})
+	if !errors.Is(err, ErrOrderNotFound) && !errors.Is(err, ErrOrderAlreadyClosed) {
+		return nil, err
+	}
+	order, err := findOrder(o.trade, o.category, o.symbol, "", orderLinkID)
+	if err != nil {
+		return nil, fmt.Errorf("error checking closed OCO leg %s: %w", orderLinkID, err)
+	}
+	if order == nil {
+		return nil, nil
+	}
+	switch order.OrderStatus {
+	case OrderStatusFilled, OrderStatusPartiallyFilled, OrderStatusPartiallyFilledCanceled:
+		return order, nil
+	}
+	return nil, nil
+}
+
+// newOrderLinkID returns a random client order id with the given prefix.
+func newOrderLinkID(prefix string) (string, error) {
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("error generating orderLinkId: %w", err)
+	}
+	return prefix + "-" + hex.EncodeToString(b), nil
+}
@@ -0,0 +1,126 @@
+package trade
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeTrade records orders and cancels. Methods not overridden panic through the nil Trade.
+type fakeTrade struct {
+	Trade
+	placed    []string
+	cancelled []string
+	placeErr  error
+	cancelErr error
+	// closed are the orders only found in the order history.
+	closed []OrderDetails
+}
+
+func (f *fakeTrade) PlaceOrder(req *PlaceOrderRequest) (*PlaceOrderResponse, error) {
+	if f.placeErr != nil && len(f.placed) == 1 {
+		return nil, f.placeErr
+	}
+	f.placed = append(f.placed, req.OrderLinkID)
+	return &PlaceOrderResponse{}, nil
+}
+
+func (f *fakeTrade) CancelOrder(req *CancelOrderRequest) (*CancelOrderResponse, error) {
+	f.cancelled = append(f.cancelled, *req.OrderLinkID)
+	return &CancelOrderResponse{}, f.cancelErr
+}
+
+func (f *fakeTrade) GetOpenOrders(req *GetOpenOrdersRequest) (*GetOpenOrdersResponse, error) {
+	return &GetOpenOrdersResponse{}, nil
+}
+
+func (f *fakeTrade) GetOrderHistory(req *GetOrderHistoryRequest) (*GetOrderHistoryResponse, error) {
+	res := &GetOrderHistoryResponse{}
+	for _, order := range f.closed {
+		if order.OrderLinkID == *req.OrderLinkID {
+			res.Result.List = append(res.Result.List, order)
+		}
+	}
+	return res, nil
+}
+
+func ocoLegs() (*PlaceOrderRequest, *PlaceOrderRequest) {
+	takeProfit, _ := NewLimitOrder("BTCUSDT").Category("spot").Sell().Qty("0.1").Price("32000").Build()
+	stop, _ := NewMarketOrder("BTCUSDT").Category("spot").Sell().Qty("0.1").TriggerPrice("29000").Build()
+	return takeProfit, stop
+}
+
+func TestOCOFillCancelsSibling(t *testing.T) {
+	fake := &fakeTrade{}
+	first, second := ocoLegs()
+	oco, err := PlaceOCO(fake, first, second)
+	require.NoError(t, err)
+	legs := oco.Legs()
+	assert.Equal(t, legs[:], fake.placed)
+
+	var events []OCOEvent
+	oco.OnEvent = func(e OCOEvent) { events = append(events, e) }
+
+	oco.Update(OrderDetails{OrderLinkID: "other", OrderStatus: OrderStatusFilled})
+	oco.Update(OrderDetails{OrderLinkID: legs[0], OrderStatus: OrderStatusNew})
+	assert.Nil(t, oco.Result())
+
+	oco.Update(OrderDetails{OrderLinkID: legs[0], OrderStatus: OrderStatusFilled})
+	oco.Update(OrderDetails{OrderLinkID: legs[1], OrderStatus: OrderStatusCancelled})
+	<-oco.Done()
+	assert.Equal(t, []string{legs[1]}, fake.cancelled)
+	require.Len(t, events, 1)
+	assert.Equal(t, OCOFilled, events[0].Type)
+}
+
+func TestOCOSiblingAlreadyGone(t *testing.T) {
+	fake := &fakeTrade{cancelErr: apiError(110001, "order not exists or too late to cancel")}
+	first, second := ocoLegs()
+	oco, err := PlaceOCO(fake, first, second)
+	require.NoError(t, err)
+	oco.Update(OrderDetails{OrderLinkID: oco.Legs()[1], OrderStatus: OrderStatusTriggered})
+	assert.Nil(t, oco.Result())
+	oco.Update(OrderDetails{OrderLinkID: oco.Legs()[1], OrderStatus: OrderStatusFilled})
+	assert.Equal(t, OCOFilled, oco.Result().Type)
+}
+
+func TestOCOBothLegsFilled(t *testing.T) {
+	fake := &fakeTrade{cancelErr: apiError(110008, "order has been finished or cancelled")}
+	first, second := ocoLegs()
+	oco, err := PlaceOCO(fake, first, second)
+	require.NoError(t, err)
+	legs := oco.Legs()
+	fake.closed = []OrderDetails{{OrderLinkID: legs[1], OrderStatus: OrderStatusFilled}}
+
+	oco.Update(OrderDetails{OrderLinkID: legs[0], OrderStatus: OrderStatusFilled})
+	result := oco.Result()
+	require.NotNil(t, result)
+	assert.Equal(t, OCOFailed, result.Type)
+	assert.True(t, errors.Is(result.Err, ErrOCOBothFilled))
+}
+
+func TestOCOPollFindsFilledLeg(t *testing.T) {
+	fake := &fakeTrade{}
+	first, second := ocoLegs()
+	oco, err := PlaceOCO(fake, first, second)
+	require.NoError(t, err)
+	legs := oco.Legs()
+	// The filled leg has left the open orders.
+	fake.closed = []OrderDetails{{OrderLinkID: legs[0], OrderStatus: OrderStatusFilled}}
+
+	require.NoError(t, oco.Poll(context.Background(), time.Millisecond))
+	assert.Equal(t, OCOFilled, oco.Result().Type)
+	assert.Equal(t, []string{legs[1]}, fake.cancelled)
+}
+
+func TestOCOSecondLegRejected(t *testing.T) {
+	fake := &fakeTrade{placeErr: errors.New("insufficient balance")}
+	first, second := ocoLegs()
+	_, err := PlaceOCO(fake, first, second)
+	assert.Error(t, err)
+	assert.Equal(t, fake.placed, fake.cancelled)
+}
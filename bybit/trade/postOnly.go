@@ -0,0 +1,115 @@
+package trade
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/market"
+	"github.com/cploutarchou/crypto-sdk-suite/decimal"
+)
+
+// RejectReasonPostOnly is the rejectReason of a post-only order cancelled because it would
+// have taken liquidity.
+const RejectReasonPostOnly = "EC_PostOnlyWillTakeLiquidity"
+
+// ErrPostOnlyRetriesExhausted is returned when every repriced attempt still crossed the book.
+var ErrPostOnlyRetriesExhausted = errors.New("post-only order crossed the book on every attempt")
+
+// BestPrices returns the current best bid and ask of a symbol, usually from a locally
+// maintained order book.
+type BestPrices func(symbol string) (bid, ask decimal.Decimal, err error)
+
+// BestPricesFromMarket returns a BestPrices that reads the top of the REST order book. A
+// websocket-fed book reacts faster and should be preferred for active quoting.
+func BestPricesFromMarket(m market.Market, category market.Category) BestPrices {
+	return func(symbol string) (decimal.Decimal, decimal.Decimal, error) {
+		book, err := m.OrderBook(&client.Params{"category": category, "symbol": symbol, "limit": "1"})
+		if err != nil {
+			return decimal.Zero, decimal.Zero, err
+		}
+		bids, err := book.Result.Bids()
+		if err != nil {
+			return decimal.Zero, decimal.Zero, err
+		}
+		asks, err := book.Result.Asks()
+		if err != nil {
+			return decimal.Zero, decimal.Zero, err
+		}
+		if len(bids) == 0 || len(asks) == 0 {
+			return decimal.Zero, decimal.Zero, fmt.Errorf("order book for %s is empty", symbol)
+		}
+		return bids[0].Price, asks[0].Price, nil
+	}
+}
+
+// PostOnlyRepricer places post-only limit orders and, when Bybit cancels one for crossing the
+// book, re-prices it to the best passive level (the best bid for buys, the best ask for sells)
+// and tries again up to MaxRetries times.
+type PostOnlyRepricer struct {
+	Trade      Trade
+	Best       BestPrices
+	MaxRetries int
+	// Settle is how long to wait after placing before checking whether the order survived.
+	// Defaults to 200ms.
+	Settle time.Duration
+}
+
+// Place submits req as a post-only order. Each retry uses a new orderLinkId derived from the
+// original one. The returned response is that of the order left resting on the book.
+func (p *PostOnlyRepricer) Place(req *PlaceOrderRequest) (*PlaceOrderResponse, error) {
+	if req.OrderType != OrderTypeLimit {
+		return nil, errors.New("post-only orders must be limit orders")
+	}
+	settle := p.Settle
+	if settle <= 0 {
+		settle = 200 * time.Millisecond
+	}
+	order := *req
+	order.TimeInForce = TimeInForcePostOnly
+	baseLinkID := req.OrderLinkID
+
+	for attempt := 0; attempt <= p.MaxRetries; attempt++ {
+		if baseLinkID != "" && attempt > 0 {
+			order.OrderLinkID = baseLinkID + "-r" + strconv.Itoa(attempt)
+		}
+		res, err := p.Trade.PlaceOrder(&order)
+		if err != nil {
+			return res, err
+		}
+		time.Sleep(settle)
+
+		crossed, err := p.crossed(&order, res.Result.OrderID)
+		if err != nil || !crossed {
+			return res, err
+		}
+		if attempt == p.MaxRetries {
+			break
+		}
+		bid, ask, err := p.Best(order.Symbol)
+		if err != nil {
+			return nil, fmt.Errorf("error repricing post-only order: %w", err)
+		}
+		if order.Side == SideBuy {
+			order.Price = bid.String()
+		} else {
+			order.Price = ask.String()
+		}
+	}
+	return nil, ErrPostOnlyRetriesExhausted
+}
+
+// crossed reports whether the order was cancelled for taking liquidity. Cancelled orders leave
+// the open orders, so they are found in the order history.
+func (p *PostOnlyRepricer) crossed(order *PlaceOrderRequest, orderID string) (bool, error) {
+	details, err := findOrder(p.Trade, order.Category, order.Symbol, orderID, "")
+	if err != nil {
+		return false, fmt.Errorf("error checking post-only order %s: %w", orderID, err)
+	}
+	if details == nil {
+		return false, fmt.Errorf("post-only order %s not found", orderID)
+	}
+	return details.OrderStatus == OrderStatusCancelled && details.RejectReason == RejectReasonPostOnly, nil
+}
@@ -0,0 +1,68 @@
+package trade
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/cploutarchou/crypto-sdk-suite/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// crossingTrade cancels the first crossUntil orders for taking liquidity. Cancelled orders are
+// only reported by the order history, as on Bybit.
+type crossingTrade struct {
+	Trade
+	prices     []string
+	crossUntil int
+	lost       bool
+}
+
+func (c *crossingTrade) PlaceOrder(req *PlaceOrderRequest) (*PlaceOrderResponse, error) {
+	c.prices = append(c.prices, req.Price)
+	var res PlaceOrderResponse
+	res.Result.OrderID = strconv.Itoa(len(c.prices))
+	return &res, nil
+}
+
+func (c *crossingTrade) GetOpenOrders(req *GetOpenOrdersRequest) (*GetOpenOrdersResponse, error) {
+	var res GetOpenOrdersResponse
+	if len(c.prices) > c.crossUntil {
+		res.Result.List = []OrderDetails{{OrderID: *req.OrderID, OrderStatus: OrderStatusNew}}
+	}
+	return &res, nil
+}
+
+func (c *crossingTrade) GetOrderHistory(req *GetOrderHistoryRequest) (*GetOrderHistoryResponse, error) {
+	var res GetOrderHistoryResponse
+	if len(c.prices) <= c.crossUntil && !c.lost {
+		res.Result.List = []OrderDetails{{OrderID: *req.OrderID, OrderStatus: OrderStatusCancelled, RejectReason: RejectReasonPostOnly}}
+	}
+	return &res, nil
+}
+
+func TestPostOnlyRepricer(t *testing.T) {
+	best := func(string) (decimal.Decimal, decimal.Decimal, error) {
+		return decimal.RequireFromString("99.5"), decimal.RequireFromString("100"), nil
+	}
+	req, err := NewLimitOrder("BTCUSDT").Buy().Qty("1").Price("101").Build()
+	require.NoError(t, err)
+
+	fake := &crossingTrade{crossUntil: 1}
+	repricer := &PostOnlyRepricer{Trade: fake, Best: best, MaxRetries: 2, Settle: 1}
+	res, err := repricer.Place(req)
+	require.NoError(t, err)
+	assert.Equal(t, "2", res.Result.OrderID)
+	assert.Equal(t, []string{"101", "99.5"}, fake.prices)
+
+	fake = &crossingTrade{crossUntil: 10}
+	repricer.Trade = fake
+	_, err = repricer.Place(req)
+	assert.ErrorIs(t, err, ErrPostOnlyRetriesExhausted)
+	assert.Len(t, fake.prices, 3)
+
+	// An order Bybit has no record of must not be reported as resting.
+	repricer.Trade = &crossingTrade{crossUntil: 10, lost: true}
+	_, err = repricer.Place(req)
+	assert.ErrorContains(t, err, "not found")
+}
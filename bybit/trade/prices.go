@@ -0,0 +1,43 @@
+package trade
+
+import "github.com/cploutarchou/crypto-sdk-suite/decimal"
+
+// SetQty sets the quantity from a decimal.
+func (r *PlaceOrderRequest) SetQty(qty decimal.Decimal) {
+	r.Qty = qty.String()
+}
+
+// SetPrice sets the limit price from a decimal.
+func (r *PlaceOrderRequest) SetPrice(price decimal.Decimal) {
+	r.Price = price.String()
+}
+
+// SetQty sets the new quantity of an amendment from a decimal.
+func (r *AmendOrderRequest) SetQty(qty decimal.Decimal) {
+	s := qty.String()
+	r.Qty = &s
+}
+
+// SetPrice sets the new price of an amendment from a decimal.
+func (r *AmendOrderRequest) SetPrice(price decimal.Decimal) {
+	s := price.String()
+	r.Price = &s
+}
+
+// Filled returns the executed quantity of the order.
+func (o OrderDetails) Filled() (decimal.Decimal, error) {
+	return decimal.NewFromString(o.CumExecQty)
+}
+
+// Remaining returns the quantity still waiting to be filled.
+func (o OrderDetails) Remaining() (decimal.Decimal, error) {
+	return decimal.NewFromString(o.LeavesQty)
+}
+
+// AveragePrice returns the average fill price, zero when nothing has filled.
+func (o OrderDetails) AveragePrice() (decimal.Decimal, error) {
+	if o.AvgPrice == "" {
+		return decimal.Zero, nil
+	}
+	return decimal.NewFromString(o.AvgPrice)
+}
@@ -0,0 +1,171 @@
+package trade
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/market"
+)
+
+// SafetyMode restricts what a Trade sends to Bybit, so a new deployment can be pointed at a
+// production account without opening new exposure.
+type SafetyMode int
+
+const (
+	// SafetyOff sends every request unchanged.
+	SafetyOff SafetyMode = iota
+	// SafetyDryRun logs every mutating request (place, amend, cancel, batch and DCP) and returns a
+	// successful response with RetMsg DryRunMsg instead of sending it. Queries are still sent.
+	SafetyDryRun
+	// SafetyReduceOnly sends every derivatives order with reduceOnly set. Spot has no reduce-only
+	// flag, so spot buys and spot margin orders are rejected with ErrReduceOnlyMode.
+	SafetyReduceOnly
+)
+
+// DryRunMsg is the RetMsg of responses returned in SafetyDryRun mode.
+const DryRunMsg = "dry run"
+
+// ErrReduceOnlyMode is returned for orders that SafetyReduceOnly cannot make reduce-only.
+var ErrReduceOnlyMode = errors.New("order rejected by reduce-only safety mode")
+
+// Option configures a Trade created by New.
+type Option func(*tradeImpl)
+
+// WithInstrumentCache validates PlaceOrder and AmendOrder requests against cached instrument
+// filters, see NewWithValidation.
+func WithInstrumentCache(cache *market.InstrumentCache) Option {
+	return func(t *tradeImpl) {
+		t.cache = cache
+	}
+}
+
+// WithSafetyMode sets the safety mode. The default is SafetyOff.
+func WithSafetyMode(mode SafetyMode) Option {
+	return func(t *tradeImpl) {
+		t.safety = mode
+	}
+}
+
+// WithLogger sets the logger used to report requests suppressed in SafetyDryRun mode. The
+// standard logger is used when it is not set.
+func WithLogger(logger *log.Logger) Option {
+	return func(t *tradeImpl) {
+		t.logger = logger
+	}
+}
+
+// post sends a mutating request, or logs it and returns a synthetic success in dry-run mode.
+func (t *tradeImpl) post(path string, params client.Params) (client.Response, error) {
+	if t.safety != SafetyDryRun {
+		return t.client.Post(path, params)
+	}
+	body, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding dry run request: %w", err)
+	}
+	msg := fmt.Sprintf("dry run: POST %s %s", path, body)
+	if t.logger != nil {
+		t.logger.Print(msg)
+	} else {
+		log.Print(msg)
+	}
+	return newDryRunResponse(params)
+}
+
+// reduceOnly returns req with reduceOnly forced on in SafetyReduceOnly mode. The caller's
+// request is never modified.
+func (t *tradeImpl) reduceOnly(req *PlaceOrderRequest) (*PlaceOrderRequest, error) {
+	if t.safety != SafetyReduceOnly {
+		return req, nil
+	}
+	if req.Category == market.CategorySpot {
+		if err := spotReducesExposure(req.Side, req.IsLeverage); err != nil {
+			return nil, err
+		}
+		return req, nil
+	}
+	forced := *req
+	reduceOnly := true
+	forced.ReduceOnly = &reduceOnly
+	return &forced, nil
+}
+
+// reduceOnlyBatch is the batch equivalent of reduceOnly. Batch spot orders cannot carry
+// isLeverage, so only the side is checked.
+func (t *tradeImpl) reduceOnlyBatch(req *BatchPlaceOrderRequest) (*BatchPlaceOrderRequest, error) {
+	if t.safety != SafetyReduceOnly {
+		return req, nil
+	}
+	forced := &BatchPlaceOrderRequest{Category: req.Category, Request: make([]OrderRequest, len(req.Request))}
+	for i, item := range req.Request {
+		if req.Category == market.CategorySpot {
+			if err := spotReducesExposure(item.Side, 0); err != nil {
+				return nil, fmt.Errorf("request[%d]: %w", i, err)
+			}
+		} else {
+			reduceOnly := true
+			item.ReduceOnly = &reduceOnly
+		}
+		forced.Request[i] = item
+	}
+	return forced, nil
+}
+
+// spotReducesExposure allows only unleveraged spot sells, which can at most sell coins the
+// account already holds.
+func spotReducesExposure(side Side, isLeverage int) error {
+	if side != SideSell {
+		return fmt.Errorf("%w: spot %s orders open new exposure", ErrReduceOnlyMode, side)
+	}
+	if isLeverage != 0 {
+		return fmt.Errorf("%w: spot margin orders can open a short", ErrReduceOnlyMode)
+	}
+	return nil
+}
+
+// dryRunResponse is the client.Response returned in place of a suppressed request.
+type dryRunResponse struct {
+	data []byte
+}
+
+// newDryRunResponse builds a successful response that echoes the request's orderLinkId, so
+// callers that track orders by link id keep working.
+func newDryRunResponse(params client.Params) (client.Response, error) {
+	result := map[string]any{}
+	if linkID, ok := params["orderLinkId"]; ok {
+		result["orderLinkId"] = linkID
+	}
+	data, err := json.Marshal(map[string]any{
+		"retCode": 0,
+		"retMsg":  DryRunMsg,
+		"result":  result,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error encoding dry run response: %w", err)
+	}
+	return &dryRunResponse{data: data}, nil
+}
+
+func (r *dryRunResponse) Unmarshal(v any) error {
+	return json.Unmarshal(r.data, v)
+}
+
+func (r *dryRunResponse) Data() []byte {
+	return r.data
+}
+
+func (r *dryRunResponse) Status() string {
+	return "200 OK"
+}
+
+func (r *dryRunResponse) StatusCode() int {
+	return http.StatusOK
+}
+
+func (r *dryRunResponse) Error() error {
+	return nil
+}
@@ -0,0 +1,58 @@
+package trade
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"testing"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDryRunSuppressesMutatingCalls(t *testing.T) {
+	var buf bytes.Buffer
+	tr := New(client.NewClient("key", "secret", true), WithSafetyMode(SafetyDryRun), WithLogger(log.New(&buf, "", 0)))
+
+	req, err := NewLimitOrder("BTCUSDT").Buy().Qty("0.01").Price("30000").OrderLinkID("dry-1").Build()
+	require.NoError(t, err)
+	placed, err := tr.PlaceOrder(req)
+	require.NoError(t, err)
+	assert.Equal(t, DryRunMsg, placed.RetMsg)
+	assert.Equal(t, "dry-1", placed.Result.OrderLinkID)
+	assert.Contains(t, buf.String(), "/v5/order/create")
+
+	linkID := "dry-1"
+	cancelled, err := tr.CancelOrder(&CancelOrderRequest{Category: "linear", Symbol: "BTCUSDT", OrderLinkID: &linkID})
+	require.NoError(t, err)
+	assert.Equal(t, DryRunMsg, cancelled.RetMsg)
+	assert.Contains(t, buf.String(), "/v5/order/cancel")
+}
+
+func TestReduceOnlyForcesFlag(t *testing.T) {
+	tr := &tradeImpl{safety: SafetyReduceOnly}
+	req, err := NewMarketOrder("BTCUSDT").Sell().Qty("1").Build()
+	require.NoError(t, err)
+
+	forced, err := tr.reduceOnly(req)
+	require.NoError(t, err)
+	require.NotNil(t, forced.ReduceOnly)
+	assert.True(t, *forced.ReduceOnly)
+	assert.Nil(t, req.ReduceOnly, "the caller's request must not be modified")
+
+	batch, err := tr.reduceOnlyBatch(&BatchPlaceOrderRequest{Category: "linear", Request: []OrderRequest{{Symbol: "BTCUSDT", Side: SideBuy}}})
+	require.NoError(t, err)
+	assert.True(t, *batch.Request[0].ReduceOnly)
+}
+
+func TestReduceOnlyRejectsSpotExposure(t *testing.T) {
+	tr := &tradeImpl{safety: SafetyReduceOnly}
+
+	_, err := tr.reduceOnly(&PlaceOrderRequest{Category: "spot", Symbol: "BTCUSDT", Side: SideBuy})
+	assert.True(t, errors.Is(err, ErrReduceOnlyMode))
+	_, err = tr.reduceOnly(&PlaceOrderRequest{Category: "spot", Symbol: "BTCUSDT", Side: SideSell, IsLeverage: 1})
+	assert.True(t, errors.Is(err, ErrReduceOnlyMode))
+	_, err = tr.reduceOnly(&PlaceOrderRequest{Category: "spot", Symbol: "BTCUSDT", Side: SideSell})
+	assert.NoError(t, err)
+}
@@ -1,34 +1,42 @@
 package trade
 
+import (
+	"time"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/market"
+	"github.com/cploutarchou/crypto-sdk-suite/decimal"
+)
+
 type PlaceOrderRequest struct {
-	Category         string  `json:"category"`
-	Symbol           string  `json:"symbol"`
-	IsLeverage       int     `json:"isLeverage"`
-	Side             string  `json:"side"`
-	OrderType        string  `json:"orderType"`
-	Qty              string  `json:"qty"`
-	Price            string  `json:"price,omitempty"`
-	TriggerPrice     *string `json:"triggerPrice,omitempty"`
-	TriggerDirection *int    `json:"triggerDirection,omitempty"`
-	TriggerBy        *string `json:"triggerBy,omitempty"`
-	OrderFilter      *string `json:"orderFilter,omitempty"`
-	OrderIv          *string `json:"orderIv,omitempty"`
-	TimeInForce      string  `json:"timeInForce"`
-	PositionIdx      *int    `json:"positionIdx,omitempty"`
-	OrderLinkID      string  `json:"orderLinkId"`
-	TakeProfit       *string `json:"takeProfit,omitempty"`
-	StopLoss         *string `json:"stopLoss,omitempty"`
-	TpTriggerBy      *string `json:"tpTriggerBy,omitempty"`
-	SlTriggerBy      *string `json:"slTriggerBy,omitempty"`
-	ReduceOnly       *bool   `json:"reduceOnly,omitempty"`
-	CloseOnTrigger   *bool   `json:"closeOnTrigger,omitempty"`
-	SmpType          *string `json:"smpType,omitempty"`
-	Mmp              *bool   `json:"mmp,omitempty"`
-	TpslMode         *string `json:"tpslMode,omitempty"`
-	TpLimitPrice     *string `json:"tpLimitPrice,omitempty"`
-	SlLimitPrice     *string `json:"slLimitPrice,omitempty"`
-	TpOrderType      *string `json:"tpOrderType,omitempty"`
-	SlOrderType      *string `json:"slOrderType,omitempty"`
+	Category         market.Category `json:"category"`
+	Symbol           string          `json:"symbol"`
+	IsLeverage       int             `json:"isLeverage"`
+	Side             Side            `json:"side"`
+	OrderType        OrderType       `json:"orderType"`
+	Qty              string          `json:"qty"`
+	Price            string          `json:"price,omitempty"`
+	TriggerPrice     *string         `json:"triggerPrice,omitempty"`
+	TriggerDirection *int            `json:"triggerDirection,omitempty"`
+	TriggerBy        *TriggerBy      `json:"triggerBy,omitempty"`
+	OrderFilter      *string         `json:"orderFilter,omitempty"`
+	OrderIv          *string         `json:"orderIv,omitempty"`
+	TimeInForce      TimeInForce     `json:"timeInForce"`
+	PositionIdx      *int            `json:"positionIdx,omitempty"`
+	OrderLinkID      string          `json:"orderLinkId"`
+	TakeProfit       *string         `json:"takeProfit,omitempty"`
+	StopLoss         *string         `json:"stopLoss,omitempty"`
+	TpTriggerBy      *TriggerBy      `json:"tpTriggerBy,omitempty"`
+	SlTriggerBy      *TriggerBy      `json:"slTriggerBy,omitempty"`
+	ReduceOnly       *bool           `json:"reduceOnly,omitempty"`
+	CloseOnTrigger   *bool           `json:"closeOnTrigger,omitempty"`
+	SmpType          *SmpType        `json:"smpType,omitempty"`
+	Mmp              *bool           `json:"mmp,omitempty"`
+	TpslMode         *string         `json:"tpslMode,omitempty"`
+	TpLimitPrice     *string         `json:"tpLimitPrice,omitempty"`
+	SlLimitPrice     *string         `json:"slLimitPrice,omitempty"`
+	TpOrderType      *OrderType      `json:"tpOrderType,omitempty"`
+	SlOrderType      *OrderType      `json:"slOrderType,omitempty"`
+	MarketUnit       *MarketUnit     `json:"marketUnit,omitempty"` // Spot market orders only
 }
 
 type PlaceOrderResponse struct {
@@ -44,22 +52,22 @@ type PlaceOrderResponse struct {
 }
 
 type AmendOrderRequest struct {
-	Category     string  `json:"category"`
-	Symbol       string  `json:"symbol"`
-	OrderID      *string `json:"orderId,omitempty"`
-	OrderLinkID  *string `json:"orderLinkId,omitempty"`
-	OrderIv      *string `json:"orderIv,omitempty"`
-	TriggerPrice *string `json:"triggerPrice,omitempty"`
-	Qty          *string `json:"qty,omitempty"`
-	Price        *string `json:"price,omitempty"`
-	TpslMode     *string `json:"tpslMode,omitempty"`
-	TakeProfit   *string `json:"takeProfit,omitempty"`
-	StopLoss     *string `json:"stopLoss,omitempty"`
-	TpTriggerBy  *string `json:"tpTriggerBy,omitempty"`
-	SlTriggerBy  *string `json:"slTriggerBy,omitempty"`
-	TriggerBy    *string `json:"triggerBy,omitempty"`
-	TpLimitPrice *string `json:"tpLimitPrice,omitempty"`
-	SlLimitPrice *string `json:"slLimitPrice,omitempty"`
+	Category     market.Category `json:"category"`
+	Symbol       string          `json:"symbol"`
+	OrderID      *string         `json:"orderId,omitempty"`
+	OrderLinkID  *string         `json:"orderLinkId,omitempty"`
+	OrderIv      *string         `json:"orderIv,omitempty"`
+	TriggerPrice *string         `json:"triggerPrice,omitempty"`
+	Qty          *string         `json:"qty,omitempty"`
+	Price        *string         `json:"price,omitempty"`
+	TpslMode     *string         `json:"tpslMode,omitempty"`
+	TakeProfit   *string         `json:"takeProfit,omitempty"`
+	StopLoss     *string         `json:"stopLoss,omitempty"`
+	TpTriggerBy  *TriggerBy      `json:"tpTriggerBy,omitempty"`
+	SlTriggerBy  *TriggerBy      `json:"slTriggerBy,omitempty"`
+	TriggerBy    *TriggerBy      `json:"triggerBy,omitempty"`
+	TpLimitPrice *string         `json:"tpLimitPrice,omitempty"`
+	SlLimitPrice *string         `json:"slLimitPrice,omitempty"`
 }
 type AmendOrderResponse struct {
 	RetCode int    `json:"retCode"`
@@ -72,11 +80,11 @@ type AmendOrderResponse struct {
 	Time       int64 `json:"time"`
 }
 type CancelOrderRequest struct {
-	Category    string  `json:"category"`
-	Symbol      string  `json:"symbol"`
-	OrderID     *string `json:"orderId,omitempty"`
-	OrderLinkID *string `json:"orderLinkId,omitempty"`
-	OrderFilter *string `json:"orderFilter,omitempty"` // Valid for spot only
+	Category    market.Category `json:"category"`
+	Symbol      string          `json:"symbol"`
+	OrderID     *string         `json:"orderId,omitempty"`
+	OrderLinkID *string         `json:"orderLinkId,omitempty"`
+	OrderFilter *string         `json:"orderFilter,omitempty"` // Valid for spot only
 }
 type CancelOrderResponse struct {
 	RetCode int    `json:"retCode"`
@@ -88,8 +96,12 @@ type CancelOrderResponse struct {
 	RetExtInfo any   `json:"retExtInfo"`
 	Time       int64 `json:"time"`
 }
+
+// MaxOpenOrdersLimit is the largest page size accepted by GetOpenOrders.
+const MaxOpenOrdersLimit = 50
+
 type GetOpenOrdersRequest struct {
-	Category    string
+	Category    market.Category
 	Symbol      *string
 	BaseCoin    *string
 	SettleCoin  *string
@@ -104,64 +116,65 @@ type GetOpenOrdersResponse struct {
 	RetCode int    `json:"retCode"`
 	RetMsg  string `json:"retMsg"`
 	Result  struct {
-		List           []OrderDetails `json:"list"`
-		NextPageCursor string         `json:"nextPageCursor"`
-		Category       string         `json:"category"`
+		List           []OrderDetails  `json:"list"`
+		NextPageCursor string          `json:"nextPageCursor"`
+		Category       market.Category `json:"category"`
 	} `json:"result"`
 	RetExtInfo any   `json:"retExtInfo"`
 	Time       int64 `json:"time"`
 }
 
 type OrderDetails struct {
-	OrderID            string `json:"orderId"`
-	OrderLinkID        string `json:"orderLinkId"`
-	BlockTradeID       string `json:"blockTradeId"`
-	Symbol             string `json:"symbol"`
-	Price              string `json:"price"`
-	Qty                string `json:"qty"`
-	Side               string `json:"side"`
-	IsLeverage         string `json:"isLeverage"`
-	PositionIdx        int    `json:"positionIdx"`
-	OrderStatus        string `json:"orderStatus"`
-	CancelType         string `json:"cancelType"`
-	RejectReason       string `json:"rejectReason"`
-	AvgPrice           string `json:"avgPrice"`
-	LeavesQty          string `json:"leavesQty"`
-	LeavesValue        string `json:"leavesValue"`
-	CumExecQty         string `json:"cumExecQty"`
-	CumExecValue       string `json:"cumExecValue"`
-	CumExecFee         string `json:"cumExecFee"`
-	TimeInForce        string `json:"timeInForce"`
-	OrderType          string `json:"orderType"`
-	StopOrderType      string `json:"stopOrderType"`
-	OrderIv            string `json:"orderIv"`
-	TriggerPrice       string `json:"triggerPrice"`
-	TakeProfit         string `json:"takeProfit"`
-	StopLoss           string `json:"stopLoss"`
-	TpTriggerBy        string `json:"tpTriggerBy"`
-	SlTriggerBy        string `json:"slTriggerBy"`
-	TriggerDirection   int    `json:"triggerDirection"`
-	TriggerBy          string `json:"triggerBy"`
-	LastPriceOnCreated string `json:"lastPriceOnCreated"`
-	ReduceOnly         bool   `json:"reduceOnly"`
-	CloseOnTrigger     bool   `json:"closeOnTrigger"`
-	SmpType            string `json:"smpType"`
-	SmpGroup           int    `json:"smpGroup"`
-	SmpOrderID         string `json:"smpOrderId"`
-	TpslMode           string `json:"tpslMode"`
-	TpLimitPrice       string `json:"tpLimitPrice"`
-	SlLimitPrice       string `json:"slLimitPrice"`
-	PlaceType          string `json:"placeType"`
-	CreatedTime        string `json:"createdTime"`
-	UpdatedTime        string `json:"updatedTime"`
+	Category           market.Category `json:"category"` // Only set on websocket order updates.
+	OrderID            string          `json:"orderId"`
+	OrderLinkID        string          `json:"orderLinkId"`
+	BlockTradeID       string          `json:"blockTradeId"`
+	Symbol             string          `json:"symbol"`
+	Price              string          `json:"price"`
+	Qty                string          `json:"qty"`
+	Side               Side            `json:"side"`
+	IsLeverage         string          `json:"isLeverage"`
+	PositionIdx        int             `json:"positionIdx"`
+	OrderStatus        OrderStatus     `json:"orderStatus"`
+	CancelType         string          `json:"cancelType"`
+	RejectReason       string          `json:"rejectReason"`
+	AvgPrice           string          `json:"avgPrice"`
+	LeavesQty          string          `json:"leavesQty"`
+	LeavesValue        string          `json:"leavesValue"`
+	CumExecQty         string          `json:"cumExecQty"`
+	CumExecValue       string          `json:"cumExecValue"`
+	CumExecFee         string          `json:"cumExecFee"`
+	TimeInForce        TimeInForce     `json:"timeInForce"`
+	OrderType          OrderType       `json:"orderType"`
+	StopOrderType      string          `json:"stopOrderType"`
+	OrderIv            string          `json:"orderIv"`
+	TriggerPrice       string          `json:"triggerPrice"`
+	TakeProfit         string          `json:"takeProfit"`
+	StopLoss           string          `json:"stopLoss"`
+	TpTriggerBy        TriggerBy       `json:"tpTriggerBy"`
+	SlTriggerBy        TriggerBy       `json:"slTriggerBy"`
+	TriggerDirection   int             `json:"triggerDirection"`
+	TriggerBy          TriggerBy       `json:"triggerBy"`
+	LastPriceOnCreated string          `json:"lastPriceOnCreated"`
+	ReduceOnly         bool            `json:"reduceOnly"`
+	CloseOnTrigger     bool            `json:"closeOnTrigger"`
+	SmpType            SmpType         `json:"smpType"`
+	SmpGroup           int             `json:"smpGroup"`
+	SmpOrderID         string          `json:"smpOrderId"`
+	TpslMode           string          `json:"tpslMode"`
+	TpLimitPrice       string          `json:"tpLimitPrice"`
+	SlLimitPrice       string          `json:"slLimitPrice"`
+	PlaceType          string          `json:"placeType"`
+	CreatedTime        string          `json:"createdTime"`
+	UpdatedTime        string          `json:"updatedTime"`
 }
 type CancelAllOrdersRequest struct {
-	Category      string  `json:"category"`
-	Symbol        *string `json:"symbol,omitempty"`
-	BaseCoin      *string `json:"baseCoin,omitempty"`
-	SettleCoin    *string `json:"settleCoin,omitempty"`
-	OrderFilter   *string `json:"orderFilter,omitempty"`
-	StopOrderType *string `json:"stopOrderType,omitempty"`
+	Category      market.Category `json:"category"`
+	Symbol        *string         `json:"symbol,omitempty"`
+	BaseCoin      *string         `json:"baseCoin,omitempty"`
+	SettleCoin    *string         `json:"settleCoin,omitempty"`
+	OrderFilter   *string         `json:"orderFilter,omitempty"`
+	StopOrderType *string         `json:"stopOrderType,omitempty"`
 }
 type CancelAllOrdersResponse struct {
 	RetCode int    `json:"retCode"`
@@ -176,39 +189,67 @@ type CancelAllOrdersResponse struct {
 	RetExtInfo any   `json:"retExtInfo"`
 	Time       int64 `json:"time"`
 }
+
+// CancelledIDs returns the order ids of every cancelled order.
+func (r *CancelAllOrdersResponse) CancelledIDs() []string {
+	ids := make([]string, 0, len(r.Result.List))
+	for _, order := range r.Result.List {
+		ids = append(ids, order.OrderID)
+	}
+	return ids
+}
+
+// MaxOrderHistoryWindow is the longest startTime to endTime span GetOrderHistory accepts per
+// request. Longer ranges are split into windows of this size.
+const MaxOrderHistoryWindow = 7 * 24 * time.Hour
+
 type GetOrderHistoryRequest struct {
-	Category    string  `json:"category"`
-	Symbol      *string `json:"symbol"`
-	BaseCoin    *string `json:"baseCoin,omitempty"`
-	SettleCoin  *string `json:"settleCoin,omitempty"`
-	OrderID     *string `json:"orderId,omitempty"`
-	OrderFilter *string `json:"orderFilter,omitempty"`
-	OrderStatus *string `json:"orderStatus,omitempty"`
-	StartTime   *int64  `json:"startTime,omitempty"`
-	EndTime     *int64  `json:"endTime,omitempty"`
-	Limit       *int    `json:"limit"`
-	Cursor      *string `json:"cursor"`
+	Category    market.Category `json:"category"`
+	Symbol      *string         `json:"symbol"`
+	BaseCoin    *string         `json:"baseCoin,omitempty"`
+	SettleCoin  *string         `json:"settleCoin,omitempty"`
+	OrderID     *string         `json:"orderId,omitempty"`
+	OrderLinkID *string         `json:"orderLinkId,omitempty"`
+	OrderFilter *string         `json:"orderFilter,omitempty"`
+	OrderStatus *OrderStatus    `json:"orderStatus,omitempty"`
+	StartTime   *int64          `json:"startTime,omitempty"`
+	EndTime     *int64          `json:"endTime,omitempty"`
+	Limit       *int            `json:"limit"`
+	Cursor      *string         `json:"cursor"`
 }
 type GetOrderHistoryResponse struct {
 	RetCode int    `json:"retCode"`
 	RetMsg  string `json:"retMsg"`
 	Result  struct {
-		List           []OrderDetails `json:"list"`
-		NextPageCursor string         `json:"nextPageCursor"`
-		Category       string         `json:"category"`
+		List           []OrderDetails  `json:"list"`
+		NextPageCursor string          `json:"nextPageCursor"`
+		Category       market.Category `json:"category"`
 	} `json:"result"`
 	RetExtInfo any   `json:"retExtInfo"`
 	Time       int64 `json:"time"`
 }
+
+// MaxExecutionsLimit is the largest page size accepted by GetExecutions.
+const MaxExecutionsLimit = 100
+
+// GetExecutionsRequest filters the execution list. Leave Cursor nil to fetch every page.
+type GetExecutionsRequest = GetTradeHistoryRequest
+
+// GetExecutionsResponse is the execution list returned by GetExecutions.
+type GetExecutionsResponse = GetTradeHistoryResponse
+
+// Execution is a single fill. ExecFee is negative for maker rebates.
+type Execution = Details
+
 type GetTradeHistoryRequest struct {
-	Category    string
+	Category    market.Category
 	Symbol      *string
 	OrderID     *string
 	OrderLinkID *string
 	BaseCoin    *string
 	StartTime   *int64
 	EndTime     *int64
-	ExecType    *string
+	ExecType    *ExecType
 	Limit       *int
 	Cursor      *string
 }
@@ -216,99 +257,96 @@ type GetTradeHistoryResponse struct {
 	RetCode int    `json:"retCode"`
 	RetMsg  string `json:"retMsg"`
 	Result  struct {
-		List           []Details `json:"list"`
-		NextPageCursor string    `json:"nextPageCursor"`
-		Category       string    `json:"category"`
+		List           []Details       `json:"list"`
+		NextPageCursor string          `json:"nextPageCursor"`
+		Category       market.Category `json:"category"`
 	} `json:"result"`
 	RetExtInfo any   `json:"retExtInfo"`
 	Time       int64 `json:"time"`
 }
 
 type Details struct {
-	Symbol          string `json:"symbol"`
-	OrderID         string `json:"orderId"`
-	OrderLinkID     string `json:"orderLinkId"`
-	Side            string `json:"side"`
-	OrderPrice      string `json:"orderPrice"`
-	OrderQty        string `json:"orderQty"`
-	LeavesQty       string `json:"leavesQty"`
-	CreateType      string `json:"createType"`
-	OrderType       string `json:"orderType"`
-	StopOrderType   string `json:"stopOrderType"`
-	ExecFee         string `json:"execFee"`
-	ExecID          string `json:"execId"`
-	ExecPrice       string `json:"execPrice"`
-	ExecQty         string `json:"execQty"`
-	ExecType        string `json:"execType"`
-	ExecValue       string `json:"execValue"`
-	ExecTime        string `json:"execTime"`
-	FeeCurrency     string `json:"feeCurrency"`
-	IsMaker         bool   `json:"isMaker"`
-	FeeRate         string `json:"feeRate"`
-	TradeIv         string `json:"tradeIv"`
-	MarkIv          string `json:"markIv"`
-	MarkPrice       string `json:"markPrice"`
-	IndexPrice      string `json:"indexPrice"`
-	UnderlyingPrice string `json:"underlyingPrice"`
-	BlockTradeId    string `json:"blockTradeId"`
-	ClosedSize      string `json:"closedSize"`
-	Seq             int64  `json:"seq"`
+	Symbol          string          `json:"symbol"`
+	OrderID         string          `json:"orderId"`
+	OrderLinkID     string          `json:"orderLinkId"`
+	Side            Side            `json:"side"`
+	OrderPrice      string          `json:"orderPrice"`
+	OrderQty        string          `json:"orderQty"`
+	LeavesQty       string          `json:"leavesQty"`
+	CreateType      string          `json:"createType"`
+	OrderType       OrderType       `json:"orderType"`
+	StopOrderType   string          `json:"stopOrderType"`
+	ExecFee         decimal.Decimal `json:"execFee"`
+	ExecID          string          `json:"execId"`
+	ExecPrice       decimal.Decimal `json:"execPrice"`
+	ExecQty         decimal.Decimal `json:"execQty"`
+	ExecType        ExecType        `json:"execType"`
+	ExecValue       decimal.Decimal `json:"execValue"`
+	ExecTime        string          `json:"execTime"`
+	FeeCurrency     string          `json:"feeCurrency"`
+	IsMaker         bool            `json:"isMaker"`
+	FeeRate         decimal.Decimal `json:"feeRate"`
+	TradeIv         string          `json:"tradeIv"`
+	MarkIv          string          `json:"markIv"`
+	MarkPrice       string          `json:"markPrice"`
+	IndexPrice      string          `json:"indexPrice"`
+	UnderlyingPrice string          `json:"underlyingPrice"`
+	BlockTradeId    string          `json:"blockTradeId"`
+	ClosedSize      string          `json:"closedSize"`
+	Seq             int64           `json:"seq"`
 }
 
 type BatchPlaceOrderRequest struct {
-	Category string         `json:"category"`
-	Request  []OrderRequest `json:"request"`
+	Category market.Category `json:"category"`
+	Request  []OrderRequest  `json:"request"`
 }
 
 type OrderRequest struct {
-	Symbol           string  `json:"symbol"`
-	Side             string  `json:"side"`
-	OrderType        string  `json:"orderType"`
-	Qty              string  `json:"qty"`
-	Price            *string `json:"price,omitempty"`
-	TriggerDirection *int    `json:"triggerDirection,omitempty"`
-	TriggerPrice     *string `json:"triggerPrice,omitempty"`
-	TriggerBy        *string `json:"triggerBy,omitempty"`
-	OrderIv          *string `json:"orderIv,omitempty"`
-	TimeInForce      *string `json:"timeInForce,omitempty"`
-	PositionIdx      *int    `json:"positionIdx,omitempty"`
-	OrderLinkID      *string `json:"orderLinkId,omitempty"`
-	TakeProfit       *string `json:"takeProfit,omitempty"`
-	StopLoss         *string `json:"stopLoss,omitempty"`
-	TpTriggerBy      *string `json:"tpTriggerBy,omitempty"`
-	SlTriggerBy      *string `json:"slTriggerBy,omitempty"`
-	ReduceOnly       *bool   `json:"reduceOnly,omitempty"`
-	CloseOnTrigger   *bool   `json:"closeOnTrigger,omitempty"`
-	SmpType          *string `json:"smpType,omitempty"`
-	Mmp              *bool   `json:"mmp,omitempty"`
-	TpslMode         *string `json:"tpslMode,omitempty"`
-	TpLimitPrice     *string `json:"tpLimitPrice,omitempty"`
-	SlLimitPrice     *string `json:"slLimitPrice,omitempty"`
-	TpOrderType      *string `json:"tpOrderType,omitempty"`
-	SlOrderType      *string `json:"slOrderType,omitempty"`
+	Symbol           string       `json:"symbol"`
+	Side             Side         `json:"side"`
+	OrderType        OrderType    `json:"orderType"`
+	Qty              string       `json:"qty"`
+	Price            *string      `json:"price,omitempty"`
+	TriggerDirection *int         `json:"triggerDirection,omitempty"`
+	TriggerPrice     *string      `json:"triggerPrice,omitempty"`
+	TriggerBy        *TriggerBy   `json:"triggerBy,omitempty"`
+	OrderIv          *string      `json:"orderIv,omitempty"`
+	TimeInForce      *TimeInForce `json:"timeInForce,omitempty"`
+	PositionIdx      *int         `json:"positionIdx,omitempty"`
+	OrderLinkID      *string      `json:"orderLinkId,omitempty"`
+	TakeProfit       *string      `json:"takeProfit,omitempty"`
+	StopLoss         *string      `json:"stopLoss,omitempty"`
+	TpTriggerBy      *TriggerBy   `json:"tpTriggerBy,omitempty"`
+	SlTriggerBy      *TriggerBy   `json:"slTriggerBy,omitempty"`
+	ReduceOnly       *bool        `json:"reduceOnly,omitempty"`
+	CloseOnTrigger   *bool        `json:"closeOnTrigger,omitempty"`
+	SmpType          *SmpType     `json:"smpType,omitempty"`
+	Mmp              *bool        `json:"mmp,omitempty"`
+	TpslMode         *string      `json:"tpslMode,omitempty"`
+	TpLimitPrice     *string      `json:"tpLimitPrice,omitempty"`
+	SlLimitPrice     *string      `json:"slLimitPrice,omitempty"`
+	TpOrderType      *OrderType   `json:"tpOrderType,omitempty"`
+	SlOrderType      *OrderType   `json:"slOrderType,omitempty"`
 }
 type BatchPlaceOrderResponse struct {
 	RetCode int    `json:"retCode"`
 	RetMsg  string `json:"retMsg"`
 	Result  struct {
 		List []struct {
-			Category    string `json:"category"`
-			Symbol      string `json:"symbol"`
-			OrderID     string `json:"orderId"`
-			OrderLinkID string `json:"orderLinkId"`
-			CreateAt    string `json:"createAt"`
+			Category    market.Category `json:"category"`
+			Symbol      string          `json:"symbol"`
+			OrderID     string          `json:"orderId"`
+			OrderLinkID string          `json:"orderLinkId"`
+			CreateAt    string          `json:"createAt"`
 		} `json:"list"`
 	} `json:"result"`
 	RetExtInfo struct {
-		List []struct {
-			Code int    `json:"code"`
-			Msg  string `json:"msg"`
-		} `json:"list"`
+		List []BatchItemStatus `json:"list"`
 	} `json:"retExtInfo"`
 	Time int64 `json:"time"`
 }
 type BatchAmendOrderRequest struct {
-	Category string              `json:"category"`
+	Category market.Category     `json:"category"`
 	Request  []AmendOrderRequest `json:"request"`
 }
 
@@ -317,22 +355,19 @@ type BatchAmendOrderResponse struct {
 	RetMsg  string `json:"retMsg"`
 	Result  struct {
 		List []struct {
-			Category    string `json:"category"`
-			Symbol      string `json:"symbol"`
-			OrderID     string `json:"orderId"`
-			OrderLinkID string `json:"orderLinkId"`
+			Category    market.Category `json:"category"`
+			Symbol      string          `json:"symbol"`
+			OrderID     string          `json:"orderId"`
+			OrderLinkID string          `json:"orderLinkId"`
 		} `json:"list"`
 	} `json:"result"`
 	RetExtInfo struct {
-		List []struct {
-			Code int    `json:"code"`
-			Msg  string `json:"msg"`
-		} `json:"list"`
+		List []BatchItemStatus `json:"list"`
 	} `json:"retExtInfo"`
 	Time int64 `json:"time"`
 }
 type BatchCancelOrderRequest struct {
-	Category string               `json:"category"`
+	Category market.Category      `json:"category"`
 	Request  []CancelOrderRequest `json:"request"`
 }
 type BatchCancelOrderResponse struct {
@@ -340,17 +375,14 @@ type BatchCancelOrderResponse struct {
 	RetMsg  string `json:"retMsg"`
 	Result  struct {
 		List []struct {
-			Category    string `json:"category"`
-			Symbol      string `json:"symbol"`
-			OrderID     string `json:"orderId"`
-			OrderLinkID string `json:"orderLinkId"`
+			Category    market.Category `json:"category"`
+			Symbol      string          `json:"symbol"`
+			OrderID     string          `json:"orderId"`
+			OrderLinkID string          `json:"orderLinkId"`
 		} `json:"list"`
 	} `json:"result"`
 	RetExtInfo struct {
-		List []struct {
-			Code int    `json:"code"`
-			Msg  string `json:"msg"`
-		} `json:"list"`
+		List []BatchItemStatus `json:"list"`
 	} `json:"retExtInfo"`
 	Time int64 `json:"time"`
 }
@@ -375,9 +407,16 @@ type BorrowQuotaResult struct {
 	BorrowCoin         string `json:"borrowCoin"`
 }
 
+// DCP time window bounds in seconds accepted by SetDisconnectCancelAll.
+const (
+	MinDCPTimeWindow = 3
+	MaxDCPTimeWindow = 300
+)
+
 // SetDisconnectCancelAllRequest represents the request payload for setting DCP.
 type SetDisconnectCancelAllRequest struct {
-	TimeWindow int `json:"timeWindow"`
+	TimeWindow int     `json:"timeWindow"`
+	Product    *string `json:"product,omitempty"` // OPTIONS (default), DERIVATIVES or SPOT
 }
 
 // APIResponse represents a generic response from the Bybit API.
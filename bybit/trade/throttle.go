@@ -0,0 +1,264 @@
+package trade
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/market"
+	"golang.org/x/time/rate"
+)
+
+// ErrThrottleClosed is returned for submissions made after ThrottledTrade.Close.
+var ErrThrottleClosed = errors.New("order throttle is closed")
+
+// ThrottleConfig sets the client-side order rate limits. Rates are orders per second and a zero
+// rate disables that limit.
+type ThrottleConfig struct {
+	GlobalRate  float64
+	GlobalBurst int
+	SymbolRate  float64
+	SymbolBurst int
+	// QueueSize is the capacity of the asynchronous queue used by Submit. Defaults to 100.
+	QueueSize int
+}
+
+// ThrottledTrade wraps a Trade so order placement, amendment and cancellation wait for both the
+// global and the per-symbol limiter before reaching Bybit. Batch requests take one token per
+// order, and helpers such as PlaceMarketOrder and ClosePosition send their orders through
+// PlaceOrder. Every other method passes straight through.
+type ThrottledTrade struct {
+	Trade
+	cfg    ThrottleConfig
+	global *rate.Limiter
+
+	mu      sync.Mutex
+	symbols map[string]*rate.Limiter
+
+	// submitMu serialises enqueuing with the final drain, so no submission is left in the
+	// queue after the worker exits.
+	submitMu  sync.Mutex
+	queue     chan submission
+	queueOnce sync.Once
+	depth     atomic.Int64
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+var _ Trade = (*ThrottledTrade)(nil)
+
+// PlaceResult is the outcome of an order submitted through the asynchronous queue.
+type PlaceResult struct {
+	Request  *PlaceOrderRequest
+	Response *PlaceOrderResponse
+	Err      error
+}
+
+type submission struct {
+	req    *PlaceOrderRequest
+	result chan PlaceResult
+}
+
+// NewThrottled wraps t with the given limits.
+func NewThrottled(t Trade, cfg ThrottleConfig) *ThrottledTrade {
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = 100
+	}
+	return &ThrottledTrade{
+		Trade:   t,
+		cfg:     cfg,
+		global:  newLimiter(cfg.GlobalRate, cfg.GlobalBurst),
+		symbols: make(map[string]*rate.Limiter),
+		closed:  make(chan struct{}),
+	}
+}
+
+func newLimiter(perSecond float64, burst int) *rate.Limiter {
+	if perSecond <= 0 {
+		return rate.NewLimiter(rate.Inf, 0)
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	return rate.NewLimiter(rate.Limit(perSecond), burst)
+}
+
+func (t *ThrottledTrade) symbolLimiter(symbol string) *rate.Limiter {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	limiter, ok := t.symbols[symbol]
+	if !ok {
+		limiter = newLimiter(t.cfg.SymbolRate, t.cfg.SymbolBurst)
+		t.symbols[symbol] = limiter
+	}
+	return limiter
+}
+
+// wait blocks until n orders for symbol may be sent.
+func (t *ThrottledTrade) wait(symbol string, n int) error {
+	ctx := context.Background()
+	for i := 0; i < n; i++ {
+		if err := t.global.Wait(ctx); err != nil {
+			return err
+		}
+	}
+	limiter := t.symbolLimiter(symbol)
+	for i := 0; i < n; i++ {
+		if err := limiter.Wait(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *ThrottledTrade) PlaceOrder(req *PlaceOrderRequest) (*PlaceOrderResponse, error) {
+	if err := t.wait(req.Symbol, 1); err != nil {
+		return nil, err
+	}
+	return t.Trade.PlaceOrder(req)
+}
+
+func (t *ThrottledTrade) PlaceMarketOrder(category market.Category, symbol string, side Side, qty string) (*PlaceOrderResponse, error) {
+	req, err := marketOrder(category, symbol, side, qty)
+	if err != nil {
+		return nil, err
+	}
+	return t.PlaceOrder(req)
+}
+
+func (t *ThrottledTrade) PlaceSpotOrderByValue(symbol string, side Side, value string) (*PlaceOrderResponse, error) {
+	req, err := spotOrderByValue(symbol, side, value)
+	if err != nil {
+		return nil, err
+	}
+	return t.PlaceOrder(req)
+}
+
+func (t *ThrottledTrade) ClosePosition(category market.Category, symbol string, positionIdx int) ([]*PlaceOrderResponse, error) {
+	return closePositionVia(t.Trade, t.PlaceOrder, category, symbol, positionIdx, "")
+}
+
+func (t *ThrottledTrade) ClosePositionAt(category market.Category, symbol string, positionIdx int, price string) ([]*PlaceOrderResponse, error) {
+	if price == "" {
+		return nil, errors.New("price is required")
+	}
+	return closePositionVia(t.Trade, t.PlaceOrder, category, symbol, positionIdx, price)
+}
+
+func (t *ThrottledTrade) AmendOrder(req *AmendOrderRequest) (*AmendOrderResponse, error) {
+	if err := t.wait(req.Symbol, 1); err != nil {
+		return nil, err
+	}
+	return t.Trade.AmendOrder(req)
+}
+
+func (t *ThrottledTrade) CancelOrder(req *CancelOrderRequest) (*CancelOrderResponse, error) {
+	if err := t.wait(req.Symbol, 1); err != nil {
+		return nil, err
+	}
+	return t.Trade.CancelOrder(req)
+}
+
+func (t *ThrottledTrade) BatchPlaceOrders(req *BatchPlaceOrderRequest) (*BatchPlaceOrderResponse, error) {
+	for symbol, n := range countSymbols(len(req.Request), func(i int) string { return req.Request[i].Symbol }) {
+		if err := t.wait(symbol, n); err != nil {
+			return nil, err
+		}
+	}
+	return t.Trade.BatchPlaceOrders(req)
+}
+
+func (t *ThrottledTrade) BatchPlaceOrder(req *BatchPlaceOrderRequest) (*BatchPlaceOrderResponse, error) {
+	return t.BatchPlaceOrders(req)
+}
+
+func (t *ThrottledTrade) BatchAmendOrders(req *BatchAmendOrderRequest) (*BatchAmendOrderResponse, error) {
+	for symbol, n := range countSymbols(len(req.Request), func(i int) string { return req.Request[i].Symbol }) {
+		if err := t.wait(symbol, n); err != nil {
+			return nil, err
+		}
+	}
+	return t.Trade.BatchAmendOrders(req)
+}
+
+func (t *ThrottledTrade) BatchCancelOrders(req *BatchCancelOrderRequest) (*BatchCancelOrderResponse, error) {
+	for symbol, n := range countSymbols(len(req.Request), func(i int) string { return req.Request[i].Symbol }) {
+		if err := t.wait(symbol, n); err != nil {
+			return nil, err
+		}
+	}
+	return t.Trade.BatchCancelOrders(req)
+}
+
+func (t *ThrottledTrade) unwrap() Trade {
+	return t.Trade
+}
+
+func countSymbols(n int, symbol func(i int) string) map[string]int {
+	counts := make(map[string]int)
+	for i := 0; i < n; i++ {
+		counts[symbol(i)]++
+	}
+	return counts
+}
+
+// Submit queues an order for asynchronous placement and returns a channel that receives its
+// result. Orders are sent in submission order by a single worker.
+func (t *ThrottledTrade) Submit(req *PlaceOrderRequest) <-chan PlaceResult {
+	t.queueOnce.Do(t.startWorker)
+	result := make(chan PlaceResult, 1)
+	t.submitMu.Lock()
+	defer t.submitMu.Unlock()
+	select {
+	case <-t.closed:
+		result <- PlaceResult{Request: req, Err: ErrThrottleClosed}
+		return result
+	default:
+	}
+	t.depth.Add(1)
+	select {
+	case t.queue <- submission{req: req, result: result}:
+	case <-t.closed:
+		t.depth.Add(-1)
+		result <- PlaceResult{Request: req, Err: ErrThrottleClosed}
+	}
+	return result
+}
+
+// QueueDepth returns the number of submitted orders not yet sent.
+func (t *ThrottledTrade) QueueDepth() int {
+	return int(t.depth.Load())
+}
+
+// Close stops the asynchronous worker. Queued orders that were not sent yet fail with
+// ErrThrottleClosed.
+func (t *ThrottledTrade) Close() {
+	t.closeOnce.Do(func() { close(t.closed) })
+}
+
+func (t *ThrottledTrade) startWorker() {
+	t.queue = make(chan submission, t.cfg.QueueSize)
+	go func() {
+		for {
+			select {
+			case <-t.closed:
+				t.submitMu.Lock()
+				defer t.submitMu.Unlock()
+				for {
+					select {
+					case s := <-t.queue:
+						t.depth.Add(-1)
+						s.result <- PlaceResult{Request: s.req, Err: ErrThrottleClosed}
+					default:
+						return
+					}
+				}
+			case s := <-t.queue:
+				res, err := t.PlaceOrder(s.req)
+				t.depth.Add(-1)
+				s.result <- PlaceResult{Request: s.req, Response: res, Err: err}
+			}
+		}
+	}()
+}
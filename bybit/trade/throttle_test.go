@@ -0,0 +1,129 @@
+package trade
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/market"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type countingTrade struct {
+	Trade
+	mu     sync.Mutex
+	placed []time.Time
+}
+
+func (c *countingTrade) PlaceOrder(*PlaceOrderRequest) (*PlaceOrderResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.placed = append(c.placed, time.Now())
+	return &PlaceOrderResponse{}, nil
+}
+
+func TestThrottledTradeSymbolLimit(t *testing.T) {
+	fake := &countingTrade{}
+	throttled := NewThrottled(fake, ThrottleConfig{SymbolRate: 20, SymbolBurst: 1})
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		_, err := throttled.PlaceOrder(&PlaceOrderRequest{Symbol: "BTCUSDT"})
+		require.NoError(t, err)
+	}
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+
+	// Another symbol has its own budget.
+	start = time.Now()
+	_, err := throttled.PlaceOrder(&PlaceOrderRequest{Symbol: "ETHUSDT"})
+	require.NoError(t, err)
+	assert.Less(t, time.Since(start), 40*time.Millisecond)
+}
+
+func TestThrottledTradeSubmit(t *testing.T) {
+	fake := &countingTrade{}
+	throttled := NewThrottled(fake, ThrottleConfig{GlobalRate: 50, GlobalBurst: 1})
+	defer throttled.Close()
+
+	var results []<-chan PlaceResult
+	for i := 0; i < 4; i++ {
+		results = append(results, throttled.Submit(&PlaceOrderRequest{Symbol: "BTCUSDT"}))
+	}
+	assert.Greater(t, throttled.QueueDepth(), 0)
+	for _, result := range results {
+		assert.NoError(t, (<-result).Err)
+	}
+	assert.Equal(t, 0, throttled.QueueDepth())
+	assert.Len(t, fake.placed, 4)
+
+	throttled.Close()
+	assert.ErrorIs(t, (<-throttled.Submit(&PlaceOrderRequest{Symbol: "BTCUSDT"})).Err, ErrThrottleClosed)
+}
+
+func TestThrottledTradeCloseAnswersEverySubmit(t *testing.T) {
+	throttled := NewThrottled(&countingTrade{}, ThrottleConfig{GlobalRate: 1000, QueueSize: 4})
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-throttled.Submit(&PlaceOrderRequest{Symbol: "BTCUSDT"})
+		}()
+	}
+	throttled.Close()
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("a submission racing Close never received a result")
+	}
+	assert.Equal(t, 0, throttled.QueueDepth())
+}
+
+// TestThrottledTradeHelpers verifies the order helpers of the wrapped Trade take a token per
+// order instead of bypassing the throttle.
+func TestThrottledTradeHelpers(t *testing.T) {
+	var created atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v5/position/list":
+			_, _ = w.Write([]byte(`{"retCode":0,"result":{"list":[
+				{"symbol":"BTCUSDT","side":"Buy","size":"0.01","positionIdx":1},
+				{"symbol":"BTCUSDT","side":"Sell","size":"0.02","positionIdx":2}]}}`))
+		case "/v5/order/create":
+			created.Add(1)
+			_, _ = w.Write([]byte(`{"retCode":0,"result":{"orderId":"1"}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	journal, err := OpenFileJournal(filepath.Join(t.TempDir(), "orders.jsonl"))
+	require.NoError(t, err)
+	defer journal.Close()
+	inner := New(client.NewClientWithBaseURL("key", "secret", server.URL))
+	throttled := NewThrottled(NewJournaled(inner, journal), ThrottleConfig{SymbolRate: 0.001, SymbolBurst: 4})
+
+	responses, err := throttled.ClosePosition(market.CategoryLinear, "BTCUSDT", 0)
+	require.NoError(t, err)
+	assert.Len(t, responses, 2)
+	_, err = throttled.PlaceMarketOrder(market.CategoryLinear, "BTCUSDT", SideBuy, "0.01")
+	require.NoError(t, err)
+	_, err = throttled.PlaceSpotOrderByValue("BTCUSDT", SideBuy, "100")
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(4), created.Load())
+	assert.Less(t, throttled.symbolLimiter("BTCUSDT").Tokens(), 1.0, "every order must take a token")
+}
@@ -0,0 +1,227 @@
+package trade
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cploutarchou/crypto-sdk-suite/decimal"
+)
+
+// OrderTracker keeps the authoritative state of working orders. Feed it the private order and
+// execution streams with Update and UpdateExecution, and call Reconcile (or Run) to repair any
+// updates missed while the websocket was down. Orders leave the tracker once they reach a
+// final status; their final state is remembered for FinalTTL so a stale snapshot or a late
+// push cannot bring them back. Execution ids are remembered for FinalTTL as well.
+//
+// Callbacks run on the goroutine that delivered the update, outside the tracker's lock.
+type OrderTracker struct {
+	trade Trade
+
+	// OnFill is called when an order's executed quantity increases.
+	OnFill func(order OrderDetails)
+	// OnCancel is called when an order is cancelled or deactivated, including partially filled
+	// orders whose remainder was cancelled.
+	OnCancel func(order OrderDetails)
+	// OnReject is called when Bybit rejects an order.
+	OnReject func(order OrderDetails)
+	// OnExecution is called once per execution id.
+	OnExecution func(execution Execution)
+
+	// FinalTTL is how long the final state of an order, and the id of a reported execution, is
+	// remembered. Defaults to 10 minutes.
+	FinalTTL time.Duration
+
+	mu         sync.Mutex
+	orders     map[string]OrderDetails
+	finals     map[string]time.Time // expiry of final orders by order id
+	executions map[string]time.Time // expiry of reported executions by exec id
+	now        func() time.Time
+}
+
+// NewOrderTracker creates an empty tracker that reconciles through t.
+func NewOrderTracker(t Trade) *OrderTracker {
+	return &OrderTracker{
+		trade:      t,
+		orders:     make(map[string]OrderDetails),
+		finals:     make(map[string]time.Time),
+		executions: make(map[string]time.Time),
+		now:        time.Now,
+	}
+}
+
+// Orders returns the working orders sorted by creation time.
+func (tr *OrderTracker) Orders() []OrderDetails {
+	tr.mu.Lock()
+	orders := make([]OrderDetails, 0, len(tr.orders))
+	for _, order := range tr.orders {
+		orders = append(orders, order)
+	}
+	tr.mu.Unlock()
+	sort.Slice(orders, func(i, j int) bool { return orders[i].CreatedTime < orders[j].CreatedTime })
+	return orders
+}
+
+// Get returns a working order by order id.
+func (tr *OrderTracker) Get(orderID string) (OrderDetails, bool) {
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	order, ok := tr.orders[orderID]
+	return order, ok
+}
+
+// Update applies an order update. Updates older than the tracked state, and any update of an
+// order that already reached a final status, are ignored so REST snapshots and websocket pushes
+// can be mixed freely.
+func (tr *OrderTracker) Update(order OrderDetails) {
+	tr.mu.Lock()
+	now := tr.now()
+	tr.pruneFinals(now)
+	if _, final := tr.finals[order.OrderID]; final {
+		tr.mu.Unlock()
+		return
+	}
+	previous, known := tr.orders[order.OrderID]
+	if known && millis(order.UpdatedTime) < millis(previous.UpdatedTime) {
+		tr.mu.Unlock()
+		return
+	}
+	if order.Category == "" {
+		order.Category = previous.Category
+	}
+	if order.OrderStatus.IsFinal() {
+		delete(tr.orders, order.OrderID)
+		tr.finals[order.OrderID] = now.Add(tr.finalTTL())
+	} else {
+		tr.orders[order.OrderID] = order
+	}
+	tr.mu.Unlock()
+
+	if filledMore(previous, order) && tr.OnFill != nil {
+		tr.OnFill(order)
+	}
+	if known && previous.OrderStatus == order.OrderStatus {
+		return
+	}
+	switch order.OrderStatus {
+	case OrderStatusCancelled, OrderStatusPartiallyFilledCanceled, OrderStatusDeactivated:
+		if tr.OnCancel != nil {
+			tr.OnCancel(order)
+		}
+	case OrderStatusRejected:
+		if tr.OnReject != nil {
+			tr.OnReject(order)
+		}
+	}
+}
+
+func (tr *OrderTracker) finalTTL() time.Duration {
+	if tr.FinalTTL > 0 {
+		return tr.FinalTTL
+	}
+	return 10 * time.Minute
+}
+
+// pruneFinals forgets final orders whose tombstone expired. Callers must hold tr.mu.
+func (tr *OrderTracker) pruneFinals(now time.Time) {
+	pruneExpired(tr.finals, now)
+}
+
+// pruneExpired deletes the entries of m that expired before now.
+func pruneExpired(m map[string]time.Time, now time.Time) {
+	for id, expires := range m {
+		if now.After(expires) {
+			delete(m, id)
+		}
+	}
+}
+
+// UpdateExecution reports an execution once, however often it is delivered within FinalTTL.
+func (tr *OrderTracker) UpdateExecution(execution Execution) {
+	tr.mu.Lock()
+	now := tr.now()
+	pruneExpired(tr.executions, now)
+	if _, seen := tr.executions[execution.ExecID]; seen {
+		tr.mu.Unlock()
+		return
+	}
+	tr.executions[execution.ExecID] = now.Add(tr.finalTTL())
+	tr.mu.Unlock()
+
+	if tr.OnExecution != nil {
+		tr.OnExecution(execution)
+	}
+}
+
+// Reconcile fetches the open orders matching req and applies them. Tracked orders in the same
+// category that are no longer open are looked up in the order history so their final status,
+// and the matching callbacks, are not lost.
+func (tr *OrderTracker) Reconcile(req *GetOpenOrdersRequest) error {
+	open, err := tr.trade.GetOpenOrders(req)
+	if err != nil {
+		return fmt.Errorf("error reconciling open orders: %w", err)
+	}
+	seen := make(map[string]bool, len(open.Result.List))
+	for _, order := range open.Result.List {
+		order.Category = req.Category
+		seen[order.OrderID] = true
+		tr.Update(order)
+	}
+
+	for _, order := range tr.Orders() {
+		if seen[order.OrderID] || order.Category != req.Category ||
+			(req.Symbol != nil && *req.Symbol != order.Symbol) {
+			continue
+		}
+		orderID := order.OrderID
+		history, err := tr.trade.GetOrderHistory(&GetOrderHistoryRequest{Category: req.Category, OrderID: &orderID})
+		if err != nil {
+			return fmt.Errorf("error reconciling order %s: %w", orderID, err)
+		}
+		for _, closed := range history.Result.List {
+			closed.Category = req.Category
+			tr.Update(closed)
+		}
+	}
+	return nil
+}
+
+// Run reconciles every interval until ctx is done. Reconcile errors are passed to onError, if
+// set, and do not stop the loop.
+func (tr *OrderTracker) Run(ctx context.Context, interval time.Duration, onError func(error), reqs ...*GetOpenOrdersRequest) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		for _, req := range reqs {
+			if err := tr.Reconcile(req); err != nil && onError != nil {
+				onError(err)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// filledMore reports whether next has a larger executed quantity than previous.
+func filledMore(previous, next OrderDetails) bool {
+	filled, err := decimal.NewFromString(next.CumExecQty)
+	if err != nil || filled.Sign() <= 0 {
+		return false
+	}
+	before, err := decimal.NewFromString(previous.CumExecQty)
+	if err != nil {
+		return true
+	}
+	return filled.GreaterThan(before)
+}
+
+func millis(s string) int64 {
+	ms, _ := strconv.ParseInt(s, 10, 64)
+	return ms
+}
@@ -0,0 +1,97 @@
+package trade
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type reconcileFake struct {
+	Trade
+	open    []OrderDetails
+	history map[string]OrderDetails
+}
+
+func (f *reconcileFake) GetOpenOrders(*GetOpenOrdersRequest) (*GetOpenOrdersResponse, error) {
+	var res GetOpenOrdersResponse
+	res.Result.List = f.open
+	return &res, nil
+}
+
+func (f *reconcileFake) GetOrderHistory(req *GetOrderHistoryRequest) (*GetOrderHistoryResponse, error) {
+	var res GetOrderHistoryResponse
+	if order, ok := f.history[*req.OrderID]; ok {
+		res.Result.List = []OrderDetails{order}
+	}
+	return &res, nil
+}
+
+func TestOrderTrackerUpdates(t *testing.T) {
+	tracker := NewOrderTracker(nil)
+	var fills, cancels, rejects int
+	tracker.OnFill = func(OrderDetails) { fills++ }
+	tracker.OnCancel = func(OrderDetails) { cancels++ }
+	tracker.OnReject = func(OrderDetails) { rejects++ }
+
+	tracker.Update(OrderDetails{OrderID: "1", OrderStatus: OrderStatusNew, CumExecQty: "0", UpdatedTime: "100"})
+	tracker.Update(OrderDetails{OrderID: "1", OrderStatus: OrderStatusPartiallyFilled, CumExecQty: "0.5", UpdatedTime: "200"})
+	tracker.Update(OrderDetails{OrderID: "1", OrderStatus: OrderStatusNew, CumExecQty: "0", UpdatedTime: "150"}) // stale
+	order, ok := tracker.Get("1")
+	require.True(t, ok)
+	assert.Equal(t, OrderStatusPartiallyFilled, order.OrderStatus)
+
+	tracker.Update(OrderDetails{OrderID: "1", OrderStatus: OrderStatusFilled, CumExecQty: "1", UpdatedTime: "300"})
+	_, ok = tracker.Get("1")
+	assert.False(t, ok)
+
+	// A stale snapshot must not bring the filled order back or report its fill again.
+	tracker.Update(OrderDetails{OrderID: "1", OrderStatus: OrderStatusPartiallyFilled, CumExecQty: "0.5", UpdatedTime: "200"})
+	_, ok = tracker.Get("1")
+	assert.False(t, ok)
+
+	tracker.Update(OrderDetails{OrderID: "2", OrderStatus: OrderStatusRejected, UpdatedTime: "100"})
+	assert.Equal(t, 2, fills)
+	assert.Equal(t, 0, cancels)
+	assert.Equal(t, 1, rejects)
+
+	// Once the final state expired the order id is forgotten.
+	tracker.now = func() time.Time { return time.Now().Add(time.Hour) }
+	tracker.Update(OrderDetails{OrderID: "1", OrderStatus: OrderStatusNew, UpdatedTime: "400"})
+	_, ok = tracker.Get("1")
+	assert.True(t, ok)
+
+	var executions int
+	tracker.OnExecution = func(Execution) { executions++ }
+	tracker.UpdateExecution(Execution{ExecID: "e1"})
+	tracker.UpdateExecution(Execution{ExecID: "e1"})
+	assert.Equal(t, 1, executions)
+
+	// Execution ids expire like final orders.
+	tracker.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+	tracker.UpdateExecution(Execution{ExecID: "e2"})
+	assert.Len(t, tracker.executions, 1)
+}
+
+func TestOrderTrackerReconcile(t *testing.T) {
+	fake := &reconcileFake{history: map[string]OrderDetails{
+		"2": {OrderID: "2", OrderStatus: OrderStatusCancelled, UpdatedTime: "500"},
+	}}
+	tracker := NewOrderTracker(fake)
+	var cancelled []string
+	tracker.OnCancel = func(order OrderDetails) { cancelled = append(cancelled, order.OrderID) }
+
+	fake.open = []OrderDetails{
+		{OrderID: "1", OrderStatus: OrderStatusNew, CreatedTime: "1", UpdatedTime: "100"},
+		{OrderID: "2", OrderStatus: OrderStatusNew, CreatedTime: "2", UpdatedTime: "100"},
+	}
+	require.NoError(t, tracker.Reconcile(&GetOpenOrdersRequest{Category: "spot"}))
+	assert.Len(t, tracker.Orders(), 2)
+
+	fake.open = fake.open[:1]
+	require.NoError(t, tracker.Reconcile(&GetOpenOrdersRequest{Category: "spot"}))
+	assert.Equal(t, []string{"2"}, cancelled)
+	require.Len(t, tracker.Orders(), 1)
+	assert.Equal(t, "1", tracker.Orders()[0].OrderID)
+}
@@ -1,24 +1,120 @@
 package trade
 
 import (
-	"encoding/json"
 	"fmt"
+	"log"
 	"net/url"
 	"strconv"
 	"strings"
 
 	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/market"
 )
 
 type Trade interface {
 	PlaceOrder(req *PlaceOrderRequest) (*PlaceOrderResponse, error)
+	// PlaceMarketOrder places a market order with no other options. For spot the quantity is in
+	// the base coin on both sides; use PlaceSpotOrderByValue to spend a quote coin amount.
+	// category: string - the product category.
+	// symbol: string - the symbol to trade.
+	// side: Side - Buy or Sell.
+	// qty: string - the order quantity.
+	// returns: *PlaceOrderResponse - the ids of the placed order.
+	//          error - an error if the request fails.
+	PlaceMarketOrder(category market.Category, symbol string, side Side, qty string) (*PlaceOrderResponse, error)
+	// PlaceSpotOrderByValue places a spot market order sized in the quote coin, e.g. buying 500
+	// USDT worth of BTCUSDT.
+	// symbol: string - the spot symbol.
+	// side: Side - Buy or Sell.
+	// value: string - the amount of quote coin to spend or receive.
+	// returns: *PlaceOrderResponse - the ids of the placed order.
+	//          error - an error if the request fails.
+	PlaceSpotOrderByValue(symbol string, side Side, value string) (*PlaceOrderResponse, error)
+	// ClosePosition looks up the current position and closes it with a reduce-only market order
+	// of its full size. In hedge mode a positionIdx of 1 or 2 closes one side and 0 closes both;
+	// in one-way mode pass 0.
+	// category: string - linear, inverse or option.
+	// symbol: string - the symbol of the position.
+	// positionIdx: int - the position index to close, 0 for every open position of the symbol.
+	// returns: []*PlaceOrderResponse - one response per closing order.
+	//          error - an error if a lookup or order fails.
+	ClosePosition(category market.Category, symbol string, positionIdx int) ([]*PlaceOrderResponse, error)
+	// ClosePositionAt works like ClosePosition but closes with reduce-only limit orders at price.
+	ClosePositionAt(category market.Category, symbol string, positionIdx int, price string) ([]*PlaceOrderResponse, error)
+	// AmendOrder modifies the price, quantity, trigger price or TP/SL of a resting order without
+	// cancelling it. The order is identified by OrderID or OrderLinkID.
+	// req: *AmendOrderRequest - the order to amend and the fields to change.
+	// returns: *AmendOrderResponse - the ids of the amended order.
+	//          error - an error if the request is invalid or fails.
 	AmendOrder(req *AmendOrderRequest) (*AmendOrderResponse, error)
+	// CancelOrder cancels an open order identified by OrderID or OrderLinkID.
+	// req: *CancelOrderRequest - the order to cancel.
+	// returns: *CancelOrderResponse - the ids of the cancelled order.
+	//          error - ErrOrderNotFound or ErrOrderAlreadyClosed if there was nothing left to cancel,
+	//          or an error if the request fails.
 	CancelOrder(req *CancelOrderRequest) (*CancelOrderResponse, error)
+	// GetOpenOrders queries real-time open orders and, with OpenOnly set, recently closed ones.
+	// When no cursor is supplied every page is fetched and merged into a single response.
+	// req: *GetOpenOrdersRequest - the category and filters.
+	// returns: *GetOpenOrdersResponse - the matching orders.
+	//          error - an error if the request is invalid or fails.
 	GetOpenOrders(req *GetOpenOrdersRequest) (*GetOpenOrdersResponse, error)
+	// CancelAllOrders cancels every open order matching the filters. Linear and inverse requests
+	// must be narrowed by symbol, base coin or settle coin.
+	// req: *CancelAllOrdersRequest - the category, filters and optional orderFilter.
+	// returns: *CancelAllOrdersResponse - the cancelled orders, see CancelledIDs.
+	//          error - an error if the request is invalid or fails.
 	CancelAllOrders(req *CancelAllOrdersRequest) (*CancelAllOrdersResponse, error)
+	// GetOrderHistory queries closed and open orders. When no cursor is supplied every page is
+	// fetched, and a StartTime to EndTime range longer than MaxOrderHistoryWindow is split into
+	// windows so the whole range is covered.
+	// req: *GetOrderHistoryRequest - the category, filters and time range.
+	// returns: *GetOrderHistoryResponse - the matching orders, newest first.
+	//          error - an error if the request fails.
 	GetOrderHistory(req *GetOrderHistoryRequest) (*GetOrderHistoryResponse, error)
+	// GetExecutions queries the account's fills. When no cursor is supplied every page is fetched
+	// and merged into a single response.
+	// req: *GetExecutionsRequest - the category and filters.
+	// returns: *GetExecutionsResponse - the matching executions, newest first.
+	//          error - an error if the request is invalid or fails.
+	GetExecutions(req *GetExecutionsRequest) (*GetExecutionsResponse, error)
+	// Deprecated: use GetExecutions.
 	GetTradeHistory(req *GetTradeHistoryRequest) (*GetTradeHistoryResponse, error)
+	// BatchPlaceOrders places up to MaxBatchOrders orders for the category in one request. Orders
+	// are accepted or rejected individually, see BatchPlaceOrderResponse.Results.
+	// req: *BatchPlaceOrderRequest - the category and the orders to place.
+	// returns: *BatchPlaceOrderResponse - the placed orders and per-order status.
+	//          error - an error if the batch is invalid or the request fails.
+	BatchPlaceOrders(req *BatchPlaceOrderRequest) (*BatchPlaceOrderResponse, error)
+	// Deprecated: use BatchPlaceOrders.
 	BatchPlaceOrder(req *BatchPlaceOrderRequest) (*BatchPlaceOrderResponse, error)
+	// BatchAmendOrders amends up to MaxBatchOrders orders for the category in one request. Each
+	// amendment succeeds or fails on its own, see BatchAmendOrderResponse.Results.
+	// req: *BatchAmendOrderRequest - the category and the amendments.
+	// returns: *BatchAmendOrderResponse - the amended orders and per-order status.
+	//          error - an error if the batch is invalid or the request fails.
+	BatchAmendOrders(req *BatchAmendOrderRequest) (*BatchAmendOrderResponse, error)
+	// BatchCancelOrders cancels up to MaxBatchOrders orders for the category in one request. Orders
+	// may be referenced by OrderID or OrderLinkID, see BatchCancelOrderResponse.Results.
+	// req: *BatchCancelOrderRequest - the category and the orders to cancel.
+	// returns: *BatchCancelOrderResponse - the cancelled orders and per-order status.
+	//          error - an error if the batch is invalid or the request fails.
+	BatchCancelOrders(req *BatchCancelOrderRequest) (*BatchCancelOrderResponse, error)
+	// GetBorrowQuota returns the maximum spot quantity and amount that can be traded for a symbol
+	// and side, including what the account can borrow under spot margin.
+	// symbol: string - the spot symbol, e.g. BTCUSDT.
+	// side: string - Buy or Sell.
+	// returns: *BorrowQuotaResponse - the tradable and borrowable amounts.
+	//          error - an error if the request fails.
+	GetBorrowQuota(symbol, side string) (*BorrowQuotaResponse, error)
+	// SetDisconnectCancelAll arms Bybit's disconnection protection: if the private websocket stays
+	// disconnected for TimeWindow seconds every open order of the product is cancelled. Use
+	// account.DCPInfo to check the configured window.
+	// req: *SetDisconnectCancelAllRequest - the time window and product.
+	// returns: *APIResponse - the API result.
+	//          error - an error if the window is out of range or the request fails.
+	SetDisconnectCancelAll(req *SetDisconnectCancelAllRequest) (*APIResponse, error)
+	// Deprecated: use GetBorrowQuota.
 	GetBorrowQuotaSpot(symbol, side string) (*BorrowQuotaResponse, error)
 }
 
@@ -34,15 +130,56 @@ func generateCurlCommand(endpoint string, params map[string]string) string {
 
 type tradeImpl struct {
 	client *client.Client
+	cache  *market.InstrumentCache
+	safety SafetyMode
+	logger *log.Logger
 }
 
-func New(c *client.Client) Trade {
-	return &tradeImpl{client: c}
+// New creates a Trade for the client. Options can enable instrument validation or a safety mode,
+// e.g. New(c, WithSafetyMode(SafetyDryRun)).
+func New(c *client.Client, opts ...Option) Trade {
+	t := &tradeImpl{client: c}
+	for _, opt := range opts {
+		opt(t)
+	}
+	return t
+}
+
+// NewWithValidation creates a Trade that checks PlaceOrder and AmendOrder requests against the
+// tick size, qty step, min/max qty and min notional of the cached instrument before sending
+// them. Violations are returned as *instrument.ValidationError without spending API quota.
+func NewWithValidation(c *client.Client, cache *market.InstrumentCache, opts ...Option) Trade {
+	return New(c, append([]Option{WithInstrumentCache(cache)}, opts...)...)
+}
+
+// instrumentInfo returns the cached instrument for the order, or nil when validation is off.
+func (t *tradeImpl) instrumentInfo(category market.Category, symbol string) (*market.InstrumentInfo, error) {
+	if t.cache == nil {
+		return nil, nil
+	}
+	info, err := t.cache.Get(category, symbol)
+	if err != nil {
+		return nil, fmt.Errorf("error loading instrument %s: %w", symbol, err)
+	}
+	return info, nil
 }
 
 func (t *tradeImpl) PlaceOrder(req *PlaceOrderRequest) (*PlaceOrderResponse, error) {
+	req, err := t.reduceOnly(req)
+	if err != nil {
+		return nil, err
+	}
+	info, err := t.instrumentInfo(req.Category, req.Symbol)
+	if err != nil {
+		return nil, err
+	}
+	if info != nil {
+		if err := req.Validate(info); err != nil {
+			return nil, err
+		}
+	}
 	params := ConvertPlaceOrderRequestToParams(req)
-	res, err := t.client.Post("/v5/order/create", params)
+	res, err := t.post("/v5/order/create", params)
 	if err != nil {
 		return nil, err
 	}
@@ -58,6 +195,36 @@ func (t *tradeImpl) PlaceOrder(req *PlaceOrderRequest) (*PlaceOrderResponse, err
 	return &placeOrderResponse, nil
 }
 
+func (t *tradeImpl) PlaceMarketOrder(category market.Category, symbol string, side Side, qty string) (*PlaceOrderResponse, error) {
+	req, err := marketOrder(category, symbol, side, qty)
+	if err != nil {
+		return nil, err
+	}
+	return t.PlaceOrder(req)
+}
+
+func (t *tradeImpl) PlaceSpotOrderByValue(symbol string, side Side, value string) (*PlaceOrderResponse, error) {
+	req, err := spotOrderByValue(symbol, side, value)
+	if err != nil {
+		return nil, err
+	}
+	return t.PlaceOrder(req)
+}
+
+// marketOrder builds the request sent by PlaceMarketOrder.
+func marketOrder(category market.Category, symbol string, side Side, qty string) (*PlaceOrderRequest, error) {
+	builder := NewMarketOrder(symbol).Category(category).Side(side).Qty(qty)
+	if category == market.CategorySpot {
+		builder = builder.MarketUnit(MarketUnitBaseCoin)
+	}
+	return builder.Build()
+}
+
+// spotOrderByValue builds the request sent by PlaceSpotOrderByValue.
+func spotOrderByValue(symbol string, side Side, value string) (*PlaceOrderRequest, error) {
+	return NewMarketOrder(symbol).Category(market.CategorySpot).Side(side).Qty(value).MarketUnit(MarketUnitQuoteCoin).Build()
+}
+
 func ConvertPlaceOrderRequestToParams(req *PlaceOrderRequest) client.Params {
 	params := client.Params{
 		"category":    req.Category,
@@ -135,23 +302,33 @@ func ConvertPlaceOrderRequestToParams(req *PlaceOrderRequest) client.Params {
 	if req.SlOrderType != nil {
 		params["slOrderType"] = *req.SlOrderType
 	}
+	if req.MarketUnit != nil {
+		params["marketUnit"] = *req.MarketUnit
+	}
 
 	return params
 }
 func (t *tradeImpl) AmendOrder(req *AmendOrderRequest) (*AmendOrderResponse, error) {
-	params := ConvertAmendOrderRequestToParams(req)
-	res, err := t.client.Post("/v5/order/amend", params)
-	if err != nil {
+	if err := req.Validate(); err != nil {
 		return nil, err
 	}
-	data, err := json.Marshal(res)
+	info, err := t.instrumentInfo(req.Category, req.Symbol)
 	if err != nil {
 		return nil, err
 	}
-	var response AmendOrderResponse
-	err = json.Unmarshal(data, &response)
+	if info != nil {
+		if err := req.ValidateFilters(info); err != nil {
+			return nil, err
+		}
+	}
+	params := ConvertAmendOrderRequestToParams(req)
+	res, err := t.post("/v5/order/amend", params)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("error amending order: %w", err)
+	}
+	var response AmendOrderResponse
+	if err := res.Unmarshal(&response); err != nil {
+		return nil, fmt.Errorf("error parsing amend order response: %w", err)
 	}
 
 	if response.RetCode != 0 {
@@ -161,199 +338,304 @@ func (t *tradeImpl) AmendOrder(req *AmendOrderRequest) (*AmendOrderResponse, err
 	return &response, nil
 }
 func (t *tradeImpl) CancelOrder(req *CancelOrderRequest) (*CancelOrderResponse, error) {
-	params := ConvertCancelOrderRequestToParams(req)
-
-	resBytes, err := t.client.Post("/v5/order/cancel", params)
-	if err != nil {
+	if err := req.Validate(); err != nil {
 		return nil, err
 	}
-	data, err := json.Marshal(resBytes)
+	params := ConvertCancelOrderRequestToParams(req)
+
+	res, err := t.post("/v5/order/cancel", params)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("error cancelling order: %w", err)
 	}
 	var response CancelOrderResponse
-	err = json.Unmarshal(data, &response)
-	if err != nil {
-		return nil, err
+	if err := res.Unmarshal(&response); err != nil {
+		return nil, fmt.Errorf("error parsing cancel order response: %w", err)
 	}
 
 	if response.RetCode != 0 {
-		return &response, fmt.Errorf("API returned error: %s", response.RetMsg)
+		return &response, apiError(response.RetCode, response.RetMsg)
 	}
 
 	return &response, nil
 }
 func (t *tradeImpl) GetOpenOrders(req *GetOpenOrdersRequest) (*GetOpenOrdersResponse, error) {
-	queryParams := ConvertGetOpenOrdersRequestToParams(req)
-
-	// Assuming the client.Get method constructs the query string from the provided params and sends a GET request.
-	resBytes, err := t.client.Get("/v5/order/realtime", queryParams)
-	if err != nil {
+	if err := req.Validate(); err != nil {
 		return nil, err
 	}
-	var response GetOpenOrdersResponse
-	err = resBytes.Unmarshal(&response)
-	if err != nil {
-		return nil, err
-	}
-	if response.RetCode != 0 {
-		return &response, fmt.Errorf("API returned error: %s", response.RetMsg)
-	}
 
-	return &response, nil
+	followCursor := req.Cursor == nil
+	page := *req
+	var merged *GetOpenOrdersResponse
+	for {
+		res, err := t.client.Get("/v5/order/realtime", ConvertGetOpenOrdersRequestToParams(&page))
+		if err != nil {
+			return nil, fmt.Errorf("error fetching open orders: %w", err)
+		}
+		var response GetOpenOrdersResponse
+		if err := res.Unmarshal(&response); err != nil {
+			return nil, fmt.Errorf("error parsing open orders response: %w", err)
+		}
+		if response.RetCode != 0 {
+			return &response, apiError(response.RetCode, response.RetMsg)
+		}
+
+		if merged == nil {
+			merged = &response
+		} else {
+			merged.Result.List = append(merged.Result.List, response.Result.List...)
+			merged.Result.NextPageCursor = response.Result.NextPageCursor
+			merged.Time = response.Time
+		}
+		if !followCursor || response.Result.NextPageCursor == "" {
+			break
+		}
+		cursor := response.Result.NextPageCursor
+		page.Cursor = &cursor
+	}
+	return merged, nil
 }
 func (t *tradeImpl) CancelAllOrders(req *CancelAllOrdersRequest) (*CancelAllOrdersResponse, error) {
-	params := ConvertCancelAllOrdersRequestToParams(req)
-
-	resBytes, err := t.client.Post("/v5/order/cancel-all", params)
-	if err != nil {
+	if err := req.Validate(); err != nil {
 		return nil, err
 	}
-	data, err := json.Marshal(resBytes)
+	params := ConvertCancelAllOrdersRequestToParams(req)
+
+	res, err := t.post("/v5/order/cancel-all", params)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("error cancelling orders: %w", err)
 	}
 	var response CancelAllOrdersResponse
-	err = json.Unmarshal(data, &response)
-	if err != nil {
-		return nil, err
+	if err := res.Unmarshal(&response); err != nil {
+		return nil, fmt.Errorf("error parsing cancel all orders response: %w", err)
 	}
 
 	if response.RetCode != 0 {
-		return &response, fmt.Errorf("API returned error: %s", response.RetMsg)
+		return &response, apiError(response.RetCode, response.RetMsg)
 	}
 
 	return &response, nil
 }
 
 func (t *tradeImpl) GetOrderHistory(req *GetOrderHistoryRequest) (*GetOrderHistoryResponse, error) {
-	queryParams := ConvertGetOrderHistoryRequestToParams(req)
-
-	response, err := t.client.Get("/v5/order/history", queryParams)
+	if req.Category == "" {
+		return nil, fmt.Errorf("category is required")
+	}
+	if req.Cursor != nil || req.StartTime == nil || req.EndTime == nil {
+		return t.getOrderHistoryPages(req)
+	}
+
+	var merged *GetOrderHistoryResponse
+	for _, window := range historyWindows(*req.StartTime, *req.EndTime, MaxOrderHistoryWindow) {
+		page := *req
+		page.StartTime, page.EndTime = &window[0], &window[1]
+		response, err := t.getOrderHistoryPages(&page)
+		if err != nil {
+			return response, err
+		}
+		if merged == nil {
+			merged = response
+		} else {
+			merged.Result.List = append(merged.Result.List, response.Result.List...)
+			merged.Time = response.Time
+		}
+	}
+	return merged, nil
+}
 
-	if err != nil {
-		return nil, err
-	}
-	var orderHistoryResponse GetOrderHistoryResponse
-	err = response.Unmarshal(&orderHistoryResponse)
+// getOrderHistoryPages fetches a single time window, following the cursor unless the caller
+// supplied one.
+func (t *tradeImpl) getOrderHistoryPages(req *GetOrderHistoryRequest) (*GetOrderHistoryResponse, error) {
+	followCursor := req.Cursor == nil
+	page := *req
+	var merged *GetOrderHistoryResponse
+	for {
+		res, err := t.client.Get("/v5/order/history", ConvertGetOrderHistoryRequestToParams(&page))
+		if err != nil {
+			return nil, fmt.Errorf("error fetching order history: %w", err)
+		}
+		var response GetOrderHistoryResponse
+		if err := res.Unmarshal(&response); err != nil {
+			return nil, fmt.Errorf("error parsing order history response: %w", err)
+		}
+		if response.RetCode != 0 {
+			return &response, apiError(response.RetCode, response.RetMsg)
+		}
+
+		if merged == nil {
+			merged = &response
+		} else {
+			merged.Result.List = append(merged.Result.List, response.Result.List...)
+			merged.Result.NextPageCursor = response.Result.NextPageCursor
+			merged.Time = response.Time
+		}
+		if !followCursor || response.Result.NextPageCursor == "" {
+			break
+		}
+		cursor := response.Result.NextPageCursor
+		page.Cursor = &cursor
+	}
+	return merged, nil
+}
 
-	if err != nil {
-		return nil, err
-	}
-	if orderHistoryResponse.RetCode != 0 {
-		return &orderHistoryResponse, fmt.Errorf("API returned error: %s", orderHistoryResponse.RetMsg)
-	}
-	return &orderHistoryResponse, nil
+func (t *tradeImpl) GetExecutions(req *GetExecutionsRequest) (*GetExecutionsResponse, error) {
+	if req.Category == "" {
+		return nil, fmt.Errorf("category is required")
+	}
+	if req.Limit != nil && (*req.Limit < 1 || *req.Limit > MaxExecutionsLimit) {
+		return nil, fmt.Errorf("limit must be between 1 and %d", MaxExecutionsLimit)
+	}
+
+	followCursor := req.Cursor == nil
+	page := *req
+	var merged *GetExecutionsResponse
+	for {
+		res, err := t.client.Get("/v5/execution/list", ConvertGetTradeHistoryRequestToParams(&page))
+		if err != nil {
+			return nil, fmt.Errorf("error fetching executions: %w", err)
+		}
+		var response GetExecutionsResponse
+		if err := res.Unmarshal(&response); err != nil {
+			return nil, fmt.Errorf("error parsing executions response: %w", err)
+		}
+		if response.RetCode != 0 {
+			return &response, apiError(response.RetCode, response.RetMsg)
+		}
+
+		if merged == nil {
+			merged = &response
+		} else {
+			merged.Result.List = append(merged.Result.List, response.Result.List...)
+			merged.Result.NextPageCursor = response.Result.NextPageCursor
+			merged.Time = response.Time
+		}
+		if !followCursor || response.Result.NextPageCursor == "" {
+			break
+		}
+		cursor := response.Result.NextPageCursor
+		page.Cursor = &cursor
+	}
+	return merged, nil
 }
 
 func (t *tradeImpl) GetTradeHistory(req *GetTradeHistoryRequest) (*GetTradeHistoryResponse, error) {
-	queryParams := ConvertGetTradeHistoryRequestToParams(req)
+	return t.GetExecutions(req)
+}
 
-	// Assuming the client.Get method constructs the query string from the provided params and sends a GET request.
-	resBytes, err := t.client.Get("/v5/execution/list", queryParams)
-	if err != nil {
+func (t *tradeImpl) BatchPlaceOrders(req *BatchPlaceOrderRequest) (*BatchPlaceOrderResponse, error) {
+	if err := validateBatch(req.Category, len(req.Request)); err != nil {
 		return nil, err
 	}
-	var response GetTradeHistoryResponse
-	err = resBytes.Unmarshal(&response)
+	req, err := t.reduceOnlyBatch(req)
 	if err != nil {
 		return nil, err
 	}
-
-	if response.RetCode != 0 {
-		return &response, fmt.Errorf("API returned error: %s", response.RetMsg)
-	}
-
-	return &response, nil
-}
-func (t *tradeImpl) BatchPlaceOrder(req *BatchPlaceOrderRequest) (*BatchPlaceOrderResponse, error) {
 	params := ConvertBatchPlaceOrderRequestToParams(req)
-	resBytes, err := t.client.Post("/v5/order/create-batch", params)
-	if err != nil {
-		return nil, err
-	}
-	data, err := json.Marshal(resBytes)
+	res, err := t.post("/v5/order/create-batch", params)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("error placing batch orders: %w", err)
 	}
 	var response BatchPlaceOrderResponse
-	err = json.Unmarshal(data, &response)
-	if err != nil {
-		return nil, err
+	if err := res.Unmarshal(&response); err != nil {
+		return nil, fmt.Errorf("error parsing batch place orders response: %w", err)
 	}
 
 	if response.RetCode != 0 {
-		return &response, fmt.Errorf("API returned error: %s", response.RetMsg)
+		return &response, apiError(response.RetCode, response.RetMsg)
 	}
 
 	return &response, nil
 }
 
-func (t *tradeImpl) BatchAmendOrder(req *BatchAmendOrderRequest) (*BatchAmendOrderResponse, error) {
-	params := ConvertBatchAmendOrderRequestToParams(req)
+func (t *tradeImpl) BatchPlaceOrder(req *BatchPlaceOrderRequest) (*BatchPlaceOrderResponse, error) {
+	return t.BatchPlaceOrders(req)
+}
 
-	resBytes, err := t.client.Post("/v5/order/amend-batch", params)
-	if err != nil {
+func (t *tradeImpl) BatchAmendOrders(req *BatchAmendOrderRequest) (*BatchAmendOrderResponse, error) {
+	if err := validateBatch(req.Category, len(req.Request)); err != nil {
 		return nil, err
 	}
-	data, err := json.Marshal(resBytes)
+	for i, item := range req.Request {
+		item.Category = req.Category
+		if err := item.Validate(); err != nil {
+			return nil, fmt.Errorf("request[%d]: %w", i, err)
+		}
+	}
+	params := ConvertBatchAmendOrderRequestToParams(req)
+
+	res, err := t.post("/v5/order/amend-batch", params)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("error amending batch orders: %w", err)
 	}
 	var response BatchAmendOrderResponse
-	err = json.Unmarshal(data, &response)
-	if err != nil {
-		return nil, err
+	if err := res.Unmarshal(&response); err != nil {
+		return nil, fmt.Errorf("error parsing batch amend orders response: %w", err)
 	}
 
 	if response.RetCode != 0 {
-		return &response, fmt.Errorf("API returned error: %s", response.RetMsg)
+		return &response, apiError(response.RetCode, response.RetMsg)
 	}
 
 	return &response, nil
 }
-func (t *tradeImpl) BatchCancelOrder(req *BatchCancelOrderRequest) (*BatchCancelOrderResponse, error) {
-	params := ConvertBatchCancelOrderRequestToParams(req)
 
-	resBytes, err := t.client.Post("/v5/order/cancel-batch", params)
-	if err != nil {
+// Deprecated: use BatchAmendOrders.
+func (t *tradeImpl) BatchAmendOrder(req *BatchAmendOrderRequest) (*BatchAmendOrderResponse, error) {
+	return t.BatchAmendOrders(req)
+}
+
+func (t *tradeImpl) BatchCancelOrders(req *BatchCancelOrderRequest) (*BatchCancelOrderResponse, error) {
+	if err := validateBatch(req.Category, len(req.Request)); err != nil {
 		return nil, err
 	}
-	data, err := json.Marshal(resBytes)
+	for i, item := range req.Request {
+		item.Category = req.Category
+		if err := item.Validate(); err != nil {
+			return nil, fmt.Errorf("request[%d]: %w", i, err)
+		}
+	}
+	params := ConvertBatchCancelOrderRequestToParams(req)
+
+	res, err := t.post("/v5/order/cancel-batch", params)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("error cancelling batch orders: %w", err)
 	}
 	var response BatchCancelOrderResponse
-	err = json.Unmarshal(data, &response)
-	if err != nil {
-		return nil, err
+	if err := res.Unmarshal(&response); err != nil {
+		return nil, fmt.Errorf("error parsing batch cancel orders response: %w", err)
 	}
 
 	if response.RetCode != 0 {
-		return &response, fmt.Errorf("API returned error: %s", response.RetMsg)
+		return &response, apiError(response.RetCode, response.RetMsg)
 	}
 
 	return &response, nil
 }
-func (t *tradeImpl) GetBorrowQuotaSpot(symbol, side string) (*BorrowQuotaResponse, error) {
+
+// Deprecated: use BatchCancelOrders.
+func (t *tradeImpl) BatchCancelOrder(req *BatchCancelOrderRequest) (*BatchCancelOrderResponse, error) {
+	return t.BatchCancelOrders(req)
+}
+
+func (t *tradeImpl) GetBorrowQuota(symbol, side string) (*BorrowQuotaResponse, error) {
+	if symbol == "" {
+		return nil, fmt.Errorf("symbol is required")
+	}
+	if side != "Buy" && side != "Sell" {
+		return nil, fmt.Errorf("invalid side %q: must be Buy or Sell", side)
+	}
 	params := client.Params{
-		"category": "spot",
+		"category": market.CategorySpot,
 		"symbol":   symbol,
 		"side":     side,
 	}
-	resBytes, err := t.client.Get("/v5/order/spot-borrow-check", params)
+	res, err := t.client.Get("/v5/order/spot-borrow-check", params)
 	if err != nil {
-		return nil, err
-	}
-	data, err := json.Marshal(resBytes)
-	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("error fetching borrow quota: %w", err)
 	}
 
-	// Parse the JSON response
 	var response BorrowQuotaResponse
-	if err := json.Unmarshal(data, &response); err != nil {
-		return nil, err
+	if err := res.Unmarshal(&response); err != nil {
+		return nil, fmt.Errorf("error parsing borrow quota response: %w", err)
 	}
 
 	// Check for API error
@@ -363,28 +645,34 @@ func (t *tradeImpl) GetBorrowQuotaSpot(symbol, side string) (*BorrowQuotaRespons
 
 	return &response, nil
 }
+
+func (t *tradeImpl) GetBorrowQuotaSpot(symbol, side string) (*BorrowQuotaResponse, error) {
+	return t.GetBorrowQuota(symbol, side)
+}
+
 func (t *tradeImpl) SetDisconnectCancelAll(req *SetDisconnectCancelAllRequest) (*APIResponse, error) {
+	if req.TimeWindow < MinDCPTimeWindow || req.TimeWindow > MaxDCPTimeWindow {
+		return nil, fmt.Errorf("timeWindow must be between %d and %d seconds", MinDCPTimeWindow, MaxDCPTimeWindow)
+	}
 	dcpRequest := NewDCPParams(req.TimeWindow)
+	if req.Product != nil {
+		dcpRequest["product"] = *req.Product
+	}
 
 	// Send POST request to the Bybit API
-	responseBody, err := t.client.Post("/v5/order/disconnected-cancel-all", dcpRequest)
+	res, err := t.post("/v5/order/disconnected-cancel-all", dcpRequest)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request to API: %w", err)
 	}
-	data, err := json.Marshal(responseBody)
-	if err != nil {
-		return nil, err
-	}
 	// Parse the JSON response
 	var response APIResponse
-	err = json.Unmarshal(data, &response)
-	if err != nil {
+	if err := res.Unmarshal(&response); err != nil {
 		return nil, fmt.Errorf("error unmarshalling response: %w", err)
 	}
 
 	// Check for API error
 	if response.RetCode != 0 {
-		return &response, fmt.Errorf("API returned error: %s", response.RetMsg)
+		return &response, apiError(response.RetCode, response.RetMsg)
 	}
 
 	return &response, nil
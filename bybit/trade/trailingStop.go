@@ -0,0 +1,200 @@
+package trade
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/market"
+	"github.com/cploutarchou/crypto-sdk-suite/decimal"
+)
+
+// TrailingStopState is everything a TrailingStop needs to resume after a restart.
+type TrailingStopState struct {
+	Category    market.Category `json:"category"`
+	Symbol      string          `json:"symbol"`
+	OrderLinkID string          `json:"orderLinkId"` // the resting stop order being trailed
+	// Side is the side of the stop order: Sell protects a long, Buy protects a short.
+	Side Side `json:"side"`
+	// Offset is the trailing distance, in price units or, when Percent is set, as a fraction
+	// of the best price, e.g. 0.01 for 1%.
+	Offset  decimal.Decimal `json:"offset"`
+	Percent bool            `json:"percent"`
+	// Tick rounds new trigger prices away from the market. Zero disables rounding.
+	Tick decimal.Decimal `json:"tick"`
+	// Extreme is the best price seen so far and TriggerPrice the current stop level.
+	Extreme      decimal.Decimal `json:"extreme"`
+	TriggerPrice decimal.Decimal `json:"triggerPrice"`
+}
+
+// TrailingStopStore persists trailing stop state between runs.
+type TrailingStopStore interface {
+	Save(state TrailingStopState) error
+	// Load returns os.ErrNotExist when no state was saved for orderLinkID.
+	Load(orderLinkID string) (*TrailingStopState, error)
+	Delete(orderLinkID string) error
+}
+
+// FileTrailingStopStore keeps one JSON file per stop order in Dir.
+type FileTrailingStopStore struct {
+	Dir string
+}
+
+func (f FileTrailingStopStore) path(orderLinkID string) string {
+	return filepath.Join(f.Dir, "trailing-"+filepath.Base(orderLinkID)+".json")
+}
+
+// Save writes the state atomically by renaming a temporary file over the previous one.
+func (f FileTrailingStopStore) Save(state TrailingStopState) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	tmp := f.path(state.OrderLinkID) + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, f.path(state.OrderLinkID))
+}
+
+func (f FileTrailingStopStore) Load(orderLinkID string) (*TrailingStopState, error) {
+	data, err := os.ReadFile(f.path(orderLinkID))
+	if err != nil {
+		return nil, err
+	}
+	var state TrailingStopState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("error parsing trailing stop state: %w", err)
+	}
+	return &state, nil
+}
+
+func (f FileTrailingStopStore) Delete(orderLinkID string) error {
+	err := os.Remove(f.path(orderLinkID))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// TrailingStop moves the trigger price of a resting stop order behind the market. Feed it mark
+// or last prices from a ticker stream with Update; the stop is only ever moved in the
+// protective direction and every move is persisted before Update returns.
+type TrailingStop struct {
+	trade Trade
+	store TrailingStopStore
+
+	mu    sync.Mutex
+	state TrailingStopState
+}
+
+// NewTrailingStop starts trailing the stop order described by state and saves the state. When
+// Extreme or TriggerPrice are zero they are initialised from the first price update.
+func NewTrailingStop(t Trade, store TrailingStopStore, state TrailingStopState) (*TrailingStop, error) {
+	if state.OrderLinkID == "" || state.Symbol == "" || state.Category == "" {
+		return nil, errors.New("category, symbol and orderLinkId are required")
+	}
+	if !state.Side.Valid() {
+		return nil, fmt.Errorf("invalid side %q", state.Side)
+	}
+	if state.Offset.Sign() <= 0 {
+		return nil, errors.New("offset must be positive")
+	}
+	if store != nil {
+		if err := store.Save(state); err != nil {
+			return nil, fmt.Errorf("error saving trailing stop state: %w", err)
+		}
+	}
+	return &TrailingStop{trade: t, store: store, state: state}, nil
+}
+
+// ResumeTrailingStop restores a trailing stop saved by a previous run.
+func ResumeTrailingStop(t Trade, store TrailingStopStore, orderLinkID string) (*TrailingStop, error) {
+	state, err := store.Load(orderLinkID)
+	if err != nil {
+		return nil, fmt.Errorf("error loading trailing stop %s: %w", orderLinkID, err)
+	}
+	return &TrailingStop{trade: t, store: store, state: *state}, nil
+}
+
+// State returns a copy of the current state.
+func (s *TrailingStop) State() TrailingStopState {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.state
+}
+
+// Update records a new price and amends the stop order when the trailing level improves.
+func (s *TrailingStop) Update(price decimal.Decimal) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st := &s.state
+	long := st.Side == SideSell
+	if !st.Extreme.IsZero() && (long && !price.GreaterThan(st.Extreme) || !long && !price.LessThan(st.Extreme)) {
+		return nil
+	}
+	trigger, err := s.level(price)
+	if err != nil {
+		return err
+	}
+	if !st.TriggerPrice.IsZero() && (long && !trigger.GreaterThan(st.TriggerPrice) || !long && !trigger.LessThan(st.TriggerPrice)) {
+		st.Extreme = price
+		return s.save()
+	}
+
+	triggerPrice := trigger.String()
+	_, err = s.trade.AmendOrder(&AmendOrderRequest{
+		Category:     st.Category,
+		Symbol:       st.Symbol,
+		OrderLinkID:  &st.OrderLinkID,
+		TriggerPrice: &triggerPrice,
+	})
+	if err != nil {
+		return fmt.Errorf("error moving trailing stop to %s: %w", triggerPrice, err)
+	}
+	st.Extreme, st.TriggerPrice = price, trigger
+	return s.save()
+}
+
+// Stop forgets the persisted state, e.g. after the stop order filled or was cancelled.
+func (s *TrailingStop) Stop() error {
+	if s.store == nil {
+		return nil
+	}
+	return s.store.Delete(s.state.OrderLinkID)
+}
+
+// level returns the stop level for price, rounded away from the market to the tick.
+func (s *TrailingStop) level(price decimal.Decimal) (decimal.Decimal, error) {
+	st := &s.state
+	offset := st.Offset
+	if st.Percent {
+		offset = price.Mul(st.Offset)
+	}
+	if st.Side == SideSell {
+		trigger := price.Sub(offset)
+		if st.Tick.Sign() > 0 {
+			return trigger.FloorToStep(st.Tick)
+		}
+		return trigger, nil
+	}
+	trigger := price.Add(offset)
+	if st.Tick.Sign() > 0 {
+		return trigger.CeilToStep(st.Tick)
+	}
+	return trigger, nil
+}
+
+func (s *TrailingStop) save() error {
+	if s.store == nil {
+		return nil
+	}
+	if err := s.store.Save(s.state); err != nil {
+		return fmt.Errorf("error saving trailing stop state: %w", err)
+	}
+	return nil
+}
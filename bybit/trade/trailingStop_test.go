@@ -0,0 +1,65 @@
+package trade
+
+import (
+	"testing"
+
+	"github.com/cploutarchou/crypto-sdk-suite/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type amendRecorder struct {
+	Trade
+	triggers []string
+}
+
+func (a *amendRecorder) AmendOrder(req *AmendOrderRequest) (*AmendOrderResponse, error) {
+	a.triggers = append(a.triggers, *req.TriggerPrice)
+	return &AmendOrderResponse{}, nil
+}
+
+func TestTrailingStopLong(t *testing.T) {
+	fake := &amendRecorder{}
+	store := FileTrailingStopStore{Dir: t.TempDir()}
+	stop, err := NewTrailingStop(fake, store, TrailingStopState{
+		Category:    "linear",
+		Symbol:      "BTCUSDT",
+		OrderLinkID: "stop-1",
+		Side:        SideSell,
+		Offset:      decimal.RequireFromString("0.01"),
+		Percent:     true,
+		Tick:        decimal.RequireFromString("0.5"),
+	})
+	require.NoError(t, err)
+
+	for _, price := range []string{"30000", "29900", "30100", "30050"} {
+		require.NoError(t, stop.Update(decimal.RequireFromString(price)))
+	}
+	assert.Equal(t, []string{"29700", "29799"}, fake.triggers)
+
+	resumed, err := ResumeTrailingStop(fake, store, "stop-1")
+	require.NoError(t, err)
+	assert.Equal(t, "30100", resumed.State().Extreme.String())
+	assert.Equal(t, "29799", resumed.State().TriggerPrice.String())
+
+	require.NoError(t, resumed.Stop())
+	_, err = ResumeTrailingStop(fake, store, "stop-1")
+	assert.Error(t, err)
+}
+
+func TestTrailingStopShort(t *testing.T) {
+	fake := &amendRecorder{}
+	stop, err := NewTrailingStop(fake, nil, TrailingStopState{
+		Category:    "linear",
+		Symbol:      "BTCUSDT",
+		OrderLinkID: "stop-2",
+		Side:        SideBuy,
+		Offset:      decimal.NewFromInt(100),
+	})
+	require.NoError(t, err)
+
+	for _, price := range []string{"30000", "30050", "29900"} {
+		require.NoError(t, stop.Update(decimal.RequireFromString(price)))
+	}
+	assert.Equal(t, []string{"30100", "30000"}, fake.triggers)
+}
@@ -0,0 +1,133 @@
+package trade
+
+import (
+	"fmt"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/instrument"
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/market"
+	"github.com/cploutarchou/crypto-sdk-suite/decimal"
+)
+
+// Validate checks the order quantity and price against the instrument's lot size and price
+// filters, returning an *instrument.ValidationError before the request spends API quota.
+// Spot market orders are sized according to MarketUnit, defaulting to the quote coin for buys
+// as Bybit does.
+func (r *PlaceOrderRequest) Validate(info *market.InstrumentInfo) error {
+	if info.Symbol != r.Symbol {
+		return fmt.Errorf("instrument %s does not match order symbol %s", info.Symbol, r.Symbol)
+	}
+	qty, err := decimal.NewFromString(r.Qty)
+	if err != nil {
+		return &instrument.ValidationError{Symbol: r.Symbol, Field: "qty", Reason: err.Error()}
+	}
+	order := instrument.Order{
+		Qty:      qty,
+		IsMarket: r.OrderType == OrderTypeMarket,
+	}
+	if order.IsMarket && r.Category == market.CategorySpot {
+		if r.MarketUnit != nil {
+			order.QuoteQty = *r.MarketUnit == MarketUnitQuoteCoin
+		} else {
+			order.QuoteQty = r.Side == SideBuy
+		}
+	}
+	if !order.IsMarket {
+		if order.Price, err = decimal.NewFromString(r.Price); err != nil {
+			return &instrument.ValidationError{Symbol: r.Symbol, Field: "price", Reason: err.Error()}
+		}
+	}
+	return instrument.ValidateOrder(info, order)
+}
+
+// ValidateFilters checks the new quantity and price of an amendment against the instrument's
+// lot size and price filters. Fields that are not being changed are not checked.
+func (r *AmendOrderRequest) ValidateFilters(info *market.InstrumentInfo) error {
+	if info.Symbol != r.Symbol {
+		return fmt.Errorf("instrument %s does not match order symbol %s", info.Symbol, r.Symbol)
+	}
+	if r.Qty != nil {
+		qty, err := decimal.NewFromString(*r.Qty)
+		if err != nil {
+			return &instrument.ValidationError{Symbol: r.Symbol, Field: "qty", Reason: err.Error()}
+		}
+		if err := instrument.ValidateQty(info, qty); err != nil {
+			return err
+		}
+	}
+	if r.Price != nil {
+		price, err := decimal.NewFromString(*r.Price)
+		if err != nil {
+			return &instrument.ValidationError{Symbol: r.Symbol, Field: "price", Reason: err.Error()}
+		}
+		if err := instrument.ValidatePrice(info, price); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks that the amend request identifies an order and changes at least one field.
+func (r *AmendOrderRequest) Validate() error {
+	if r.Category == "" || r.Symbol == "" {
+		return fmt.Errorf("category and symbol are required")
+	}
+	if (r.OrderID == nil || *r.OrderID == "") && (r.OrderLinkID == nil || *r.OrderLinkID == "") {
+		return fmt.Errorf("either orderId or orderLinkId is required")
+	}
+	for _, field := range []*string{
+		r.OrderIv, r.TriggerPrice, r.Qty, r.Price, r.TakeProfit, r.StopLoss, r.TpLimitPrice, r.SlLimitPrice,
+	} {
+		if field != nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("nothing to amend for order on %s", r.Symbol)
+}
+
+// Validate checks that the cancel request identifies an order.
+func (r *CancelOrderRequest) Validate() error {
+	if r.Category == "" || r.Symbol == "" {
+		return fmt.Errorf("category and symbol are required")
+	}
+	if (r.OrderID == nil || *r.OrderID == "") && (r.OrderLinkID == nil || *r.OrderLinkID == "") {
+		return fmt.Errorf("either orderId or orderLinkId is required")
+	}
+	return nil
+}
+
+// Validate checks that linear and inverse cancel-all requests are scoped, as Bybit rejects them
+// otherwise.
+func (r *CancelAllOrdersRequest) Validate() error {
+	if r.Category == "" {
+		return fmt.Errorf("category is required")
+	}
+	if r.Category != market.CategoryLinear && r.Category != market.CategoryInverse {
+		return nil
+	}
+	for _, filter := range []*string{r.Symbol, r.BaseCoin, r.SettleCoin} {
+		if filter != nil && *filter != "" {
+			return nil
+		}
+	}
+	return fmt.Errorf("one of symbol, baseCoin or settleCoin is required for %s", r.Category)
+}
+
+// Validate checks the open orders query. Linear and inverse queries must be scoped by symbol,
+// base coin or settle coin.
+func (r *GetOpenOrdersRequest) Validate() error {
+	if r.Category == "" {
+		return fmt.Errorf("category is required")
+	}
+	if r.Category == market.CategoryLinear || r.Category == market.CategoryInverse {
+		if (r.Symbol == nil || *r.Symbol == "") && (r.BaseCoin == nil || *r.BaseCoin == "") && (r.SettleCoin == nil || *r.SettleCoin == "") {
+			return fmt.Errorf("one of symbol, baseCoin or settleCoin is required for %s", r.Category)
+		}
+	}
+	if r.OpenOnly != nil && (*r.OpenOnly < 0 || *r.OpenOnly > 2) {
+		return fmt.Errorf("invalid openOnly %d: must be 0, 1 or 2", *r.OpenOnly)
+	}
+	if r.Limit != nil && (*r.Limit < 1 || *r.Limit > MaxOpenOrdersLimit) {
+		return fmt.Errorf("limit must be between 1 and %d", MaxOpenOrdersLimit)
+	}
+	return nil
+}
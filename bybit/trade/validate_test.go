@@ -0,0 +1,68 @@
+package trade
+
+import (
+	"testing"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/instrument"
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/market"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAmendOrderRequestValidate(t *testing.T) {
+	orderID := "1321003749386327552"
+	price := "30000"
+
+	req := &AmendOrderRequest{Category: "linear", Symbol: "BTCUSDT", Price: &price}
+	assert.Error(t, req.Validate(), "missing order id")
+
+	req.OrderID = &orderID
+	assert.NoError(t, req.Validate())
+
+	req.Price = nil
+	assert.Error(t, req.Validate(), "nothing to amend")
+
+	req.Symbol = ""
+	assert.Error(t, req.Validate())
+}
+
+func TestCancelAllOrdersRequestValidate(t *testing.T) {
+	settleCoin := "USDT"
+
+	assert.NoError(t, (&CancelAllOrdersRequest{Category: "spot"}).Validate())
+	assert.Error(t, (&CancelAllOrdersRequest{Category: "linear"}).Validate())
+	assert.NoError(t, (&CancelAllOrdersRequest{Category: "linear", SettleCoin: &settleCoin}).Validate())
+}
+
+func TestGetOpenOrdersRequestValidate(t *testing.T) {
+	symbol := "BTCUSDT"
+	openOnly, limit := 3, 100
+
+	assert.NoError(t, (&GetOpenOrdersRequest{Category: "spot"}).Validate())
+	assert.Error(t, (&GetOpenOrdersRequest{Category: "linear"}).Validate())
+	assert.NoError(t, (&GetOpenOrdersRequest{Category: "linear", Symbol: &symbol}).Validate())
+	assert.Error(t, (&GetOpenOrdersRequest{Category: "spot", OpenOnly: &openOnly}).Validate())
+	assert.Error(t, (&GetOpenOrdersRequest{Category: "spot", Limit: &limit}).Validate())
+}
+
+func TestAmendOrderRequestValidateFilters(t *testing.T) {
+	info := &market.InstrumentInfo{
+		Symbol:        "BTCUSDT",
+		PriceFilter:   market.PriceFilter{TickSize: "0.10", MinPrice: "0.10"},
+		LotSizeFilter: market.LotSizeFilter{QtyStep: "0.001", MinOrderQty: "0.001", MaxOrderQty: "100"},
+	}
+	orderID, qty, price := "1", "0.0015", "30000.05"
+
+	req := &AmendOrderRequest{Category: "linear", Symbol: "BTCUSDT", OrderID: &orderID, Qty: &qty}
+	var validationErr *instrument.ValidationError
+	require.ErrorAs(t, req.ValidateFilters(info), &validationErr)
+	assert.Equal(t, "qty", validationErr.Field)
+
+	qty = "0.002"
+	req.Price = &price
+	require.ErrorAs(t, req.ValidateFilters(info), &validationErr)
+	assert.Equal(t, "price", validationErr.Field)
+
+	price = "30000.1"
+	assert.NoError(t, req.ValidateFilters(info))
+}
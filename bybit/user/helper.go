@@ -0,0 +1,88 @@
+package user
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+)
+
+// validateUsername checks the sub account username rules: 6-16 characters, letters and digits
+// only, containing at least one of each.
+func validateUsername(username string) error {
+	if len(username) < 6 || len(username) > 16 {
+		return fmt.Errorf("username must be 6-16 characters long")
+	}
+	var letters, digits bool
+	for _, r := range username {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+			letters = true
+		case r >= '0' && r <= '9':
+			digits = true
+		default:
+			return fmt.Errorf("username may only contain letters and digits")
+		}
+	}
+	if !letters || !digits {
+		return fmt.Errorf("username must contain both letters and digits")
+	}
+	return nil
+}
+
+func ConvertCreateSubMemberRequestToParams(req *CreateSubMemberRequest) client.Params {
+	params := make(client.Params)
+	params["username"] = req.Username
+	params["memberType"] = req.MemberType
+	if req.Password != nil {
+		params["password"] = *req.Password
+	}
+	if req.Switch != nil {
+		params["switch"] = *req.Switch
+	}
+	if req.IsUta != nil {
+		params["isUta"] = *req.IsUta
+	}
+	if req.Note != nil {
+		params["note"] = *req.Note
+	}
+	return params
+}
+
+func ConvertCreateSubAPIKeyRequestToParams(req *CreateSubAPIKeyRequest) client.Params {
+	params := make(client.Params)
+	params["subuid"] = req.SubUID
+	params["readOnly"] = boolToInt(req.ReadOnly)
+	params["permissions"] = req.Permissions
+	if req.Note != nil {
+		params["note"] = *req.Note
+	}
+	if len(req.IPs) > 0 {
+		params["ips"] = strings.Join(req.IPs, ",")
+	}
+	return params
+}
+
+func ConvertModifyAPIKeyRequestToParams(req *ModifyAPIKeyRequest) client.Params {
+	params := make(client.Params)
+	if req.APIKey != nil {
+		params["apikey"] = *req.APIKey
+	}
+	if req.ReadOnly != nil {
+		params["readOnly"] = boolToInt(*req.ReadOnly)
+	}
+	if len(req.IPs) > 0 {
+		params["ips"] = strings.Join(req.IPs, ",")
+	}
+	if req.Permissions != nil {
+		params["permissions"] = *req.Permissions
+	}
+	return params
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,15 @@
+package user
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateUsername(t *testing.T) {
+	assert.NoError(t, validateUsername("desk01"))
+	assert.Error(t, validateUsername("ab12"))
+	assert.Error(t, validateUsername("onlyletters"))
+	assert.Error(t, validateUsername("12345678"))
+	assert.Error(t, validateUsername("desk_01"))
+}
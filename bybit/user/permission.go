@@ -0,0 +1,99 @@
+package user
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Permission is a single API key permission within a product group of Permissions.
+type Permission struct {
+	Group string
+	Name  string
+}
+
+func (p Permission) String() string {
+	return p.Group + "." + p.Name
+}
+
+// Permissions commonly required by trading and treasury tools.
+var (
+	PermissionContractOrder     = Permission{Group: "ContractTrade", Name: "Order"}
+	PermissionContractPosition  = Permission{Group: "ContractTrade", Name: "Position"}
+	PermissionSpotTrade         = Permission{Group: "Spot", Name: "SpotTrade"}
+	PermissionTransfer          = Permission{Group: "Wallet", Name: "AccountTransfer"}
+	PermissionSubMemberTransfer = Permission{Group: "Wallet", Name: "SubMemberTransfer"}
+	PermissionWithdraw          = Permission{Group: "Wallet", Name: "Withdraw"}
+	PermissionOptionsTrade      = Permission{Group: "Options", Name: "OptionsTrade"}
+	PermissionDerivativesTrade  = Permission{Group: "Derivatives", Name: "DerivativesTrade"}
+	PermissionCopyTrading       = Permission{Group: "CopyTrading", Name: "CopyTrading"}
+	PermissionEarn              = Permission{Group: "Earn", Name: "Earn"}
+)
+
+// group returns the permissions granted in the named product group.
+func (p Permissions) group(name string) []string {
+	switch name {
+	case "ContractTrade":
+		return p.ContractTrade
+	case "Spot":
+		return p.Spot
+	case "Wallet":
+		return p.Wallet
+	case "Options":
+		return p.Options
+	case "Derivatives":
+		return p.Derivatives
+	case "CopyTrading":
+		return p.CopyTrading
+	case "BlockTrade":
+		return p.BlockTrade
+	case "Exchange":
+		return p.Exchange
+	case "NFT":
+		return p.NFT
+	case "Affiliate":
+		return p.Affiliate
+	case "Earn":
+		return p.Earn
+	}
+	return nil
+}
+
+// Has reports whether the permission is granted.
+func (p Permissions) Has(perm Permission) bool {
+	for _, name := range p.group(perm.Group) {
+		if name == perm.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// missingPermissions returns the required permissions that the key does not grant. A read-only
+// key grants none of them, since every listed permission needs write access.
+func missingPermissions(granted Permissions, readOnly bool, required []Permission) []Permission {
+	var missing []Permission
+	for _, perm := range required {
+		if readOnly || !granted.Has(perm) {
+			missing = append(missing, perm)
+		}
+	}
+	return missing
+}
+
+// MissingPermissionsError is returned by VerifyPermissions when the API key lacks required permissions.
+type MissingPermissionsError struct {
+	Missing  []Permission
+	ReadOnly bool
+}
+
+func (e *MissingPermissionsError) Error() string {
+	names := make([]string, len(e.Missing))
+	for i, perm := range e.Missing {
+		names[i] = perm.String()
+	}
+	msg := fmt.Sprintf("API key is missing required permissions: %s", strings.Join(names, ", "))
+	if e.ReadOnly {
+		msg += " (key is read-only)"
+	}
+	return msg
+}
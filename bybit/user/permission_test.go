@@ -0,0 +1,25 @@
+package user
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMissingPermissions(t *testing.T) {
+	granted := Permissions{
+		ContractTrade: []string{"Order", "Position"},
+		Wallet:        []string{"AccountTransfer"},
+	}
+
+	assert.Empty(t, missingPermissions(granted, false, []Permission{PermissionContractOrder, PermissionTransfer}))
+	assert.Equal(t, []Permission{PermissionWithdraw, PermissionSpotTrade},
+		missingPermissions(granted, false, []Permission{PermissionContractOrder, PermissionWithdraw, PermissionSpotTrade}))
+	assert.Equal(t, []Permission{PermissionContractOrder},
+		missingPermissions(granted, true, []Permission{PermissionContractOrder}))
+}
+
+func TestMissingPermissionsError(t *testing.T) {
+	err := &MissingPermissionsError{Missing: []Permission{PermissionTransfer, PermissionWithdraw}}
+	assert.Equal(t, "API key is missing required permissions: Wallet.AccountTransfer, Wallet.Withdraw", err.Error())
+}
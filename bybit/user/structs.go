@@ -0,0 +1,164 @@
+package user
+
+// MaxAPIKeyIPs is the largest IP whitelist Bybit accepts for an API key.
+const MaxAPIKeyIPs = 1000
+
+// Member types of a sub account.
+const (
+	MemberTypeNormal    = 1
+	MemberTypeCustodial = 6
+)
+
+// CreateSubMemberRequest represents the payload for creating a sub account.
+type CreateSubMemberRequest struct {
+	Username   string  `json:"username"`           // Required: 6-16 characters, letters and digits
+	Password   *string `json:"password,omitempty"` // Optional: 8-30 characters with digits, upper and lower case letters
+	MemberType int     `json:"memberType"`         // Required: MemberTypeNormal or MemberTypeCustodial
+	Switch     *int    `json:"switch,omitempty"`   // Optional: 0 quick login off (default), 1 quick login on
+	IsUta      *bool   `json:"isUta,omitempty"`    // Optional: Create the sub account as a unified trading account
+	Note       *string `json:"note,omitempty"`     // Optional: Remark
+}
+
+// CreateSubMemberResponse represents the response from creating a sub account.
+type CreateSubMemberResponse struct {
+	RetCode int    `json:"retCode"`
+	RetMsg  string `json:"retMsg"`
+	Result  struct {
+		UID        string `json:"uid"`
+		Username   string `json:"username"`
+		MemberType int    `json:"memberType"`
+		Status     int    `json:"status"` // 1 normal, 2 login banned, 4 frozen
+		Remark     string `json:"remark"`
+	} `json:"result"`
+	RetExtInfo any   `json:"retExtInfo"`
+	Time       int64 `json:"time"`
+}
+
+// Permissions is the permission map of an API key, keyed by product group. Empty groups are omitted.
+type Permissions struct {
+	ContractTrade []string `json:"ContractTrade,omitempty"` // "Order", "Position"
+	Spot          []string `json:"Spot,omitempty"`          // "SpotTrade"
+	Wallet        []string `json:"Wallet,omitempty"`        // "AccountTransfer", "SubMemberTransferList", "Withdraw"
+	Options       []string `json:"Options,omitempty"`       // "OptionsTrade"
+	Derivatives   []string `json:"Derivatives,omitempty"`   // "DerivativesTrade"
+	CopyTrading   []string `json:"CopyTrading,omitempty"`   // "CopyTrading"
+	BlockTrade    []string `json:"BlockTrade,omitempty"`    // "BlockTrade"
+	Exchange      []string `json:"Exchange,omitempty"`      // "ExchangeHistory"
+	NFT           []string `json:"NFT,omitempty"`           // "NFTQueryProductList"
+	Affiliate     []string `json:"Affiliate,omitempty"`     // "Affiliate"
+	Earn          []string `json:"Earn,omitempty"`          // "Earn"
+}
+
+// CreateSubAPIKeyRequest represents the payload for creating an API key of a sub account.
+type CreateSubAPIKeyRequest struct {
+	SubUID      int         `json:"subuid"`         // Required: Sub account UID
+	Note        *string     `json:"note,omitempty"` // Optional: Remark
+	ReadOnly    bool        `json:"readOnly"`       // Required: Create a read-only key
+	IPs         []string    `json:"ips,omitempty"`  // Optional: IP whitelist, at most 1000 addresses; no restriction when empty
+	Permissions Permissions `json:"permissions"`    // Required: Permissions of the key
+}
+
+// ModifyAPIKeyRequest represents the payload for modifying an API key. Unset fields are left unchanged.
+type ModifyAPIKeyRequest struct {
+	APIKey      *string      `json:"apikey,omitempty"`      // Optional: Sub account key to modify, ModifySubAPIKey only; defaults to the calling key
+	ReadOnly    *bool        `json:"readOnly,omitempty"`    // Optional: Make the key read-only
+	IPs         []string     `json:"ips,omitempty"`         // Optional: Replaces the IP whitelist; []string{"*"} removes the restriction
+	Permissions *Permissions `json:"permissions,omitempty"` // Optional: Replaces the permissions of the key
+}
+
+// APIKeyResponse represents the response from creating or modifying an API key.
+type APIKeyResponse struct {
+	RetCode int    `json:"retCode"`
+	RetMsg  string `json:"retMsg"`
+	Result  struct {
+		ID          string      `json:"id"`
+		Note        string      `json:"note"`
+		APIKey      string      `json:"apiKey"`
+		ReadOnly    int         `json:"readOnly"`
+		Secret      string      `json:"secret"` // Only returned when the key is created
+		Permissions Permissions `json:"permissions"`
+		IPs         []string    `json:"ips"`
+	} `json:"result"`
+	RetExtInfo any   `json:"retExtInfo"`
+	Time       int64 `json:"time"`
+}
+
+// SubMember is a sub account of the master account.
+type SubMember struct {
+	UID         string `json:"uid"`
+	Username    string `json:"username"`
+	MemberType  int    `json:"memberType"`
+	Status      int    `json:"status"`      // 1 normal, 2 login banned, 4 frozen
+	AccountMode int    `json:"accountMode"` // 1 classic, 3 UTA1.0, 4 UTA1.0 Pro, 5 UTA2.0, 6 UTA2.0 Pro
+	Remark      string `json:"remark"`
+}
+
+// SubUIDListResponse represents the response from listing sub accounts.
+type SubUIDListResponse struct {
+	RetCode int    `json:"retCode"`
+	RetMsg  string `json:"retMsg"`
+	Result  struct {
+		SubMembers []SubMember `json:"subMembers"`
+		NextCursor string      `json:"nextCursor"` // Unlimited variant only, "0" on the last page
+	} `json:"result"`
+	RetExtInfo any   `json:"retExtInfo"`
+	Time       int64 `json:"time"`
+}
+
+// GetSubUIDListUnlimitedRequest represents the query parameters for the paginated sub account list.
+type GetSubUIDListUnlimitedRequest struct {
+	PageSize   *int    `json:"pageSize,omitempty"`   // Optional: Page size, at most 100
+	NextCursor *string `json:"nextCursor,omitempty"` // Optional: Cursor of a previous page; disables automatic paging
+}
+
+// FreezeSubMemberResponse represents the response from freezing or unfreezing a sub account.
+type FreezeSubMemberResponse struct {
+	RetCode    int    `json:"retCode"`
+	RetMsg     string `json:"retMsg"`
+	Result     any    `json:"result"`
+	RetExtInfo any    `json:"retExtInfo"`
+	Time       int64  `json:"time"`
+}
+
+// DeleteAPIKeyResponse represents the response from deleting an API key.
+type DeleteAPIKeyResponse struct {
+	RetCode    int    `json:"retCode"`
+	RetMsg     string `json:"retMsg"`
+	Result     any    `json:"result"`
+	RetExtInfo any    `json:"retExtInfo"`
+	Time       int64  `json:"time"`
+}
+
+// APIKeyInfo describes the API key that signs the request.
+type APIKeyInfo struct {
+	ID            string      `json:"id"`
+	Note          string      `json:"note"`
+	APIKey        string      `json:"apiKey"`
+	ReadOnly      int         `json:"readOnly"` // 0 read and write, 1 read-only
+	Permissions   Permissions `json:"permissions"`
+	IPs           []string    `json:"ips"`
+	Type          int         `json:"type"`        // 1 personal, 2 third-party application
+	DeadlineDay   int         `json:"deadlineDay"` // Days until the key expires
+	ExpiredAt     string      `json:"expiredAt"`
+	CreatedAt     string      `json:"createdAt"`
+	Unified       int         `json:"unified"`
+	Uta           int         `json:"uta"`
+	UserID        int64       `json:"userID"`
+	InviterID     int64       `json:"inviterID"`
+	VipLevel      string      `json:"vipLevel"`
+	MktMakerLevel string      `json:"mktMakerLevel"`
+	AffiliateID   int64       `json:"affiliateID"`
+	IsMaster      bool        `json:"isMaster"`
+	ParentUID     string      `json:"parentUid"`
+	KycLevel      string      `json:"kycLevel"`
+	KycRegion     string      `json:"kycRegion"`
+}
+
+// APIKeyInfoResponse represents the response from querying the API key information.
+type APIKeyInfoResponse struct {
+	RetCode    int        `json:"retCode"`
+	RetMsg     string     `json:"retMsg"`
+	Result     APIKeyInfo `json:"result"`
+	RetExtInfo any        `json:"retExtInfo"`
+	Time       int64      `json:"time"`
+}
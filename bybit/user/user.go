@@ -0,0 +1,304 @@
+package user
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cploutarchou/crypto-sdk-suite/bybit/client"
+)
+
+// User defines the interface for managing sub accounts and API keys in the Bybit API.
+type User interface {
+	// CreateSubMember creates a new sub account under the master account.
+	// req: CreateSubMemberRequest - the username, member type and optional settings of the sub account.
+	// returns: *CreateSubMemberResponse - the UID and settings of the new sub account.
+	//          error - an error if the request fails.
+	CreateSubMember(req *CreateSubMemberRequest) (*CreateSubMemberResponse, error)
+
+	// CreateSubAPIKey creates an API key for a sub account.
+	// req: CreateSubAPIKeyRequest - the sub UID, permissions and IP whitelist of the key.
+	// returns: *APIKeyResponse - the new key including its secret, which is only returned once.
+	//          error - an error if the request fails.
+	CreateSubAPIKey(req *CreateSubAPIKeyRequest) (*APIKeyResponse, error)
+
+	// GetSubUIDList lists up to 10,000 sub accounts of the master account.
+	// returns: *SubUIDListResponse - the sub accounts.
+	//          error - an error if the request fails.
+	GetSubUIDList() (*SubUIDListResponse, error)
+
+	// GetSubUIDListUnlimited lists every sub account using the paginated endpoint.
+	// req: GetSubUIDListUnlimitedRequest - the page size and an optional cursor.
+	// returns: *SubUIDListResponse - the sub accounts of all pages, or of one page when a cursor is given.
+	//          error - an error if any request fails.
+	GetSubUIDListUnlimited(req *GetSubUIDListUnlimitedRequest) (*SubUIDListResponse, error)
+
+	// FreezeSubMember freezes or unfreezes a sub account.
+	// subUID: int - the UID of the sub account.
+	// frozen: bool - true to freeze the sub account, false to unfreeze it.
+	// returns: *FreezeSubMemberResponse - the response from the API.
+	//          error - an error if the request fails.
+	FreezeSubMember(subUID int, frozen bool) (*FreezeSubMemberResponse, error)
+
+	// ModifyMasterAPIKey modifies the master account API key used to sign the request.
+	// req: ModifyAPIKeyRequest - the read-only flag, IP whitelist and permissions to change.
+	// returns: *APIKeyResponse - the updated key.
+	//          error - an error if the request fails.
+	ModifyMasterAPIKey(req *ModifyAPIKeyRequest) (*APIKeyResponse, error)
+
+	// ModifySubAPIKey modifies a sub account API key.
+	// req: ModifyAPIKeyRequest - the key to modify and the read-only flag, IP whitelist and permissions to change.
+	// returns: *APIKeyResponse - the updated key.
+	//          error - an error if the request fails.
+	ModifySubAPIKey(req *ModifyAPIKeyRequest) (*APIKeyResponse, error)
+
+	// DeleteMasterAPIKey deletes the master account API key used to sign the request.
+	// returns: *DeleteAPIKeyResponse - the response from the API.
+	//          error - an error if the request fails.
+	DeleteMasterAPIKey() (*DeleteAPIKeyResponse, error)
+
+	// DeleteSubAPIKey deletes a sub account API key.
+	// apiKey: string - the sub account key to delete; empty deletes the sub account key used to sign the request.
+	// returns: *DeleteAPIKeyResponse - the response from the API.
+	//          error - an error if the request fails.
+	DeleteSubAPIKey(apiKey string) (*DeleteAPIKeyResponse, error)
+
+	// GetAPIKeyInfo retrieves the information of the API key used to sign the request.
+	// returns: *APIKeyInfoResponse - the permissions, IP whitelist and expiry of the key.
+	//          error - an error if the request fails.
+	GetAPIKeyInfo() (*APIKeyInfoResponse, error)
+
+	// VerifyPermissions checks that the API key used to sign the request grants every required permission.
+	// required: ...Permission - the permissions the caller depends on.
+	// returns: error - a *MissingPermissionsError if any permission is missing, or an error if the request fails.
+	VerifyPermissions(required ...Permission) error
+}
+
+type impl struct {
+	client *client.Client
+}
+
+// New creates a new instance of the User interface, which can be used to interact with the Bybit API.
+func New(c *client.Client) User {
+	return &impl{client: c}
+}
+
+// CreateSubMember creates a new sub account. The master account must be verified and the
+// username must be 6-16 characters containing both letters and digits.
+func (i *impl) CreateSubMember(req *CreateSubMemberRequest) (*CreateSubMemberResponse, error) {
+	if err := validateUsername(req.Username); err != nil {
+		return nil, err
+	}
+	if req.MemberType != MemberTypeNormal && req.MemberType != MemberTypeCustodial {
+		return nil, fmt.Errorf("invalid memberType %d", req.MemberType)
+	}
+
+	response, err := i.client.Post("/v5/user/create-sub-member", ConvertCreateSubMemberRequestToParams(req))
+	if err != nil {
+		return nil, fmt.Errorf("error creating sub member: %w", err)
+	}
+	var subMemberResponse CreateSubMemberResponse
+	if err := response.Unmarshal(&subMemberResponse); err != nil {
+		return nil, fmt.Errorf("error parsing create sub member response: %w", err)
+	}
+	if subMemberResponse.RetCode != 0 {
+		return &subMemberResponse, fmt.Errorf("API returned error: %s", subMemberResponse.RetMsg)
+	}
+	return &subMemberResponse, nil
+}
+
+// CreateSubAPIKey creates an API key for a sub account. Keep the returned secret: Bybit does not
+// return it again.
+func (i *impl) CreateSubAPIKey(req *CreateSubAPIKeyRequest) (*APIKeyResponse, error) {
+	if req.SubUID <= 0 {
+		return nil, fmt.Errorf("subuid is required")
+	}
+	if len(req.IPs) > MaxAPIKeyIPs {
+		return nil, fmt.Errorf("at most %d IP addresses can be whitelisted", MaxAPIKeyIPs)
+	}
+
+	response, err := i.client.Post("/v5/user/create-sub-api", ConvertCreateSubAPIKeyRequestToParams(req))
+	if err != nil {
+		return nil, fmt.Errorf("error creating sub API key: %w", err)
+	}
+	var keyResponse APIKeyResponse
+	if err := response.Unmarshal(&keyResponse); err != nil {
+		return nil, fmt.Errorf("error parsing create sub API key response: %w", err)
+	}
+	if keyResponse.RetCode != 0 {
+		return &keyResponse, fmt.Errorf("API returned error: %s", keyResponse.RetMsg)
+	}
+	return &keyResponse, nil
+}
+
+// GetSubUIDList lists the sub accounts of the master account. Masters with more than 10,000 sub
+// accounts must use GetSubUIDListUnlimited.
+func (i *impl) GetSubUIDList() (*SubUIDListResponse, error) {
+	return i.getSubUIDs("/v5/user/query-sub-members", client.Params{})
+}
+
+// GetSubUIDListUnlimited lists sub accounts page by page. When no cursor is supplied every page is
+// fetched and the sub accounts are merged into a single response.
+func (i *impl) GetSubUIDListUnlimited(req *GetSubUIDListUnlimitedRequest) (*SubUIDListResponse, error) {
+	params := client.Params{}
+	if req.PageSize != nil {
+		params["pageSize"] = strconv.Itoa(*req.PageSize)
+	}
+	if req.NextCursor != nil {
+		params["nextCursor"] = *req.NextCursor
+	}
+
+	var merged *SubUIDListResponse
+	for {
+		page, err := i.getSubUIDs("/v5/user/submembers", params)
+		if err != nil {
+			return page, err
+		}
+		if merged == nil {
+			merged = page
+		} else {
+			merged.Result.SubMembers = append(merged.Result.SubMembers, page.Result.SubMembers...)
+			merged.Result.NextCursor = page.Result.NextCursor
+			merged.Time = page.Time
+		}
+		cursor := page.Result.NextCursor
+		if req.NextCursor != nil || cursor == "" || cursor == "0" {
+			return merged, nil
+		}
+		params["nextCursor"] = cursor
+	}
+}
+
+func (i *impl) getSubUIDs(path string, params client.Params) (*SubUIDListResponse, error) {
+	response, err := i.client.Get(path, params)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching sub members: %w", err)
+	}
+	var listResponse SubUIDListResponse
+	if err := response.Unmarshal(&listResponse); err != nil {
+		return nil, fmt.Errorf("error parsing sub members response: %w", err)
+	}
+	if listResponse.RetCode != 0 {
+		return &listResponse, fmt.Errorf("API returned error: %s", listResponse.RetMsg)
+	}
+	return &listResponse, nil
+}
+
+// FreezeSubMember freezes or unfreezes a sub account. A frozen sub account cannot trade or
+// transfer until it is unfrozen again.
+func (i *impl) FreezeSubMember(subUID int, frozen bool) (*FreezeSubMemberResponse, error) {
+	if subUID <= 0 {
+		return nil, fmt.Errorf("subuid is required")
+	}
+
+	params := client.Params{
+		"subuid": subUID,
+		"frozen": boolToInt(frozen),
+	}
+	response, err := i.client.Post("/v5/user/frozen-sub-member", params)
+	if err != nil {
+		return nil, fmt.Errorf("error freezing sub member: %w", err)
+	}
+	var freezeResponse FreezeSubMemberResponse
+	if err := response.Unmarshal(&freezeResponse); err != nil {
+		return nil, fmt.Errorf("error parsing freeze sub member response: %w", err)
+	}
+	if freezeResponse.RetCode != 0 {
+		return &freezeResponse, fmt.Errorf("API returned error: %s", freezeResponse.RetMsg)
+	}
+	return &freezeResponse, nil
+}
+
+// ModifyMasterAPIKey modifies the master API key that signs the request. The sub account only
+// APIKey field must be left unset.
+func (i *impl) ModifyMasterAPIKey(req *ModifyAPIKeyRequest) (*APIKeyResponse, error) {
+	if req.APIKey != nil {
+		return nil, fmt.Errorf("apikey can only be set when modifying a sub account key")
+	}
+	return i.modifyAPIKey("/v5/user/update-api", req)
+}
+
+// ModifySubAPIKey modifies a sub account API key. When APIKey is unset the sub account key that
+// signs the request is modified; a master key can modify any of its sub account keys by setting it.
+func (i *impl) ModifySubAPIKey(req *ModifyAPIKeyRequest) (*APIKeyResponse, error) {
+	return i.modifyAPIKey("/v5/user/update-sub-api", req)
+}
+
+func (i *impl) modifyAPIKey(path string, req *ModifyAPIKeyRequest) (*APIKeyResponse, error) {
+	if len(req.IPs) > MaxAPIKeyIPs {
+		return nil, fmt.Errorf("at most %d IP addresses can be whitelisted", MaxAPIKeyIPs)
+	}
+
+	response, err := i.client.Post(path, ConvertModifyAPIKeyRequestToParams(req))
+	if err != nil {
+		return nil, fmt.Errorf("error modifying API key: %w", err)
+	}
+	var keyResponse APIKeyResponse
+	if err := response.Unmarshal(&keyResponse); err != nil {
+		return nil, fmt.Errorf("error parsing modify API key response: %w", err)
+	}
+	if keyResponse.RetCode != 0 {
+		return &keyResponse, fmt.Errorf("API returned error: %s", keyResponse.RetMsg)
+	}
+	return &keyResponse, nil
+}
+
+// DeleteMasterAPIKey deletes the master API key that signs the request. The client cannot make
+// further authenticated calls with that key afterwards.
+func (i *impl) DeleteMasterAPIKey() (*DeleteAPIKeyResponse, error) {
+	return i.deleteAPIKey("/v5/user/delete-api", client.Params{})
+}
+
+// DeleteSubAPIKey deletes a sub account API key. A master key can delete any of its sub account
+// keys by passing the key; an empty key deletes the sub account key that signs the request.
+func (i *impl) DeleteSubAPIKey(apiKey string) (*DeleteAPIKeyResponse, error) {
+	params := client.Params{}
+	if apiKey != "" {
+		params["apikey"] = apiKey
+	}
+	return i.deleteAPIKey("/v5/user/delete-sub-api", params)
+}
+
+func (i *impl) deleteAPIKey(path string, params client.Params) (*DeleteAPIKeyResponse, error) {
+	response, err := i.client.Post(path, params)
+	if err != nil {
+		return nil, fmt.Errorf("error deleting API key: %w", err)
+	}
+	var deleteResponse DeleteAPIKeyResponse
+	if err := response.Unmarshal(&deleteResponse); err != nil {
+		return nil, fmt.Errorf("error parsing delete API key response: %w", err)
+	}
+	if deleteResponse.RetCode != 0 {
+		return &deleteResponse, fmt.Errorf("API returned error: %s", deleteResponse.RetMsg)
+	}
+	return &deleteResponse, nil
+}
+
+// GetAPIKeyInfo retrieves the information of the API key that signs the request.
+func (i *impl) GetAPIKeyInfo() (*APIKeyInfoResponse, error) {
+	response, err := i.client.Get("/v5/user/query-api", client.Params{})
+	if err != nil {
+		return nil, fmt.Errorf("error fetching API key info: %w", err)
+	}
+	var infoResponse APIKeyInfoResponse
+	if err := response.Unmarshal(&infoResponse); err != nil {
+		return nil, fmt.Errorf("error parsing API key info response: %w", err)
+	}
+	if infoResponse.RetCode != 0 {
+		return &infoResponse, fmt.Errorf("API returned error: %s", infoResponse.RetMsg)
+	}
+	return &infoResponse, nil
+}
+
+// VerifyPermissions fails fast when the configured key lacks the permissions a tool depends on,
+// so a misconfigured key is reported at startup instead of as an opaque retCode mid-run.
+func (i *impl) VerifyPermissions(required ...Permission) error {
+	info, err := i.GetAPIKeyInfo()
+	if err != nil {
+		return err
+	}
+	readOnly := info.Result.ReadOnly == 1
+	if missing := missingPermissions(info.Result.Permissions, readOnly, required); len(missing) > 0 {
+		return &MissingPermissionsError{Missing: missing, ReadOnly: readOnly}
+	}
+	return nil
+}
@@ -45,7 +45,6 @@ type ChannelType string
 // Client is the main WebSocket client struct, managing the connection and its state.
 type Client struct {
 	closeOnce         sync.Once
-	connOnce          sync.Once
 	isClosed          bool
 	logger            *log.Logger
 	IsTestNet         bool
@@ -56,12 +55,22 @@ type Client struct {
 	Connected         chan struct{}
 	OnConnected       func()
 	OnConnectionError func(err error)
+	OnLatency         func(stats LatencyStats)
 	Category          string
 	MaxActiveTime     string
-	wsURL             string // WebSocket URL for dependency injection in tests
-
-	Conn     *websocket.Conn
-	connLock sync.Mutex
+	// ManualReconnect stops the client from reconnecting by itself when a ping or read fails.
+	// The failed connection is closed instead, and its owner calls Reconnect and restores its
+	// authentication and subscriptions.
+	ManualReconnect bool
+	// ReconnectDelay is the pause before each reconnection attempt. Defaults to ReconnectionDelay.
+	ReconnectDelay time.Duration
+	wsURL          string // WebSocket URL for dependency injection in tests
+
+	Conn          *websocket.Conn
+	connDone      chan struct{} // closed when Conn is dropped, stopping its keepAlive
+	connLock      sync.Mutex
+	reconnectLock sync.Mutex
+	latency       latencyTracker
 }
 
 // NewPublicClient initializes a new public WSClient instance.
@@ -93,38 +102,67 @@ func NewPrivateClient(apiKey, apiSecret string, isTestNet bool, maxActiveTime st
 	return client, nil
 }
 
-// Connect establishes a WebSocket connection to the server based on the configuration.
+// Connect establishes a WebSocket connection to the server based on the configuration. It does
+// nothing while a connection is open.
 func (c *Client) Connect() error {
-	var err error
-	c.connOnce.Do(func() {
-		c.connLock.Lock()
-		defer c.connLock.Unlock()
+	c.connLock.Lock()
+	defer c.connLock.Unlock()
 
-		if c.isClosed {
-			err = errors.New("connection already closed")
-			c.handleConnectionError(err)
-			return
-		}
+	if c.isClosed {
+		err := errors.New("connection already closed")
+		c.handleConnectionError(err)
+		return err
+	}
+	if c.Conn != nil {
+		return nil
+	}
 
-		url := c.buildURL()
-		c.Conn, _, err = websocket.DefaultDialer.Dial(url, nil)
-		if err != nil {
-			c.handleConnectionError(fmt.Errorf("failed to dial %s: %v", url, err))
-			c.Conn = nil
-			return
-		}
+	url := c.buildURL()
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		c.handleConnectionError(fmt.Errorf("failed to dial %s: %v", url, err))
+		return err
+	}
+	c.Conn = conn
+	c.connDone = make(chan struct{})
 
-		c.logger.Printf("Connected to %s", url)
-		if c.OnConnected != nil {
-			c.OnConnected()
-		}
-		closeOnce(c.Connected)
+	c.logger.Printf("Connected to %s", url)
+	if c.OnConnected != nil {
+		c.OnConnected()
+	}
+	closeOnce(c.Connected)
 
-		go c.keepAlive()
-	})
+	go c.keepAlive(c.connDone)
+	return nil
+}
+
+// CurrentConn returns the open connection, or nil while there is none. Use it instead of reading
+// Conn, which Reconnect replaces.
+func (c *Client) CurrentConn() *websocket.Conn {
+	c.connLock.Lock()
+	defer c.connLock.Unlock()
+	return c.Conn
+}
+
+// dropConn closes the current connection and stops its keepAlive. Callers must hold c.connLock.
+func (c *Client) dropConn() error {
+	if c.Conn == nil {
+		return nil
+	}
+	close(c.connDone)
+	err := c.Conn.Close()
+	c.Conn = nil
 	return err
 }
 
+// SetURL makes the client dial url instead of the Bybit endpoint for its channel, e.g. to go
+// through a proxy.
+func (c *Client) SetURL(url string) {
+	c.connLock.Lock()
+	defer c.connLock.Unlock()
+	c.wsURL = url
+}
+
 // buildURL constructs the WebSocket URL based on client configuration.
 func (c *Client) buildURL() string {
 	if c.wsURL != "" {
@@ -147,7 +185,7 @@ func (c *Client) buildURL() string {
 			return fmt.Sprintf("%s://%s/v5/public/linear", DefaultScheme, baseURL)
 		case "inverse_contract":
 			return fmt.Sprintf("%s://%s/v5/public/inverse", DefaultScheme, baseURL)
-		case "usdc_option":
+		case "option", "usdc_option":
 			return fmt.Sprintf("%s://%s/v5/public/option", DefaultScheme, baseURL)
 		default:
 			return fmt.Sprintf("%s://%s/v5/public/linear", DefaultScheme, baseURL) // default to linear (USDT/USDC)
@@ -159,8 +197,8 @@ func (c *Client) buildURL() string {
 	}
 }
 
-// authenticateIfRequired authenticates the WebSocket client if the channel is private.
-func (c *Client) authenticateIfRequired() error {
+// AuthenticateIfRequired authenticates the WebSocket client if the channel is private.
+func (c *Client) AuthenticateIfRequired() error {
 	if c.Channel == Private {
 		expires := fmt.Sprintf("%d", time.Now().UnixMilli()+1000)
 		signatureData := fmt.Sprintf("GET/realtime%s", expires)
@@ -182,12 +220,18 @@ func GenerateWsSignature(apiSecret, data string) string {
 }
 
 // keepAlive sends a ping message to the WebSocket server every PingInterval and handles reconnection if the ping fails.
-func (c *Client) keepAlive() {
+// It returns when done is closed, i.e. when the connection it was started for is dropped.
+func (c *Client) keepAlive(done chan struct{}) {
 	ticker := time.NewTicker(PingInterval)
 	defer ticker.Stop()
 
-	for range ticker.C {
-		c.sendPingAndHandleReconnection()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			c.sendPingAndHandleReconnection()
+		}
 	}
 }
 
@@ -210,8 +254,14 @@ func (c *Client) sendPingAndHandleReconnection() {
 		return
 	}
 
+	c.latency.pingSent(time.Now())
 	if err = c.Conn.WriteMessage(websocket.TextMessage, jsonData); err != nil {
 		c.logger.Printf("Error sending ping: %v", err)
+		if c.ManualReconnect {
+			// Fail the owner's pending read so it reconnects.
+			_ = c.Conn.Close()
+			return
+		}
 		go c.handleReconnection()
 		return
 	}
@@ -250,11 +300,8 @@ func (c *Client) Close() {
 
 		c.isClosed = true
 		c.logger.Println("Connection closed")
-		if c.Conn != nil {
-			if err := c.Conn.Close(); err != nil && c.OnConnectionError != nil {
-				c.OnConnectionError(err)
-			}
-			c.Conn = nil
+		if err := c.dropConn(); err != nil && c.OnConnectionError != nil {
+			c.OnConnectionError(err)
 		}
 	})
 }
@@ -269,13 +316,14 @@ func randomString(n int) string {
 // Send sends a message to the WebSocket server.
 func (c *Client) Send(message []byte) error {
 	c.connLock.Lock()
-	defer c.connLock.Unlock()
+	closed, conn := c.isClosed, c.Conn
+	c.connLock.Unlock()
 
-	if c.isClosed {
+	if closed {
 		return errors.New("attempt to send message on closed connection")
 	}
 
-	if c.Conn == nil {
+	if conn == nil {
 		log.Println("Connection is nil, attempting to reconnect...")
 		if err := c.Connect(); err != nil {
 			log.Printf("Reconnection failed: %v", err)
@@ -283,6 +331,9 @@ func (c *Client) Send(message []byte) error {
 		}
 	}
 
+	c.connLock.Lock()
+	defer c.connLock.Unlock()
+
 	if c.Conn == nil {
 		return errors.New("connection is still nil after attempting to reconnect")
 	}
@@ -307,36 +358,55 @@ func (c *Client) Receive() ([]byte, error) {
 	_, message, err := c.Conn.ReadMessage()
 	if err != nil {
 		log.Printf("Error receiving message: %v", err)
-		go c.handleReconnection()
+		if !c.ManualReconnect {
+			go c.handleReconnection()
+		}
 		return nil, err
 	}
+	c.RecordMessage(message)
 
 	return message, nil
 }
 
 // handleReconnection attempts to reconnect to the WebSocket server.
 func (c *Client) handleReconnection() {
-	c.connLock.Lock()
-	defer c.connLock.Unlock()
-
-	if c.isClosed {
-		return // No need to reconnect if the client is intentionally closed
+	if err := c.Reconnect(); err != nil {
+		c.handleConnectionError(err)
 	}
+}
 
-	c.logger.Println("Attempting to reconnect...")
-	if c.Conn != nil {
-		_ = c.Conn.Close()
-		c.Conn = nil
-	}
+// Reconnect drops the current connection and dials the server again, retrying up to
+// ReconnectionRetries times. Concurrent callers are serialized so only one dial is in flight.
+// Private channels are not re-authenticated here; callers should invoke AuthenticateIfRequired afterwards.
+func (c *Client) Reconnect() error {
+	c.reconnectLock.Lock()
+	defer c.reconnectLock.Unlock()
 
+	delay := c.ReconnectDelay
+	if delay <= 0 {
+		delay = ReconnectionDelay
+	}
+	var err error
 	for i := 0; i < ReconnectionRetries; i++ {
-		time.Sleep(ReconnectionDelay)
-		if err := c.Connect(); err == nil {
+		c.connLock.Lock()
+		if c.isClosed {
+			c.connLock.Unlock()
+			return errors.New("connection already closed")
+		}
+		if i == 0 {
+			c.logger.Println("Attempting to reconnect...")
+		}
+		_ = c.dropConn()
+		c.connLock.Unlock()
+
+		time.Sleep(delay)
+		if err = c.Connect(); err == nil {
 			c.logger.Printf("Reconnection attempt %d successful", i+1)
-			return
+			return nil
 		}
 		c.logger.Printf("Reconnection attempt %d failed", i+1)
 	}
+	return fmt.Errorf("reconnection failed after %d attempts: %w", ReconnectionRetries, err)
 }
 
 func (c *Client) handleConnectionError(err error) {
@@ -3,7 +3,10 @@ package client
 
 import (
 	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -150,3 +153,36 @@ func TestClient_Close(t *testing.T) {
 	client.Close()
 	assert.True(t, client.isClosed)
 }
+
+// TestClient_ConnectionLifecycle verifies a second Connect reuses the open connection and that
+// dropping the connection stops its keepAlive.
+func TestClient_ConnectionLifecycle(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_, _, _ = conn.ReadMessage()
+	}))
+	defer server.Close()
+
+	client, err := NewPublicClient(true, "linear")
+	assert.NoError(t, err)
+	client.wsURL = "ws" + strings.TrimPrefix(server.URL, "http")
+	assert.NoError(t, client.Connect())
+	conn, done := client.CurrentConn(), client.connDone
+	assert.NotNil(t, conn)
+	assert.NoError(t, client.Connect())
+	assert.Same(t, conn, client.CurrentConn())
+
+	client.Close()
+	assert.Nil(t, client.CurrentConn())
+	select {
+	case <-done:
+	default:
+		t.Fatal("keepAlive of the dropped connection is still running")
+	}
+	assert.Error(t, client.Connect())
+}
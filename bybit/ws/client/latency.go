@@ -0,0 +1,113 @@
+package client
+
+import (
+	"encoding/json"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// LatencyStats is a snapshot of the latency measurements collected by a Client.
+//
+// Transport latency is the local receive time minus the server "ts" of a pushed message, so it
+// includes any clock skew between the host and Bybit. ClockSkew is estimated from the server time
+// carried in private pong replies (server time minus the midpoint of the ping round trip); a
+// positive value means the server clock is ahead of the local one.
+type LatencyStats struct {
+	Samples          int64
+	LastTransport    time.Duration
+	MinTransport     time.Duration
+	MaxTransport     time.Duration
+	AverageTransport time.Duration
+	LastRTT          time.Duration
+	ClockSkew        time.Duration
+	UpdatedAt        time.Time
+}
+
+// latencyTracker accumulates latency samples. The zero value is ready to use.
+type latencyTracker struct {
+	mu         sync.Mutex
+	stats      LatencyStats
+	total      time.Duration
+	pingSentAt time.Time
+}
+
+// latencyMessage holds the fields needed to take a latency sample from any inbound frame.
+type latencyMessage struct {
+	Op     string   `json:"op"`
+	RetMsg string   `json:"ret_msg"`
+	TS     int64    `json:"ts"`
+	Args   []string `json:"args"`
+}
+
+func (l *latencyTracker) pingSent(at time.Time) {
+	l.mu.Lock()
+	l.pingSentAt = at
+	l.mu.Unlock()
+}
+
+// record updates the stats from a raw frame received at the given time and reports whether
+// anything was measured.
+func (l *latencyTracker) record(raw []byte, at time.Time) (LatencyStats, bool) {
+	var msg latencyMessage
+	if err := json.Unmarshal(raw, &msg); err != nil {
+		return LatencyStats{}, false
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	switch {
+	case msg.Op == "pong" || msg.RetMsg == "pong":
+		if l.pingSentAt.IsZero() {
+			return LatencyStats{}, false
+		}
+		rtt := at.Sub(l.pingSentAt)
+		l.stats.LastRTT = rtt
+		if len(msg.Args) > 0 {
+			if serverMs, err := strconv.ParseInt(msg.Args[0], 10, 64); err == nil {
+				midpoint := l.pingSentAt.Add(rtt / 2)
+				l.stats.ClockSkew = time.UnixMilli(serverMs).Sub(midpoint)
+			}
+		}
+		l.pingSentAt = time.Time{}
+	case msg.TS > 0:
+		latency := at.Sub(time.UnixMilli(msg.TS))
+		l.stats.Samples++
+		l.total += latency
+		l.stats.LastTransport = latency
+		l.stats.AverageTransport = l.total / time.Duration(l.stats.Samples)
+		if l.stats.Samples == 1 || latency < l.stats.MinTransport {
+			l.stats.MinTransport = latency
+		}
+		if latency > l.stats.MaxTransport {
+			l.stats.MaxTransport = latency
+		}
+	default:
+		return LatencyStats{}, false
+	}
+
+	l.stats.UpdatedAt = at
+	return l.stats, true
+}
+
+func (l *latencyTracker) snapshot() LatencyStats {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.stats
+}
+
+// RecordMessage takes a latency sample from a raw frame read off the connection. Receive calls it
+// automatically; callers that read Conn directly should call it for every frame they read.
+// OnLatency is invoked whenever a sample was taken.
+func (c *Client) RecordMessage(raw []byte) {
+	stats, ok := c.latency.record(raw, time.Now())
+	if ok && c.OnLatency != nil {
+		c.OnLatency(stats)
+	}
+}
+
+// Latency returns the latest latency measurements for the connection.
+func (c *Client) Latency() LatencyStats {
+	return c.latency.snapshot()
+}
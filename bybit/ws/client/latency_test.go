@@ -0,0 +1,46 @@
+package client
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestLatencyTracker_Transport verifies transport latency is derived from the server ts of pushed messages.
+func TestLatencyTracker_Transport(t *testing.T) {
+	var l latencyTracker
+	now := time.UnixMilli(1_700_000_000_000)
+
+	for _, delay := range []time.Duration{30 * time.Millisecond, 10 * time.Millisecond, 20 * time.Millisecond} {
+		raw := fmt.Sprintf(`{"topic":"tickers.BTCUSDT","type":"snapshot","ts":%d,"data":{}}`, now.Add(-delay).UnixMilli())
+		_, ok := l.record([]byte(raw), now)
+		assert.True(t, ok)
+	}
+
+	stats := l.snapshot()
+	assert.Equal(t, int64(3), stats.Samples)
+	assert.Equal(t, 20*time.Millisecond, stats.LastTransport)
+	assert.Equal(t, 10*time.Millisecond, stats.MinTransport)
+	assert.Equal(t, 30*time.Millisecond, stats.MaxTransport)
+	assert.Equal(t, 20*time.Millisecond, stats.AverageTransport)
+}
+
+// TestLatencyTracker_Pong verifies ping round trip and clock skew are measured from pong replies.
+func TestLatencyTracker_Pong(t *testing.T) {
+	var l latencyTracker
+	sent := time.UnixMilli(1_700_000_000_000)
+	l.pingSent(sent)
+
+	// Server is 500ms ahead: it answers at the local midpoint (sent+20ms) plus 500ms.
+	raw := fmt.Sprintf(`{"op":"pong","args":["%d"]}`, sent.Add(520*time.Millisecond).UnixMilli())
+	stats, ok := l.record([]byte(raw), sent.Add(40*time.Millisecond))
+	assert.True(t, ok)
+	assert.Equal(t, 40*time.Millisecond, stats.LastRTT)
+	assert.Equal(t, 500*time.Millisecond, stats.ClockSkew)
+
+	// A second pong without a pending ping is ignored.
+	_, ok = l.record([]byte(`{"success":true,"ret_msg":"pong","op":"ping"}`), sent.Add(time.Second))
+	assert.False(t, ok)
+}
@@ -11,11 +11,11 @@ import (
 )
 
 type response struct {
-	Topic string `json:"topic"`
-	Type  string `json:"type"`
-	Data  Data   `json:"data"`
-	CS    int64  `json:"cs"`
-	TS    int64  `json:"ts"`
+	Topic string          `json:"topic"`
+	Type  string          `json:"type"`
+	Data  json.RawMessage `json:"data"`
+	CS    int64           `json:"cs"`
+	TS    int64           `json:"ts"`
 }
 
 type Data struct {
@@ -41,13 +41,43 @@ type Data struct {
 	Ask1Size          string `json:"ask1Size"`
 }
 
+// OptionData is the ticker payload pushed for option contracts, including Greeks and implied volatility.
+type OptionData struct {
+	Symbol                 string `json:"symbol"`
+	BidPrice               string `json:"bidPrice"`
+	BidSize                string `json:"bidSize"`
+	BidIv                  string `json:"bidIv"`
+	AskPrice               string `json:"askPrice"`
+	AskSize                string `json:"askSize"`
+	AskIv                  string `json:"askIv"`
+	LastPrice              string `json:"lastPrice"`
+	HighPrice24H           string `json:"highPrice24h"`
+	LowPrice24H            string `json:"lowPrice24h"`
+	MarkPrice              string `json:"markPrice"`
+	IndexPrice             string `json:"indexPrice"`
+	MarkPriceIv            string `json:"markPriceIv"`
+	UnderlyingPrice        string `json:"underlyingPrice"`
+	OpenInterest           string `json:"openInterest"`
+	Turnover24H            string `json:"turnover24h"`
+	Volume24H              string `json:"volume24h"`
+	TotalVolume            string `json:"totalVolume"`
+	TotalTurnover          string `json:"totalTurnover"`
+	Delta                  string `json:"delta"`
+	Gamma                  string `json:"gamma"`
+	Vega                   string `json:"vega"`
+	Theta                  string `json:"theta"`
+	PredictedDeliveryPrice string `json:"predictedDeliveryPrice"`
+	Change24H              string `json:"change24h"`
+}
+
 // Ticker manages ticker subscriptions and updates.
 type Ticker struct {
 	client      *client.Client
 	subscribers map[string]func(Data)
+	options     map[string]func(OptionData)
 	ctx         context.Context
 	cancel      context.CancelFunc
-	mu          sync.RWMutex
+	mu          *sync.RWMutex // shared by the copies New hands out, like the maps it guards
 	sendCh      chan []byte
 }
 
@@ -57,6 +87,8 @@ func New(client *client.Client) Ticker {
 	t := Ticker{
 		client:      client,
 		subscribers: make(map[string]func(Data)),
+		options:     make(map[string]func(OptionData)),
+		mu:          new(sync.RWMutex),
 		ctx:         ctx,
 		cancel:      cancel,
 		sendCh:      make(chan []byte),
@@ -83,18 +115,29 @@ func (t *Ticker) Subscribe(symbol string, callback func(Data)) error {
 	defer t.mu.Unlock()
 	topic := fmt.Sprintf("tickers.%s", symbol)
 	t.subscribers[topic] = callback
+	return t.send("subscribe", topic)
+}
 
-	// Correctly construct the subscription message with "args"
-	subscriptionMessage := map[string]any{
-		"op":   "subscribe",
+// SubscribeOption subscribes to the ticker updates for an option contract, e.g. "BTC-28JUN24-60000-C".
+// The client must be created with the "option" category so it connects to the option stream.
+func (t *Ticker) SubscribeOption(symbol string, callback func(OptionData)) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	topic := fmt.Sprintf("tickers.%s", symbol)
+	t.options[topic] = callback
+	return t.send("subscribe", topic)
+}
+
+// send queues a subscribe or unsubscribe request for the writer goroutine.
+func (t *Ticker) send(op, topic string) error {
+	message := map[string]any{
+		"op":   op,
 		"args": []string{topic}, // Use an array for topics as per API requirements
 	}
-	msg, err := json.Marshal(subscriptionMessage)
+	msg, err := json.Marshal(message)
 	if err != nil {
-		return fmt.Errorf("failed to marshal subscription message: %v", err)
+		return fmt.Errorf("failed to marshal %s message: %v", op, err)
 	}
-
-	// Send the subscription message
 	t.sendCh <- msg
 	return nil
 }
@@ -119,17 +162,39 @@ func (t *Ticker) Listen() {
 				continue
 			}
 
-			t.mu.RLock()
-			callback, exists := t.subscribers[res.Topic]
-			t.mu.RUnlock()
-
-			if exists && (res.Type == "snapshot" || res.Type == "delta") {
-				go callback(res.Data)
+			if res.Type != "snapshot" && res.Type != "delta" {
+				continue
 			}
+			t.dispatch(res)
 		}
 	}
 }
 
+// dispatch decodes the payload according to the subscriber registered for the topic.
+func (t *Ticker) dispatch(res response) {
+	t.mu.RLock()
+	callback, exists := t.subscribers[res.Topic]
+	optionCallback, isOption := t.options[res.Topic]
+	t.mu.RUnlock()
+
+	switch {
+	case exists:
+		var data Data
+		if err := json.Unmarshal(res.Data, &data); err != nil {
+			log.Printf("Error unmarshalling ticker data: %v", err)
+			return
+		}
+		go callback(data)
+	case isOption:
+		var data OptionData
+		if err := json.Unmarshal(res.Data, &data); err != nil {
+			log.Printf("Error unmarshalling option ticker data: %v", err)
+			return
+		}
+		go optionCallback(data)
+	}
+}
+
 // Unsubscribe from the ticker updates for a given symbol.
 func (t *Ticker) Unsubscribe(symbol string) error {
 	t.mu.Lock()
@@ -137,20 +202,8 @@ func (t *Ticker) Unsubscribe(symbol string) error {
 	topic := fmt.Sprintf("tickers.%s", symbol)
 
 	delete(t.subscribers, topic)
-
-	// Construct the unsubscription message
-	unsubscriptionMessage := map[string]any{
-		"op":   "unsubscribe",
-		"args": []string{topic},
-	}
-	msg, err := json.Marshal(unsubscriptionMessage)
-	if err != nil {
-		return fmt.Errorf("failed to marshal unsubscription message: %v", err)
-	}
-
-	// Send the unsubscription message
-	t.sendCh <- msg
-	return nil
+	delete(t.options, topic)
+	return t.send("unsubscribe", topic)
 }
 
 // Shutdown method to cleanly terminate the Listen loop.
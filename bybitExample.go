@@ -98,8 +98,8 @@ func setMMP() (any, error) {
 		BaseCoin:     "BTC",
 		Window:       200,
 		FrozenPeriod: 10,
-		QtyLimit:     100,
-		DeltaLimit:   100,
+		QtyLimit:     "100",
+		DeltaLimit:   "100",
 	}
 	fmt.Println("setMMP")
 	return margin.SetMMP(params)
@@ -0,0 +1,199 @@
+// Package client is the REST client of the Coinbase Advanced Trade API. Requests are
+// authenticated with a JWT signed by a CDP API key and kept within Coinbase's rate limits.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	BaseURL = "https://api.coinbase.com"
+	// APIPrefix is the path prefix of the Advanced Trade REST endpoints.
+	APIPrefix = "/api/v3/brokerage"
+
+	// Coinbase allows 10 public and 30 private requests per second.
+	publicRequestsPerSecond  = 10
+	privateRequestsPerSecond = 30
+)
+
+// ErrNoCredentials is returned for private requests by a client created without an API key.
+var ErrNoCredentials = errors.New("API key required")
+
+// Request is a REST request. Path is relative to APIPrefix.
+type Request struct {
+	Method string
+	Path   string
+	Query  url.Values
+	// Body is encoded as JSON.
+	Body any
+	// Public requests are sent without authentication.
+	Public bool
+}
+
+// APIError is an error response of the Advanced Trade API.
+type APIError struct {
+	Status  int    `json:"-"`
+	Code    string `json:"error"`
+	Message string `json:"message"`
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API returned error: %s (%s)", e.Message, e.Code)
+}
+
+// Client sends requests to the Advanced Trade API. It is safe for concurrent use.
+type Client struct {
+	keyName    string
+	signer     signer
+	baseURL    string
+	host       string
+	httpClient *http.Client
+
+	publicLimiter  *rate.Limiter
+	privateLimiter *rate.Limiter
+}
+
+// New creates a client for the CDP API key keyName, e.g. "organizations/{org}/apiKeys/{id}",
+// and its private key. Both may be empty for public market data only.
+func New(keyName, privateKey string) (*Client, error) {
+	return NewWithBaseURL(keyName, privateKey, BaseURL)
+}
+
+// NewWithBaseURL creates a client that sends requests to baseURL instead of BaseURL, e.g. through
+// a proxy.
+func NewWithBaseURL(keyName, privateKey, baseURL string) (*Client, error) {
+	parsed, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, err
+	}
+	c := &Client{
+		keyName:        keyName,
+		baseURL:        baseURL,
+		host:           parsed.Host,
+		httpClient:     &http.Client{},
+		publicLimiter:  rate.NewLimiter(publicRequestsPerSecond, publicRequestsPerSecond),
+		privateLimiter: rate.NewLimiter(privateRequestsPerSecond, privateRequestsPerSecond),
+	}
+	if privateKey != "" {
+		if c.signer, err = parsePrivateKey(privateKey); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// Do sends req and decodes the JSON response into out, which may be nil.
+func (c *Client) Do(req *Request, out any) error {
+	limiter := c.privateLimiter
+	if req.Public {
+		limiter = c.publicLimiter
+	}
+	if err := limiter.Wait(context.Background()); err != nil {
+		return fmt.Errorf("rate limiter error: %w", err)
+	}
+
+	httpReq, err := c.newRequest(req)
+	if err != nil {
+		return err
+	}
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("error reading response: %w", err)
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		apiErr := &APIError{Status: resp.StatusCode}
+		if err := json.Unmarshal(body, apiErr); err != nil || (apiErr.Code == "" && apiErr.Message == "") {
+			return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+		}
+		return apiErr
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("error parsing response: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) newRequest(req *Request) (*http.Request, error) {
+	path := APIPrefix + req.Path
+	target := c.baseURL + path
+	if len(req.Query) > 0 {
+		target += "?" + req.Query.Encode()
+	}
+	body := io.Reader(http.NoBody)
+	if req.Body != nil {
+		data, err := json.Marshal(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewReader(data)
+	}
+	httpReq, err := http.NewRequest(req.Method, target, body)
+	if err != nil {
+		return nil, err
+	}
+	if req.Body != nil {
+		httpReq.Header.Set("Content-Type", "application/json")
+	}
+	if !req.Public {
+		token, err := c.JWT(req.Method + " " + c.host + path)
+		if err != nil {
+			return nil, err
+		}
+		httpReq.Header.Set("Authorization", "Bearer "+token)
+	}
+	return httpReq, nil
+}
+
+// Pagination is embedded in list responses that are paged with a cursor.
+type Pagination struct {
+	HasNext bool   `json:"has_next"`
+	Cursor  string `json:"cursor"`
+}
+
+func (p Pagination) pagination() Pagination {
+	return p
+}
+
+type paged interface {
+	pagination() Pagination
+}
+
+// GetAll requests every page of a cursor-paginated endpoint and calls visit with each of them.
+// P is a response type embedding Pagination.
+func GetAll[P paged](c *Client, path string, query url.Values, visit func(P)) error {
+	params := url.Values{}
+	for k, v := range query {
+		params[k] = v
+	}
+	for {
+		var page P
+		if err := c.Do(&Request{Method: http.MethodGet, Path: path, Query: params}, &page); err != nil {
+			return err
+		}
+		visit(page)
+		next := page.pagination()
+		if !next.HasNext || next.Cursor == "" {
+			return nil
+		}
+		params.Set("cursor", next.Cursor)
+	}
+}
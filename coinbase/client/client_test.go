@@ -0,0 +1,131 @@
+package client
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// decodeJWT verifies the token with verify and returns its header and claims.
+func decodeJWT(t *testing.T, token string, verify func(input, sig []byte) bool) (header, claims map[string]any) {
+	parts := strings.Split(token, ".")
+	require.Len(t, parts, 3)
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	require.NoError(t, err)
+	assert.True(t, verify([]byte(parts[0]+"."+parts[1]), sig), "invalid signature")
+	for i, out := range []*map[string]any{&header, &claims} {
+		data, err := base64.RawURLEncoding.DecodeString(parts[i])
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(data, out))
+	}
+	return header, claims
+}
+
+func TestJWTES256(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	// Key files downloaded from the CDP portal contain escaped newlines.
+	secret := strings.ReplaceAll(string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})), "\n", `\n`)
+
+	c, err := New("organizations/org/apiKeys/key", secret)
+	require.NoError(t, err)
+	token, err := c.JWT("GET api.coinbase.com/api/v3/brokerage/accounts")
+	require.NoError(t, err)
+
+	header, claims := decodeJWT(t, token, func(input, sig []byte) bool {
+		digest := sha256.Sum256(input)
+		r, s := new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:])
+		return len(sig) == 64 && ecdsa.Verify(&key.PublicKey, digest[:], r, s)
+	})
+	assert.Equal(t, "ES256", header["alg"])
+	assert.Equal(t, "organizations/org/apiKeys/key", header["kid"])
+	assert.NotEmpty(t, header["nonce"])
+	assert.Equal(t, "cdp", claims["iss"])
+	assert.Equal(t, "GET api.coinbase.com/api/v3/brokerage/accounts", claims["uri"])
+	assert.Equal(t, float64(120), claims["exp"].(float64)-claims["nbf"].(float64))
+}
+
+func TestJWTEd25519(t *testing.T) {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	c, err := New("key-id", base64.StdEncoding.EncodeToString(private))
+	require.NoError(t, err)
+	token, err := c.JWT("")
+	require.NoError(t, err)
+
+	header, claims := decodeJWT(t, token, func(input, sig []byte) bool {
+		return ed25519.Verify(public, input, sig)
+	})
+	assert.Equal(t, "EdDSA", header["alg"])
+	assert.NotContains(t, claims, "uri")
+
+	_, err = New("key-id", "not a key")
+	assert.Error(t, err)
+}
+
+type testPage struct {
+	Pagination
+	Items []string `json:"items"`
+}
+
+func TestGetAll(t *testing.T) {
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, APIPrefix+"/accounts", r.URL.Path)
+		assert.True(t, strings.HasPrefix(r.Header.Get("Authorization"), "Bearer "))
+		assert.Equal(t, "250", r.URL.Query().Get("limit"))
+		if r.URL.Query().Get("cursor") == "" {
+			fmt.Fprint(w, `{"items":["a","b"],"has_next":true,"cursor":"next"}`)
+			return
+		}
+		fmt.Fprint(w, `{"items":["c"],"has_next":false,"cursor":""}`)
+	}))
+	defer server.Close()
+	c, err := NewWithBaseURL("key-id", base64.StdEncoding.EncodeToString(private), server.URL)
+	require.NoError(t, err)
+
+	var items []string
+	err = GetAll(c, "/accounts", map[string][]string{"limit": {"250"}}, func(page testPage) {
+		items = append(items, page.Items...)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, items)
+}
+
+func TestDoErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"error":"NOT_FOUND","code":5,"message":"order with this orderID was not found"}`)
+	}))
+	defer server.Close()
+	c, err := NewWithBaseURL("", "", server.URL)
+	require.NoError(t, err)
+
+	err = c.Do(&Request{Method: http.MethodGet, Path: "/market/products/X", Public: true}, nil)
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, "NOT_FOUND", apiErr.Code)
+	assert.Equal(t, http.StatusNotFound, apiErr.Status)
+
+	err = c.Do(&Request{Method: http.MethodGet, Path: "/accounts"}, nil)
+	assert.True(t, errors.Is(err, ErrNoCredentials))
+}
@@ -0,0 +1,133 @@
+package client
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const jwtLifetime = 2 * time.Minute
+
+// signer signs JWTs with the private key of a CDP API key.
+type signer interface {
+	algorithm() string
+	sign(data []byte) ([]byte, error)
+}
+
+type ecdsaSigner struct {
+	key *ecdsa.PrivateKey
+}
+
+func (s ecdsaSigner) algorithm() string {
+	return "ES256"
+}
+
+// sign returns the fixed-size r || s signature JWS requires instead of the ASN.1 encoding.
+func (s ecdsaSigner) sign(data []byte) ([]byte, error) {
+	digest := sha256.Sum256(data)
+	r, sig, err := ecdsa.Sign(rand.Reader, s.key, digest[:])
+	if err != nil {
+		return nil, err
+	}
+	out := make([]byte, 64)
+	r.FillBytes(out[:32])
+	sig.FillBytes(out[32:])
+	return out, nil
+}
+
+type ed25519Signer struct {
+	key ed25519.PrivateKey
+}
+
+func (s ed25519Signer) algorithm() string {
+	return "EdDSA"
+}
+
+func (s ed25519Signer) sign(data []byte) ([]byte, error) {
+	return ed25519.Sign(s.key, data), nil
+}
+
+// parsePrivateKey accepts the PEM encoded EC keys and the base64 encoded Ed25519 keys the CDP
+// portal issues. Escaped newlines, as found in the downloaded JSON key file, are unescaped.
+func parsePrivateKey(secret string) (signer, error) {
+	secret = strings.TrimSpace(strings.ReplaceAll(secret, `\n`, "\n"))
+	if block, _ := pem.Decode([]byte(secret)); block != nil {
+		if key, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+			return ecdsaSigner{key: key}, nil
+		}
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing private key: %w", err)
+		}
+		switch key := key.(type) {
+		case *ecdsa.PrivateKey:
+			return ecdsaSigner{key: key}, nil
+		case ed25519.PrivateKey:
+			return ed25519Signer{key: key}, nil
+		}
+		return nil, errors.New("unsupported private key type")
+	}
+	raw, err := base64.StdEncoding.DecodeString(secret)
+	if err != nil {
+		return nil, errors.New("private key is neither PEM nor base64 encoded")
+	}
+	switch len(raw) {
+	case ed25519.PrivateKeySize:
+		return ed25519Signer{key: ed25519.PrivateKey(raw)}, nil
+	case ed25519.SeedSize:
+		return ed25519Signer{key: ed25519.NewKeyFromSeed(raw)}, nil
+	}
+	return nil, fmt.Errorf("unexpected Ed25519 key length %d", len(raw))
+}
+
+// JWT returns a bearer token for uri, e.g. "GET api.coinbase.com/api/v3/brokerage/accounts".
+// Websocket subscriptions use a token without a uri.
+func (c *Client) JWT(uri string) (string, error) {
+	if c.signer == nil {
+		return "", ErrNoCredentials
+	}
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	header := map[string]string{
+		"alg":   c.signer.algorithm(),
+		"kid":   c.keyName,
+		"nonce": hex.EncodeToString(nonce),
+		"typ":   "JWT",
+	}
+	now := time.Now()
+	claims := map[string]any{
+		"sub": c.keyName,
+		"iss": "cdp",
+		"nbf": now.Unix(),
+		"exp": now.Add(jwtLifetime).Unix(),
+	}
+	if uri != "" {
+		claims["uri"] = uri
+	}
+	headerJSON, err := json.Marshal(header)
+	if err != nil {
+		return "", err
+	}
+	claimsJSON, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	signingInput := base64.RawURLEncoding.EncodeToString(headerJSON) + "." + base64.RawURLEncoding.EncodeToString(claimsJSON)
+	signature, err := c.signer.sign([]byte(signingInput))
+	if err != nil {
+		return "", fmt.Errorf("error signing JWT: %w", err)
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
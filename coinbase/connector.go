@@ -0,0 +1,539 @@
+// Package coinbase implements the exchange-neutral interfaces for Coinbase Advanced Trade spot
+// markets, using the REST API for orders and accounts and the websocket feed for tickers and
+// order updates. Symbols are Coinbase product ids such as BTC-USD.
+package coinbase
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cploutarchou/crypto-sdk-suite/coinbase/client"
+	"github.com/cploutarchou/crypto-sdk-suite/decimal"
+	"github.com/cploutarchou/crypto-sdk-suite/exchange"
+)
+
+// maxCandles is the number of candles Coinbase returns per request.
+const maxCandles = 350
+
+// Connector implements exchange.Exchange for Coinbase Advanced Trade spot markets.
+type Connector struct {
+	client *client.Client
+	stream *Stream
+
+	mu             sync.Mutex
+	tickerHandlers map[string]func(exchange.Ticker)
+}
+
+var _ exchange.Exchange = (*Connector)(nil)
+
+// NewConnector creates a connector for the CDP API key keyName and its private key, either the
+// PEM encoded EC key or the base64 encoded Ed25519 key. Both may be empty for market data only.
+func NewConnector(keyName, privateKey string) (*Connector, error) {
+	c, err := client.New(keyName, privateKey)
+	if err != nil {
+		return nil, err
+	}
+	return newConnector(c, MarketStreamURL, UserStreamURL), nil
+}
+
+func newConnector(c *client.Client, marketURL, userURL string) *Connector {
+	return &Connector{
+		client:         c,
+		stream:         newStream(c, marketURL, userURL),
+		tickerHandlers: make(map[string]func(exchange.Ticker)),
+	}
+}
+
+// Client returns the REST client, e.g. to call endpoints the connector does not cover.
+func (c *Connector) Client() *client.Client {
+	return c.client
+}
+
+// Stream returns the websocket stream used by SubscribeTicker and SubscribeOrders.
+func (c *Connector) Stream() *Stream {
+	return c.stream
+}
+
+func (c *Connector) Name() string {
+	return "coinbase"
+}
+
+func (c *Connector) public(path string, query url.Values, out any) error {
+	return c.client.Do(&client.Request{Method: http.MethodGet, Path: path, Query: query, Public: true}, out)
+}
+
+func (c *Connector) Ticker(symbol string) (*exchange.Ticker, error) {
+	var p product
+	if err := c.public("/market/products/"+url.PathEscape(symbol), nil, &p); err != nil {
+		return nil, fmt.Errorf("error fetching product: %w", err)
+	}
+	book, err := c.productBook(symbol, 1)
+	if err != nil {
+		return nil, err
+	}
+	var fp exchange.FieldParser
+	t := &exchange.Ticker{
+		Symbol:    symbol,
+		Last:      fp.Decimal("price", p.Price),
+		Volume24h: fp.Decimal("volume_24h", p.Volume24h),
+		Time:      fp.RFC3339("time", book.PriceBook.Time),
+	}
+	if len(book.PriceBook.Bids) > 0 {
+		t.Bid = fp.Decimal("bid", book.PriceBook.Bids[0].Price)
+	}
+	if len(book.PriceBook.Asks) > 0 {
+		t.Ask = fp.Decimal("ask", book.PriceBook.Asks[0].Price)
+	}
+	return t, fp.Err
+}
+
+func (c *Connector) productBook(symbol string, limit int) (*productBook, error) {
+	query := url.Values{"product_id": {symbol}}
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	var book productBook
+	if err := c.public("/market/product_book", query, &book); err != nil {
+		return nil, fmt.Errorf("error fetching order book: %w", err)
+	}
+	return &book, nil
+}
+
+func (c *Connector) OrderBook(symbol string, depth int) (*exchange.OrderBook, error) {
+	book, err := c.productBook(symbol, depth)
+	if err != nil {
+		return nil, err
+	}
+	var p exchange.FieldParser
+	out := &exchange.OrderBook{
+		Symbol: symbol,
+		Bids:   levels(&p, book.PriceBook.Bids),
+		Asks:   levels(&p, book.PriceBook.Asks),
+		Time:   p.RFC3339("time", book.PriceBook.Time),
+	}
+	return out, p.Err
+}
+
+func levels(p *exchange.FieldParser, rows []bookLevel) []exchange.PriceLevel {
+	out := make([]exchange.PriceLevel, len(rows))
+	for i, row := range rows {
+		out[i] = exchange.PriceLevel{Price: p.Decimal("price", row.Price), Qty: p.Decimal("size", row.Size)}
+	}
+	return out
+}
+
+// candleGranularities maps the durations Candles accepts to Coinbase candle granularities.
+var candleGranularities = map[time.Duration]string{
+	time.Minute:      "ONE_MINUTE",
+	5 * time.Minute:  "FIVE_MINUTE",
+	15 * time.Minute: "FIFTEEN_MINUTE",
+	30 * time.Minute: "THIRTY_MINUTE",
+	time.Hour:        "ONE_HOUR",
+	2 * time.Hour:    "TWO_HOUR",
+	6 * time.Hour:    "SIX_HOUR",
+	24 * time.Hour:   "ONE_DAY",
+}
+
+// Candles pages backwards by time window when limit exceeds what one request returns. Coinbase
+// omits candles without trades, so fewer than limit candles may be returned for quiet products.
+func (c *Connector) Candles(symbol string, interval time.Duration, limit int) ([]exchange.Candle, error) {
+	granularity, ok := candleGranularities[interval]
+	if !ok {
+		return nil, fmt.Errorf("%w: candle interval %s", exchange.ErrNotSupported, interval)
+	}
+	var (
+		p   exchange.FieldParser
+		out []exchange.Candle
+		end = time.Now()
+	)
+	for len(out) < limit {
+		start := end.Add(-time.Duration(min(limit-len(out), maxCandles)) * interval)
+		query := url.Values{
+			"start":       {strconv.FormatInt(start.Unix(), 10)},
+			"end":         {strconv.FormatInt(end.Unix(), 10)},
+			"granularity": {granularity},
+		}
+		var res candleList
+		if err := c.public("/market/products/"+url.PathEscape(symbol)+"/candles", query, &res); err != nil {
+			return nil, fmt.Errorf("error fetching candles: %w", err)
+		}
+		if len(res.Candles) == 0 {
+			break
+		}
+		// Coinbase returns the newest candle first.
+		page := make([]exchange.Candle, len(res.Candles))
+		for i, candle := range res.Candles {
+			startSeconds, err := strconv.ParseInt(candle.Start, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing start: %w", err)
+			}
+			page[len(page)-1-i] = exchange.Candle{
+				Start:  time.Unix(startSeconds, 0),
+				Open:   p.Decimal("open", candle.Open),
+				High:   p.Decimal("high", candle.High),
+				Low:    p.Decimal("low", candle.Low),
+				Close:  p.Decimal("close", candle.Close),
+				Volume: p.Decimal("volume", candle.Volume),
+			}
+		}
+		out = append(page, out...)
+		end = page[0].Start.Add(-time.Second)
+	}
+	if len(out) > limit {
+		out = out[len(out)-limit:]
+	}
+	return out, p.Err
+}
+
+func (c *Connector) Instruments() ([]exchange.Instrument, error) {
+	var res productList
+	if err := c.public("/market/products", url.Values{"product_type": {"SPOT"}}, &res); err != nil {
+		return nil, fmt.Errorf("error fetching products: %w", err)
+	}
+	var p exchange.FieldParser
+	out := make([]exchange.Instrument, 0, len(res.Products))
+	for _, info := range res.Products {
+		if info.TradingDisabled || info.IsDisabled {
+			continue
+		}
+		tick := info.PriceIncrement
+		if tick == "" {
+			tick = info.QuoteIncrement
+		}
+		out = append(out, exchange.Instrument{
+			Symbol:      info.ProductID,
+			Base:        info.BaseCurrencyID,
+			Quote:       info.QuoteCurrencyID,
+			TickSize:    p.Decimal("price_increment", tick),
+			QtyStep:     p.Decimal("base_increment", info.BaseIncrement),
+			MinQty:      p.Decimal("base_min_size", info.BaseMinSize),
+			MinNotional: p.Decimal("quote_min_size", info.QuoteMinSize),
+		})
+	}
+	return out, p.Err
+}
+
+// PlaceOrder generates a client order id when none is set, since Coinbase requires one.
+func (c *Connector) PlaceOrder(req exchange.OrderRequest) (*exchange.Order, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if req.ReduceOnly {
+		return nil, fmt.Errorf("%w: reduce-only orders on spot", exchange.ErrNotSupported)
+	}
+	if req.ClientOrderID == "" {
+		id := make([]byte, 16)
+		if _, err := rand.Read(id); err != nil {
+			return nil, err
+		}
+		req.ClientOrderID = hex.EncodeToString(id)
+	}
+
+	config := orderConfig{BaseSize: req.Qty.String()}
+	configType := "market_market_ioc"
+	if req.Type == exchange.Limit {
+		config.LimitPrice = req.Price.String()
+		switch req.TimeInForce {
+		case exchange.FOK:
+			configType = "limit_limit_fok"
+		case exchange.IOC:
+			configType = "sor_limit_ioc"
+		default:
+			configType = "limit_limit_gtc"
+			config.PostOnly = req.TimeInForce == exchange.PostOnly
+		}
+	}
+	body := createOrderRequest{
+		ClientOrderID:      req.ClientOrderID,
+		ProductID:          req.Symbol,
+		Side:               convertSideTo(req.Side),
+		OrderConfiguration: map[string]orderConfig{configType: config},
+	}
+	var res createOrderResponse
+	if err := c.client.Do(&client.Request{Method: http.MethodPost, Path: "/orders", Body: body}, &res); err != nil {
+		return nil, fmt.Errorf("error placing order: %w", err)
+	}
+	if !res.Success {
+		reason := res.ErrorResponse
+		msg := reason.Message
+		if reason.ErrorDetails != "" {
+			msg = reason.ErrorDetails
+		}
+		code := reason.Error
+		if reason.NewOrderFailureReason != "" {
+			code = reason.NewOrderFailureReason
+		}
+		return nil, fmt.Errorf("error placing order: %w", &client.APIError{Code: code, Message: msg})
+	}
+	now := time.Now()
+	return &exchange.Order{
+		ID:            res.SuccessResponse.OrderID,
+		ClientOrderID: req.ClientOrderID,
+		Symbol:        req.Symbol,
+		Side:          req.Side,
+		Type:          req.Type,
+		Status:        exchange.StatusNew,
+		Price:         req.Price,
+		Qty:           req.Qty,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}, nil
+}
+
+// CancelOrder cancels the order with orderID. Coinbase order ids are unique across products, so
+// symbol is not sent.
+func (c *Connector) CancelOrder(symbol, orderID string) error {
+	var res cancelOrdersResponse
+	body := cancelOrdersRequest{OrderIDs: []string{orderID}}
+	if err := c.client.Do(&client.Request{Method: http.MethodPost, Path: "/orders/batch_cancel", Body: body}, &res); err != nil {
+		return fmt.Errorf("error cancelling order: %w", err)
+	}
+	for _, result := range res.Results {
+		if result.Success {
+			return nil
+		}
+		err := &client.APIError{Code: result.FailureReason, Message: "cancel failed"}
+		return fmt.Errorf("error cancelling order: %w", neutralError(err))
+	}
+	return errors.New("error cancelling order: empty response")
+}
+
+func (c *Connector) GetOrder(symbol, orderID string) (*exchange.Order, error) {
+	var res orderResponse
+	if err := c.client.Do(&client.Request{Method: http.MethodGet, Path: "/orders/historical/" + url.PathEscape(orderID)}, &res); err != nil {
+		return nil, fmt.Errorf("error fetching order: %w", neutralError(err))
+	}
+	return convertOrder(res.Order)
+}
+
+func (c *Connector) OpenOrders(symbol string) ([]exchange.Order, error) {
+	query := url.Values{"order_status": {"OPEN"}, "limit": {"100"}}
+	if symbol != "" {
+		query.Set("product_ids", symbol)
+	}
+	var out []exchange.Order
+	var convertErr error
+	err := client.GetAll(c.client, "/orders/historical/batch", query, func(page orderList) {
+		for _, raw := range page.Orders {
+			o, err := convertOrder(raw)
+			if err != nil {
+				convertErr = err
+				return
+			}
+			out = append(out, *o)
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error fetching open orders: %w", err)
+	}
+	return out, convertErr
+}
+
+// neutralError adds exchange.ErrOrderNotFound to Coinbase's unknown order errors.
+func neutralError(err error) error {
+	var apiErr *client.APIError
+	if errors.As(err, &apiErr) && (apiErr.Status == http.StatusNotFound || apiErr.Code == "NOT_FOUND" || apiErr.Code == "UNKNOWN_CANCEL_ORDER") {
+		return fmt.Errorf("%w: %w", exchange.ErrOrderNotFound, err)
+	}
+	return err
+}
+
+func convertOrder(raw order) (*exchange.Order, error) {
+	var p exchange.FieldParser
+	o := &exchange.Order{
+		ID:            raw.OrderID,
+		ClientOrderID: raw.ClientOrderID,
+		Symbol:        raw.ProductID,
+		Side:          convertSide(raw.Side),
+		Type:          convertType(raw.OrderType),
+		FilledQty:     p.Decimal("filled_size", raw.FilledSize),
+		AvgPrice:      p.Decimal("average_filled_price", raw.AverageFilledPrice),
+		CreatedAt:     p.RFC3339("created_time", raw.CreatedTime),
+		UpdatedAt:     p.RFC3339("last_fill_time", raw.LastFillTime),
+	}
+	// The order configuration holds a single entry named after the order type.
+	for _, config := range raw.OrderConfiguration {
+		o.Qty = p.Decimal("base_size", config.BaseSize)
+		o.Price = p.Decimal("limit_price", config.LimitPrice)
+	}
+	if o.UpdatedAt.IsZero() {
+		o.UpdatedAt = o.CreatedAt
+	}
+	o.Status = convertStatus(raw.Status, o.FilledQty)
+	if p.Err != nil {
+		return nil, p.Err
+	}
+	return o, nil
+}
+
+func convertSide(side string) exchange.Side {
+	if side == "SELL" {
+		return exchange.Sell
+	}
+	return exchange.Buy
+}
+
+func convertSideTo(side exchange.Side) string {
+	if side == exchange.Sell {
+		return "SELL"
+	}
+	return "BUY"
+}
+
+func convertType(orderType string) exchange.OrderType {
+	switch orderType {
+	case "LIMIT":
+		return exchange.Limit
+	case "MARKET":
+		return exchange.Market
+	}
+	// Stop and bracket orders keep Coinbase's type.
+	return exchange.OrderType(orderType)
+}
+
+// convertStatus maps Coinbase order statuses. Coinbase reports partially filled orders as OPEN.
+func convertStatus(status string, filled decimal.Decimal) exchange.OrderStatus {
+	switch status {
+	case "OPEN":
+		if filled.Sign() > 0 {
+			return exchange.StatusPartiallyFilled
+		}
+	case "FILLED":
+		return exchange.StatusFilled
+	case "CANCELLED", "EXPIRED":
+		return exchange.StatusCancelled
+	case "FAILED":
+		return exchange.StatusRejected
+	}
+	// PENDING, QUEUED and CANCEL_QUEUED orders are still waiting to fill.
+	return exchange.StatusNew
+}
+
+func (c *Connector) Balances() ([]exchange.Balance, error) {
+	var p exchange.FieldParser
+	var out []exchange.Balance
+	err := client.GetAll(c.client, "/accounts", url.Values{"limit": {"250"}}, func(page accountList) {
+		for _, acc := range page.Accounts {
+			free := p.Decimal("available_balance", acc.AvailableBalance.Value)
+			locked := p.Decimal("hold", acc.Hold.Value)
+			total := free.Add(locked)
+			if total.IsZero() {
+				continue
+			}
+			out = append(out, exchange.Balance{Asset: acc.Currency, Total: total, Free: free, Locked: locked})
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error fetching accounts: %w", err)
+	}
+	return out, p.Err
+}
+
+func (c *Connector) Positions(symbol string) ([]exchange.Position, error) {
+	return nil, fmt.Errorf("%w: positions on spot", exchange.ErrNotSupported)
+}
+
+// SubscribeTicker subscribes symbol on the ticker channel. Coinbase sends the full ticker on
+// every update.
+func (c *Connector) SubscribeTicker(symbol string, handler func(exchange.Ticker)) error {
+	c.mu.Lock()
+	c.tickerHandlers[symbol] = handler
+	c.mu.Unlock()
+	return c.stream.Subscribe("ticker", []string{symbol}, c.dispatchTicker)
+}
+
+func (c *Connector) dispatchTicker(msg Message) {
+	var events []tickerEvent
+	if err := json.Unmarshal(msg.Events, &events); err != nil {
+		c.stream.reportError(fmt.Errorf("failed to decode ticker: %w", err))
+		return
+	}
+	var p exchange.FieldParser
+	at := p.RFC3339("timestamp", msg.Timestamp)
+	for _, event := range events {
+		for _, raw := range event.Tickers {
+			t := exchange.Ticker{
+				Symbol:    raw.ProductID,
+				Last:      p.Decimal("price", raw.Price),
+				Bid:       p.Decimal("best_bid", raw.BestBid),
+				Ask:       p.Decimal("best_ask", raw.BestAsk),
+				Volume24h: p.Decimal("volume_24_h", raw.Volume24h),
+				Time:      at,
+			}
+			if p.Err != nil {
+				c.stream.reportError(fmt.Errorf("failed to decode ticker: %w", p.Err))
+				return
+			}
+			c.mu.Lock()
+			handler := c.tickerHandlers[raw.ProductID]
+			c.mu.Unlock()
+			if handler != nil {
+				handler(t)
+			}
+		}
+	}
+}
+
+// SubscribeOrders subscribes to the user channel, which requires an API key. The first update is
+// a snapshot of the open orders. It replaces the handler of a previous call.
+func (c *Connector) SubscribeOrders(handler func(exchange.Order)) error {
+	return c.stream.SubscribeUser(nil, func(msg Message) {
+		orders, err := convertUserMessage(msg)
+		if err != nil {
+			c.stream.reportError(fmt.Errorf("failed to decode order update: %w", err))
+			return
+		}
+		for _, o := range orders {
+			handler(o)
+		}
+	})
+}
+
+func convertUserMessage(msg Message) ([]exchange.Order, error) {
+	var events []userEvent
+	if err := json.Unmarshal(msg.Events, &events); err != nil {
+		return nil, err
+	}
+	var p exchange.FieldParser
+	at := p.RFC3339("timestamp", msg.Timestamp)
+	var out []exchange.Order
+	for _, event := range events {
+		for _, raw := range event.Orders {
+			filled := p.Decimal("cumulative_quantity", raw.CumulativeQuantity)
+			out = append(out, exchange.Order{
+				ID:            raw.OrderID,
+				ClientOrderID: raw.ClientOrderID,
+				Symbol:        raw.ProductID,
+				Side:          convertSide(raw.OrderSide),
+				Type:          convertType(raw.OrderType),
+				Status:        convertStatus(raw.Status, filled),
+				Price:         p.Decimal("limit_price", raw.LimitPrice),
+				Qty:           filled.Add(p.Decimal("leaves_quantity", raw.LeavesQuantity)),
+				FilledQty:     filled,
+				AvgPrice:      p.Decimal("avg_price", raw.AvgPrice),
+				CreatedAt:     p.RFC3339("creation_time", raw.CreationTime),
+				UpdatedAt:     at,
+			})
+		}
+	}
+	if p.Err != nil {
+		return nil, p.Err
+	}
+	return out, nil
+}
+
+func (c *Connector) Connect() error {
+	return c.stream.Connect()
+}
+
+func (c *Connector) Close() error {
+	return c.stream.Close()
+}
@@ -0,0 +1,239 @@
+package coinbase
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cploutarchou/crypto-sdk-suite/coinbase/client"
+	"github.com/cploutarchou/crypto-sdk-suite/decimal"
+	"github.com/cploutarchou/crypto-sdk-suite/exchange"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestConnector serves the REST endpoints from mux and the market and user feeds from
+// /market and /user.
+func newTestConnector(t *testing.T, mux *http.ServeMux) *Connector {
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	c, err := client.NewWithBaseURL("key-id", base64.StdEncoding.EncodeToString(private), server.URL)
+	require.NoError(t, err)
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http")
+	conn := newConnector(c, wsURL+"/market", wsURL+"/user")
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func TestConnectorTicker(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc(client.APIPrefix+"/market/products/BTC-USD", func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"))
+		fmt.Fprint(w, `{"product_id":"BTC-USD","price":"30000.10","volume_24h":"1200.5"}`)
+	})
+	mux.HandleFunc(client.APIPrefix+"/market/product_book", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "BTC-USD", r.URL.Query().Get("product_id"))
+		assert.Equal(t, "1", r.URL.Query().Get("limit"))
+		fmt.Fprint(w, `{"pricebook":{"product_id":"BTC-USD","bids":[{"price":"30000.00","size":"3"}],
+			"asks":[{"price":"30000.20","size":"1"}],"time":"2023-11-14T22:13:20.5Z"}}`)
+	})
+	c := newTestConnector(t, mux)
+
+	ticker, err := c.Ticker("BTC-USD")
+	require.NoError(t, err)
+	assert.Equal(t, "30000.1", ticker.Last.String())
+	assert.Equal(t, "30000", ticker.Bid.String())
+	assert.Equal(t, "30000.2", ticker.Ask.String())
+	assert.Equal(t, "1200.5", ticker.Volume24h.String())
+	assert.True(t, time.UnixMilli(1700000000500).Equal(ticker.Time))
+}
+
+func TestConnectorCandlesPaging(t *testing.T) {
+	var ends []string
+	mux := http.NewServeMux()
+	mux.HandleFunc(client.APIPrefix+"/market/products/BTC-USD/candles", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "ONE_MINUTE", r.URL.Query().Get("granularity"))
+		ends = append(ends, r.URL.Query().Get("end"))
+		newest := int64(4)
+		if len(ends) > 1 {
+			newest = 2
+		}
+		fmt.Fprintf(w, `{"candles":[
+			{"start":"%d","low":"1","high":"2","open":"1.5","close":"1.8","volume":"12"},
+			{"start":"%d","low":"0.5","high":"2","open":"1","close":"1.5","volume":"10"}]}`, newest*60, (newest-1)*60)
+	})
+	c := newTestConnector(t, mux)
+
+	candles, err := c.Candles("BTC-USD", time.Minute, 3)
+	require.NoError(t, err)
+	require.Len(t, candles, 3)
+	require.Len(t, ends, 2)
+	assert.Equal(t, "179", ends[1])
+	for i, candle := range candles {
+		assert.Equal(t, time.Unix(int64(i+2)*60, 0), candle.Start)
+	}
+	assert.Equal(t, "1.8", candles[2].Close.String())
+
+	_, err = c.Candles("BTC-USD", 4*time.Hour, 2)
+	assert.True(t, errors.Is(err, exchange.ErrNotSupported))
+}
+
+func TestConnectorPlaceOrder(t *testing.T) {
+	var bodies []createOrderRequest
+	mux := http.NewServeMux()
+	mux.HandleFunc(client.APIPrefix+"/orders", func(w http.ResponseWriter, r *http.Request) {
+		assert.True(t, strings.HasPrefix(r.Header.Get("Authorization"), "Bearer "))
+		var body createOrderRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		bodies = append(bodies, body)
+		if body.Side == "SELL" {
+			fmt.Fprint(w, `{"success":false,"error_response":{"error":"INSUFFICIENT_FUND","message":"Insufficient balance in source account"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"success":true,"success_response":{"order_id":"abc","client_order_id":"my-id"}}`)
+	})
+	c := newTestConnector(t, mux)
+
+	order, err := c.PlaceOrder(exchange.OrderRequest{
+		Symbol: "BTC-USD", Side: exchange.Buy, Type: exchange.Limit,
+		Qty: decimal.RequireFromString("0.01"), Price: decimal.RequireFromString("30000"), TimeInForce: exchange.PostOnly, ClientOrderID: "my-id",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "abc", order.ID)
+	assert.Equal(t, exchange.StatusNew, order.Status)
+	assert.Equal(t, map[string]orderConfig{"limit_limit_gtc": {BaseSize: "0.01", LimitPrice: "30000", PostOnly: true}}, bodies[0].OrderConfiguration)
+
+	_, err = c.PlaceOrder(exchange.OrderRequest{Symbol: "BTC-USD", Side: exchange.Sell, Type: exchange.Market, Qty: decimal.RequireFromString("1")})
+	var apiErr *client.APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, "INSUFFICIENT_FUND", apiErr.Code)
+	assert.NotEmpty(t, bodies[1].ClientOrderID)
+	assert.Equal(t, map[string]orderConfig{"market_market_ioc": {BaseSize: "1"}}, bodies[1].OrderConfiguration)
+}
+
+func TestConnectorOrders(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc(client.APIPrefix+"/orders/batch_cancel", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"results":[{"success":false,"failure_reason":"UNKNOWN_CANCEL_ORDER","order_id":"gone"}]}`)
+	})
+	mux.HandleFunc(client.APIPrefix+"/orders/historical/gone", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"error":"NOT_FOUND","message":"order with this orderID was not found"}`)
+	})
+	mux.HandleFunc(client.APIPrefix+"/orders/historical/batch", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "OPEN", r.URL.Query().Get("order_status"))
+		assert.Equal(t, "BTC-USD", r.URL.Query().Get("product_ids"))
+		if r.URL.Query().Get("cursor") == "" {
+			fmt.Fprint(w, `{"orders":[{"order_id":"a","product_id":"BTC-USD","side":"BUY","status":"OPEN","order_type":"LIMIT",
+				"order_configuration":{"limit_limit_gtc":{"base_size":"2","limit_price":"100.5"}},"filled_size":"0.5",
+				"average_filled_price":"100.5","created_time":"2023-11-14T22:13:20Z"}],"has_next":true,"cursor":"next"}`)
+			return
+		}
+		fmt.Fprint(w, `{"orders":[{"order_id":"b","product_id":"BTC-USD","side":"SELL","status":"OPEN","order_type":"LIMIT",
+			"order_configuration":{"limit_limit_gtc":{"base_size":"1","limit_price":"200"}},"filled_size":"0",
+			"average_filled_price":"0","created_time":"2023-11-14T22:13:21Z"}],"has_next":false,"cursor":""}`)
+	})
+	c := newTestConnector(t, mux)
+
+	err := c.CancelOrder("BTC-USD", "gone")
+	assert.True(t, errors.Is(err, exchange.ErrOrderNotFound))
+	_, err = c.GetOrder("BTC-USD", "gone")
+	assert.True(t, errors.Is(err, exchange.ErrOrderNotFound))
+
+	orders, err := c.OpenOrders("BTC-USD")
+	require.NoError(t, err)
+	require.Len(t, orders, 2)
+	assert.Equal(t, exchange.StatusPartiallyFilled, orders[0].Status)
+	assert.Equal(t, "2", orders[0].Qty.String())
+	assert.Equal(t, "100.5", orders[0].Price.String())
+	assert.Equal(t, orders[0].CreatedAt, orders[0].UpdatedAt)
+	assert.Equal(t, exchange.StatusNew, orders[1].Status)
+	assert.Equal(t, exchange.Sell, orders[1].Side)
+}
+
+func TestConnectorBalances(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc(client.APIPrefix+"/accounts", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"accounts":[
+			{"currency":"BTC","available_balance":{"value":"1.5","currency":"BTC"},"hold":{"value":"0.5","currency":"BTC"}},
+			{"currency":"ETH","available_balance":{"value":"0","currency":"ETH"},"hold":{"value":"0","currency":"ETH"}}],
+			"has_next":false}`)
+	})
+	c := newTestConnector(t, mux)
+
+	balances, err := c.Balances()
+	require.NoError(t, err)
+	require.Len(t, balances, 1)
+	assert.Equal(t, "BTC", balances[0].Asset)
+	assert.Equal(t, "2", balances[0].Total.String())
+	assert.Equal(t, "0.5", balances[0].Locked.String())
+
+	_, err = c.Positions("")
+	assert.True(t, errors.Is(err, exchange.ErrNotSupported))
+}
+
+func TestConnectorStream(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	// serve reads the heartbeats and the channel subscription, then writes msg.
+	serve := func(channel, msg string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			conn, err := upgrader.Upgrade(w, r, nil)
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+			var subscriptions []map[string]any
+			for i := 0; i < 2; i++ {
+				var sub map[string]any
+				if err := conn.ReadJSON(&sub); err != nil {
+					return
+				}
+				subscriptions = append(subscriptions, sub)
+			}
+			assert.Equal(t, "heartbeats", subscriptions[0]["channel"])
+			assert.Equal(t, channel, subscriptions[1]["channel"])
+			if channel == "user" {
+				assert.NotEmpty(t, subscriptions[1]["jwt"])
+			}
+			assert.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(msg)))
+			_, _, _ = conn.ReadMessage()
+		}
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/market", serve("ticker", `{"channel":"ticker","timestamp":"2023-11-14T22:13:20Z","sequence_num":1,
+		"events":[{"type":"update","tickers":[{"product_id":"BTC-USD","price":"30000.1","volume_24_h":"1200",
+		"best_bid":"30000.0","best_ask":"30000.2"}]}]}`))
+	mux.Handle("/user", serve("user", `{"channel":"user","timestamp":"2023-11-14T22:13:21Z","sequence_num":2,
+		"events":[{"type":"snapshot","orders":[{"order_id":"abc","client_order_id":"my-id","product_id":"BTC-USD",
+		"order_side":"BUY","order_type":"LIMIT","status":"FILLED","limit_price":"30000","cumulative_quantity":"0.01",
+		"leaves_quantity":"0","avg_price":"29999.5","creation_time":"2023-11-14T22:13:20Z"}]}]}`))
+	c := newTestConnector(t, mux)
+
+	tickers := make(chan exchange.Ticker, 1)
+	orders := make(chan exchange.Order, 1)
+	require.NoError(t, c.SubscribeTicker("BTC-USD", func(ticker exchange.Ticker) { tickers <- ticker }))
+	require.NoError(t, c.SubscribeOrders(func(order exchange.Order) { orders <- order }))
+	require.NoError(t, c.Connect())
+
+	ticker := <-tickers
+	assert.Equal(t, "30000.1", ticker.Last.String())
+	assert.Equal(t, "30000", ticker.Bid.String())
+	assert.True(t, time.Unix(1700000000, 0).Equal(ticker.Time))
+
+	order := <-orders
+	assert.Equal(t, "abc", order.ID)
+	assert.Equal(t, exchange.StatusFilled, order.Status)
+	assert.Equal(t, "0.01", order.Qty.String())
+	assert.Equal(t, "29999.5", order.AvgPrice.String())
+}
@@ -0,0 +1,159 @@
+package coinbase
+
+import "github.com/cploutarchou/crypto-sdk-suite/coinbase/client"
+
+type product struct {
+	ProductID       string `json:"product_id"`
+	Price           string `json:"price"`
+	Volume24h       string `json:"volume_24h"`
+	BaseIncrement   string `json:"base_increment"`
+	QuoteIncrement  string `json:"quote_increment"`
+	PriceIncrement  string `json:"price_increment"`
+	BaseMinSize     string `json:"base_min_size"`
+	QuoteMinSize    string `json:"quote_min_size"`
+	BaseCurrencyID  string `json:"base_currency_id"`
+	QuoteCurrencyID string `json:"quote_currency_id"`
+	TradingDisabled bool   `json:"trading_disabled"`
+	IsDisabled      bool   `json:"is_disabled"`
+}
+
+type productList struct {
+	Products []product `json:"products"`
+}
+
+type bookLevel struct {
+	Price string `json:"price"`
+	Size  string `json:"size"`
+}
+
+type productBook struct {
+	PriceBook struct {
+		ProductID string      `json:"product_id"`
+		Bids      []bookLevel `json:"bids"`
+		Asks      []bookLevel `json:"asks"`
+		Time      string      `json:"time"`
+	} `json:"pricebook"`
+}
+
+type candleList struct {
+	Candles []struct {
+		Start  string `json:"start"` // unix seconds
+		Low    string `json:"low"`
+		High   string `json:"high"`
+		Open   string `json:"open"`
+		Close  string `json:"close"`
+		Volume string `json:"volume"`
+	} `json:"candles"`
+}
+
+// orderConfig is the content of any order configuration, e.g. limit_limit_gtc or
+// market_market_ioc.
+type orderConfig struct {
+	BaseSize   string `json:"base_size,omitempty"`
+	QuoteSize  string `json:"quote_size,omitempty"`
+	LimitPrice string `json:"limit_price,omitempty"`
+	PostOnly   bool   `json:"post_only,omitempty"`
+}
+
+type createOrderRequest struct {
+	ClientOrderID      string                 `json:"client_order_id"`
+	ProductID          string                 `json:"product_id"`
+	Side               string                 `json:"side"`
+	OrderConfiguration map[string]orderConfig `json:"order_configuration"`
+}
+
+// createOrderResponse reports rejected orders with success false and HTTP status 200.
+type createOrderResponse struct {
+	Success         bool `json:"success"`
+	SuccessResponse struct {
+		OrderID       string `json:"order_id"`
+		ClientOrderID string `json:"client_order_id"`
+	} `json:"success_response"`
+	ErrorResponse struct {
+		Error                 string `json:"error"`
+		Message               string `json:"message"`
+		ErrorDetails          string `json:"error_details"`
+		NewOrderFailureReason string `json:"new_order_failure_reason"`
+	} `json:"error_response"`
+}
+
+type cancelOrdersRequest struct {
+	OrderIDs []string `json:"order_ids"`
+}
+
+type cancelOrdersResponse struct {
+	Results []struct {
+		Success       bool   `json:"success"`
+		FailureReason string `json:"failure_reason"`
+		OrderID       string `json:"order_id"`
+	} `json:"results"`
+}
+
+type order struct {
+	OrderID            string                 `json:"order_id"`
+	ProductID          string                 `json:"product_id"`
+	ClientOrderID      string                 `json:"client_order_id"`
+	Side               string                 `json:"side"`
+	Status             string                 `json:"status"`
+	OrderType          string                 `json:"order_type"`
+	OrderConfiguration map[string]orderConfig `json:"order_configuration"`
+	FilledSize         string                 `json:"filled_size"`
+	AverageFilledPrice string                 `json:"average_filled_price"`
+	CreatedTime        string                 `json:"created_time"`
+	LastFillTime       string                 `json:"last_fill_time"`
+}
+
+type orderResponse struct {
+	Order order `json:"order"`
+}
+
+type orderList struct {
+	client.Pagination
+	Orders []order `json:"orders"`
+}
+
+type amount struct {
+	Value    string `json:"value"`
+	Currency string `json:"currency"`
+}
+
+type account struct {
+	Currency         string `json:"currency"`
+	AvailableBalance amount `json:"available_balance"`
+	Hold             amount `json:"hold"`
+}
+
+type accountList struct {
+	client.Pagination
+	Accounts []account `json:"accounts"`
+}
+
+// tickerEvent is an event of the ticker channel.
+type tickerEvent struct {
+	Type    string `json:"type"`
+	Tickers []struct {
+		ProductID string `json:"product_id"`
+		Price     string `json:"price"`
+		Volume24h string `json:"volume_24_h"`
+		BestBid   string `json:"best_bid"`
+		BestAsk   string `json:"best_ask"`
+	} `json:"tickers"`
+}
+
+// userEvent is an event of the user channel.
+type userEvent struct {
+	Type   string `json:"type"`
+	Orders []struct {
+		OrderID            string `json:"order_id"`
+		ClientOrderID      string `json:"client_order_id"`
+		ProductID          string `json:"product_id"`
+		OrderSide          string `json:"order_side"`
+		OrderType          string `json:"order_type"`
+		Status             string `json:"status"`
+		LimitPrice         string `json:"limit_price"`
+		CumulativeQuantity string `json:"cumulative_quantity"`
+		LeavesQuantity     string `json:"leaves_quantity"`
+		AvgPrice           string `json:"avg_price"`
+		CreationTime       string `json:"creation_time"`
+	} `json:"orders"`
+}
@@ -0,0 +1,311 @@
+package coinbase
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/cploutarchou/crypto-sdk-suite/coinbase/client"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	MarketStreamURL = "wss://advanced-trade-ws.coinbase.com"
+	UserStreamURL   = "wss://advanced-trade-ws-user.coinbase.com"
+
+	reconnectDelay = 5 * time.Second
+)
+
+// Message is a message of the Advanced Trade websocket feed.
+type Message struct {
+	Channel     string          `json:"channel"`
+	Timestamp   string          `json:"timestamp"`
+	SequenceNum int64           `json:"sequence_num"`
+	Events      json.RawMessage `json:"events"`
+	// Type and Message are set on error messages.
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
+
+// messageChannels maps subscription channels to the channel their messages carry, where the two
+// differ.
+var messageChannels = map[string]string{
+	"level2": "l2_data",
+}
+
+// feed is one websocket connection and the channels subscribed on it.
+type feed struct {
+	url      string
+	auth     bool // subscriptions carry a JWT
+	conn     *websocket.Conn
+	running  bool
+	channels map[string][]string // product ids by subscription channel
+	handlers map[string]func(Message)
+}
+
+// Stream manages the market data and the user order connections of the Advanced Trade feed.
+// Channels are resubscribed after every reconnect, and every connection is subscribed to
+// heartbeats so Coinbase keeps it open while the subscribed products are quiet.
+type Stream struct {
+	client *client.Client
+	market *feed
+	user   *feed
+
+	mu        sync.Mutex
+	connected bool
+
+	// OnError is called with connection and decoding errors. Errors are logged when it is nil.
+	OnError func(err error)
+
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func newStream(c *client.Client, marketURL, userURL string) *Stream {
+	newFeed := func(url string, auth bool) *feed {
+		return &feed{url: url, auth: auth, channels: make(map[string][]string), handlers: make(map[string]func(Message))}
+	}
+	return &Stream{
+		client: c,
+		market: newFeed(marketURL, false),
+		user:   newFeed(userURL, true),
+		closed: make(chan struct{}),
+	}
+}
+
+// Connect opens the market data connection, and the user connection when its channel is
+// subscribed.
+func (s *Stream) Connect() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.connected {
+		return nil
+	}
+	if err := s.start(s.market); err != nil {
+		return fmt.Errorf("failed to connect market stream: %w", err)
+	}
+	if len(s.user.channels) > 0 {
+		if err := s.start(s.user); err != nil {
+			return fmt.Errorf("failed to connect user stream: %w", err)
+		}
+	}
+	s.connected = true
+	return nil
+}
+
+// start opens f and starts its read loop. Callers must hold s.mu.
+func (s *Stream) start(f *feed) error {
+	if err := s.open(f); err != nil {
+		return err
+	}
+	f.running = true
+	go s.run(f)
+	return nil
+}
+
+// open dials f and subscribes heartbeats and every channel of f. Callers must hold s.mu.
+func (s *Stream) open(f *feed) error {
+	conn, _, err := websocket.DefaultDialer.Dial(f.url, nil)
+	if err != nil {
+		return err
+	}
+	f.conn = conn
+	err = s.send(f, "subscribe", "heartbeats", nil)
+	for channel, products := range f.channels {
+		if err != nil {
+			break
+		}
+		err = s.send(f, "subscribe", channel, products)
+	}
+	if err != nil {
+		conn.Close()
+		f.conn = nil
+	}
+	return err
+}
+
+// run reads messages from f until the stream is closed, reconnecting on read errors.
+func (s *Stream) run(f *feed) {
+	for {
+		s.mu.Lock()
+		conn := f.conn
+		s.mu.Unlock()
+		if conn == nil {
+			select {
+			case <-s.closed:
+				return
+			case <-time.After(reconnectDelay):
+			}
+			s.reconnect(f)
+			continue
+		}
+
+		_, raw, err := conn.ReadMessage()
+		if err == nil {
+			s.dispatch(f, raw)
+			continue
+		}
+		conn.Close()
+		select {
+		case <-s.closed:
+			return
+		default:
+		}
+		s.mu.Lock()
+		f.conn = nil
+		s.mu.Unlock()
+		s.reportError(fmt.Errorf("read failed: %w", err))
+	}
+}
+
+func (s *Stream) reconnect(f *feed) {
+	s.mu.Lock()
+	select {
+	case <-s.closed:
+		s.mu.Unlock()
+		return
+	default:
+	}
+	err := s.open(f)
+	s.mu.Unlock()
+	if err != nil {
+		s.reportError(fmt.Errorf("reconnect failed: %w", err))
+	}
+}
+
+func (s *Stream) dispatch(f *feed, raw []byte) {
+	var msg Message
+	if err := json.Unmarshal(raw, &msg); err != nil {
+		s.reportError(fmt.Errorf("failed to unmarshal message: %w", err))
+		return
+	}
+	if msg.Type == "error" {
+		s.reportError(fmt.Errorf("stream error: %s", msg.Message))
+		return
+	}
+
+	s.mu.Lock()
+	handler, ok := f.handlers[msg.Channel]
+	s.mu.Unlock()
+	if ok {
+		handler(msg)
+	}
+}
+
+func (s *Stream) reportError(err error) {
+	if s.OnError != nil {
+		s.OnError(err)
+		return
+	}
+	log.Printf("Stream error: %v", err)
+}
+
+// Subscribe registers the handler of a market data channel, e.g. "ticker" or "level2", and
+// subscribes it to productIDs in addition to the products subscribed before.
+func (s *Stream) Subscribe(channel string, productIDs []string, handler func(Message)) error {
+	return s.subscribe(s.market, channel, productIDs, handler)
+}
+
+// SubscribeUser registers the handler of the user channel, which reports the account's orders.
+// It requires an API key. Orders of every product are reported when productIDs is empty.
+func (s *Stream) SubscribeUser(productIDs []string, handler func(Message)) error {
+	return s.subscribe(s.user, "user", productIDs, handler)
+}
+
+func (s *Stream) subscribe(f *feed, channel string, productIDs []string, handler func(Message)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if f.auth {
+		if _, err := s.client.JWT(""); err != nil {
+			return err
+		}
+	}
+	var added []string
+	for _, id := range productIDs {
+		if !slices.Contains(f.channels[channel], id) {
+			added = append(added, id)
+		}
+	}
+	_, subscribed := f.channels[channel]
+	f.channels[channel] = append(f.channels[channel], added...)
+	if name, ok := messageChannels[channel]; ok {
+		f.handlers[name] = handler
+	} else {
+		f.handlers[channel] = handler
+	}
+
+	switch {
+	case !s.connected:
+		return nil
+	case !f.running:
+		return s.start(f)
+	case f.conn == nil:
+		// The channel is subscribed when the connection is reopened.
+		return nil
+	case !subscribed || len(added) > 0:
+		return s.send(f, "subscribe", channel, added)
+	}
+	return nil
+}
+
+// Unsubscribe removes the handler of a market data channel and unsubscribes all of its products.
+func (s *Stream) Unsubscribe(channel string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	products, ok := s.market.channels[channel]
+	if !ok {
+		return fmt.Errorf("not subscribed to channel %s", channel)
+	}
+	delete(s.market.channels, channel)
+	if name, ok := messageChannels[channel]; ok {
+		delete(s.market.handlers, name)
+	} else {
+		delete(s.market.handlers, channel)
+	}
+	if s.market.conn == nil {
+		return nil
+	}
+	return s.send(s.market, "unsubscribe", channel, products)
+}
+
+// send writes a subscription change to f. Callers must hold s.mu.
+func (s *Stream) send(f *feed, op, channel string, productIDs []string) error {
+	msg := map[string]any{"type": op, "channel": channel}
+	if len(productIDs) > 0 {
+		msg["product_ids"] = productIDs
+	}
+	if f.auth {
+		token, err := s.client.JWT("")
+		if err != nil {
+			return err
+		}
+		msg["jwt"] = token
+	}
+	if f.conn == nil {
+		return errors.New("connection was not established")
+	}
+	if err := f.conn.WriteJSON(msg); err != nil {
+		return fmt.Errorf("failed to %s to %s: %w", op, channel, err)
+	}
+	return nil
+}
+
+// Close closes both connections and stops the read loops.
+func (s *Stream) Close() error {
+	s.closeOnce.Do(func() {
+		close(s.closed)
+	})
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, f := range []*feed{s.market, s.user} {
+		if f.conn != nil {
+			f.conn.Close()
+			f.conn = nil
+		}
+	}
+	return nil
+}
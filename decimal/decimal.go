@@ -0,0 +1,309 @@
+// Package decimal provides an arbitrary-precision fixed-point decimal used for prices and
+// quantities, so values received from and sent to an exchange never pass through float64.
+package decimal
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Decimal represents value * 10^exp. The zero value is 0 and is ready to use.
+type Decimal struct {
+	value *big.Int
+	exp   int32
+}
+
+// Zero is the decimal 0.
+var Zero = Decimal{}
+
+var errInvalid = errors.New("invalid decimal")
+
+// New returns value * 10^exp.
+func New(value int64, exp int32) Decimal {
+	return Decimal{value: big.NewInt(value), exp: exp}
+}
+
+// NewFromInt converts an int64 to a Decimal.
+func NewFromInt(value int64) Decimal {
+	return New(value, 0)
+}
+
+// NewFromFloat converts a float64 using the shortest representation that round-trips.
+func NewFromFloat(value float64) Decimal {
+	d, err := NewFromString(strconv.FormatFloat(value, 'f', -1, 64))
+	if err != nil {
+		return Zero
+	}
+	return d
+}
+
+// NewFromString parses a decimal such as "-12.3400" or "1.5e-3".
+func NewFromString(s string) (Decimal, error) {
+	orig := s
+	var exp int64
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		e, err := strconv.ParseInt(s[i+1:], 10, 32)
+		if err != nil {
+			return Zero, fmt.Errorf("%w: %q", errInvalid, orig)
+		}
+		exp = e
+		s = s[:i]
+	}
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		exp -= int64(len(s) - i - 1)
+		s = s[:i] + s[i+1:]
+	}
+	if s == "" || s == "-" || s == "+" {
+		return Zero, fmt.Errorf("%w: %q", errInvalid, orig)
+	}
+	value, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return Zero, fmt.Errorf("%w: %q", errInvalid, orig)
+	}
+	if exp < -1<<31 || exp > 1<<31-1 {
+		return Zero, fmt.Errorf("%w: exponent out of range in %q", errInvalid, orig)
+	}
+	return Decimal{value: value, exp: int32(exp)}, nil
+}
+
+// RequireFromString parses s and panics if it is not a valid decimal. Intended for constants.
+func RequireFromString(s string) Decimal {
+	d, err := NewFromString(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func (d Decimal) int() *big.Int {
+	if d.value == nil {
+		return new(big.Int)
+	}
+	return d.value
+}
+
+// rescale returns the unscaled value of d expressed with the (smaller or equal) exponent exp.
+func (d Decimal) rescale(exp int32) *big.Int {
+	v := new(big.Int).Set(d.int())
+	if exp < d.exp {
+		v.Mul(v, pow10(d.exp-exp))
+	}
+	return v
+}
+
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// align returns the unscaled values of d and o with a common exponent.
+func align(d, o Decimal) (a, b *big.Int, exp int32) {
+	exp = min(d.exp, o.exp)
+	return d.rescale(exp), o.rescale(exp), exp
+}
+
+// Add returns d + o.
+func (d Decimal) Add(o Decimal) Decimal {
+	a, b, exp := align(d, o)
+	return Decimal{value: a.Add(a, b), exp: exp}
+}
+
+// Sub returns d - o.
+func (d Decimal) Sub(o Decimal) Decimal {
+	a, b, exp := align(d, o)
+	return Decimal{value: a.Sub(a, b), exp: exp}
+}
+
+// Mul returns d * o.
+func (d Decimal) Mul(o Decimal) Decimal {
+	return Decimal{value: new(big.Int).Mul(d.int(), o.int()), exp: d.exp + o.exp}
+}
+
+// DivRound returns d / o rounded half away from zero to the given number of decimal places.
+func (d Decimal) DivRound(o Decimal, places int32) (Decimal, error) {
+	if o.IsZero() {
+		return Zero, errors.New("division by zero")
+	}
+	// d / o * 10^places = d.value * 10^(d.exp-o.exp+places) / o.value
+	num, den := new(big.Int).Set(d.int()), new(big.Int).Set(o.int())
+	if shift := d.exp - o.exp + places; shift >= 0 {
+		num.Mul(num, pow10(shift))
+	} else {
+		den.Mul(den, pow10(-shift))
+	}
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	half := new(big.Int).Mul(new(big.Int).Abs(r), big.NewInt(2))
+	if half.Cmp(new(big.Int).Abs(den)) >= 0 {
+		if num.Sign()*den.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return Decimal{value: q, exp: -places}, nil
+}
+
+// Neg returns -d.
+func (d Decimal) Neg() Decimal {
+	return Decimal{value: new(big.Int).Neg(d.int()), exp: d.exp}
+}
+
+// Abs returns |d|.
+func (d Decimal) Abs() Decimal {
+	return Decimal{value: new(big.Int).Abs(d.int()), exp: d.exp}
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of d.
+func (d Decimal) Sign() int {
+	return d.int().Sign()
+}
+
+// IsZero reports whether d is 0.
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// Cmp compares d and o and returns -1, 0 or +1.
+func (d Decimal) Cmp(o Decimal) int {
+	a, b, _ := align(d, o)
+	return a.Cmp(b)
+}
+
+// Equal reports whether d == o, regardless of trailing zeros.
+func (d Decimal) Equal(o Decimal) bool {
+	return d.Cmp(o) == 0
+}
+
+// LessThan reports whether d < o.
+func (d Decimal) LessThan(o Decimal) bool {
+	return d.Cmp(o) < 0
+}
+
+// GreaterThan reports whether d > o.
+func (d Decimal) GreaterThan(o Decimal) bool {
+	return d.Cmp(o) > 0
+}
+
+// Float64 returns the nearest float64 to d.
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+// String formats d without an exponent and without insignificant trailing zeros.
+func (d Decimal) String() string {
+	s := d.format()
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	return s
+}
+
+// StringFixed formats d rounded half away from zero to the given number of decimal places.
+func (d Decimal) StringFixed(places int32) string {
+	return d.Round(places).format()
+}
+
+// format renders the unscaled value with exactly -exp decimal places (none when exp >= 0).
+func (d Decimal) format() string {
+	v := d.int()
+	if d.exp >= 0 {
+		return new(big.Int).Mul(v, pow10(d.exp)).String()
+	}
+	digits := new(big.Int).Abs(v).String()
+	places := int(-d.exp)
+	if len(digits) <= places {
+		digits = strings.Repeat("0", places-len(digits)+1) + digits
+	}
+	s := digits[:len(digits)-places] + "." + digits[len(digits)-places:]
+	if v.Sign() < 0 {
+		s = "-" + s
+	}
+	return s
+}
+
+// Round rounds d half away from zero to the given number of decimal places.
+func (d Decimal) Round(places int32) Decimal {
+	exp := -places
+	if d.exp >= exp {
+		return Decimal{value: d.rescale(exp), exp: exp}
+	}
+	q, r := new(big.Int).QuoRem(d.int(), pow10(exp-d.exp), new(big.Int))
+	half := new(big.Int).Mul(new(big.Int).Abs(r), big.NewInt(2))
+	if half.Cmp(pow10(exp-d.exp)) >= 0 {
+		if d.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return Decimal{value: q, exp: exp}
+}
+
+// FloorToStep rounds d down to a multiple of step. Step must be positive.
+func (d Decimal) FloorToStep(step Decimal) (Decimal, error) {
+	return d.toStep(step, func(q *big.Int, r *big.Int, _ *big.Int) {
+		if r.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		}
+	})
+}
+
+// CeilToStep rounds d up to a multiple of step. Step must be positive.
+func (d Decimal) CeilToStep(step Decimal) (Decimal, error) {
+	return d.toStep(step, func(q *big.Int, r *big.Int, _ *big.Int) {
+		if r.Sign() > 0 {
+			q.Add(q, big.NewInt(1))
+		}
+	})
+}
+
+// RoundToStep rounds d to the nearest multiple of step, half away from zero. Step must be positive.
+func (d Decimal) RoundToStep(step Decimal) (Decimal, error) {
+	return d.toStep(step, func(q *big.Int, r *big.Int, b *big.Int) {
+		half := new(big.Int).Mul(new(big.Int).Abs(r), big.NewInt(2))
+		if half.Cmp(b) >= 0 {
+			q.Add(q, big.NewInt(int64(r.Sign())))
+		}
+	})
+}
+
+// toStep divides d by step with truncation, lets adjust correct the quotient using the
+// remainder, and multiplies back.
+func (d Decimal) toStep(step Decimal, adjust func(q, r, b *big.Int)) (Decimal, error) {
+	if step.Sign() <= 0 {
+		return Zero, fmt.Errorf("step must be positive, got %s", step)
+	}
+	a, b, exp := align(d, step)
+	q, r := new(big.Int).QuoRem(a, b, new(big.Int))
+	adjust(q, r, b)
+	return Decimal{value: q.Mul(q, b), exp: exp}, nil
+}
+
+// MarshalJSON encodes d as a JSON string, matching how Bybit transmits numbers.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(d.String())), nil
+}
+
+// UnmarshalJSON accepts both quoted and bare JSON numbers. An empty string decodes to 0.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		return nil
+	}
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		s = unquoted
+	}
+	if s == "" {
+		*d = Zero
+		return nil
+	}
+	parsed, err := NewFromString(s)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
@@ -0,0 +1,109 @@
+package decimal
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNewFromString verifies parsing and canonical formatting.
+func TestNewFromString(t *testing.T) {
+	cases := map[string]string{
+		"0":          "0",
+		"12.3400":    "12.34",
+		"-0.001":     "-0.001",
+		"1.5e-3":     "0.0015",
+		"2E2":        "200",
+		"65000.10":   "65000.1",
+		"0.00000001": "0.00000001",
+	}
+	for in, want := range cases {
+		d, err := NewFromString(in)
+		assert.NoError(t, err, in)
+		assert.Equal(t, want, d.String(), in)
+	}
+
+	for _, in := range []string{"", "-", "1.2.3", "abc", "1e"} {
+		_, err := NewFromString(in)
+		assert.Error(t, err, in)
+	}
+}
+
+// TestArithmetic verifies exact arithmetic where float64 would drift.
+func TestArithmetic(t *testing.T) {
+	a := RequireFromString("0.1")
+	b := RequireFromString("0.2")
+	assert.Equal(t, "0.3", a.Add(b).String())
+	assert.Equal(t, "-0.1", a.Sub(b).String())
+	assert.Equal(t, "0.02", a.Mul(b).String())
+	assert.True(t, a.Add(b).Equal(RequireFromString("0.30")))
+	assert.True(t, a.LessThan(b))
+	assert.Equal(t, "1.24", RequireFromString("1.235").StringFixed(2))
+	assert.Equal(t, "-1.24", RequireFromString("-1.235").StringFixed(2))
+	assert.Equal(t, "5.00", NewFromInt(5).StringFixed(2))
+	assert.Equal(t, "0", Decimal{}.String())
+	assert.True(t, Decimal{}.IsZero())
+}
+
+// TestDivRound verifies division rounding and the zero divisor error.
+func TestDivRound(t *testing.T) {
+	d, err := RequireFromString("30150.5").DivRound(RequireFromString("0.5"), 2)
+	require.NoError(t, err)
+	assert.Equal(t, "60301.00", d.StringFixed(2))
+	d, _ = NewFromInt(2).DivRound(NewFromInt(3), 4)
+	assert.Equal(t, "0.6667", d.String())
+	d, _ = NewFromInt(-2).DivRound(NewFromInt(3), 0)
+	assert.Equal(t, "-1", d.String())
+	d, _ = RequireFromString("1000").DivRound(RequireFromString("3.3"), 1)
+	assert.Equal(t, "303", d.String())
+	_, err = NewFromInt(1).DivRound(Zero, 2)
+	assert.Error(t, err)
+}
+
+// TestToStep verifies rounding to tick and lot size multiples.
+func TestToStep(t *testing.T) {
+	tick := RequireFromString("0.05")
+	price := RequireFromString("100.07")
+
+	floor, err := price.FloorToStep(tick)
+	assert.NoError(t, err)
+	assert.Equal(t, "100.05", floor.String())
+
+	ceil, err := price.CeilToStep(tick)
+	assert.NoError(t, err)
+	assert.Equal(t, "100.1", ceil.String())
+
+	nearest, err := price.RoundToStep(tick)
+	assert.NoError(t, err)
+	assert.Equal(t, "100.05", nearest.String())
+
+	nearest, err = RequireFromString("-100.08").RoundToStep(tick)
+	assert.NoError(t, err)
+	assert.Equal(t, "-100.1", nearest.String())
+
+	floor, err = RequireFromString("-0.0015").FloorToStep(RequireFromString("0.001"))
+	assert.NoError(t, err)
+	assert.Equal(t, "-0.002", floor.String())
+
+	_, err = price.FloorToStep(Zero)
+	assert.Error(t, err)
+}
+
+// TestJSON verifies quoted and bare numbers decode and that values encode as strings.
+func TestJSON(t *testing.T) {
+	var v struct {
+		Price Decimal `json:"price"`
+		Qty   Decimal `json:"qty"`
+		Empty Decimal `json:"empty"`
+	}
+	assert.NoError(t, json.Unmarshal([]byte(`{"price":"65000.5","qty":0.25,"empty":""}`), &v))
+	assert.Equal(t, "65000.5", v.Price.String())
+	assert.Equal(t, "0.25", v.Qty.String())
+	assert.True(t, v.Empty.IsZero())
+
+	out, err := json.Marshal(v)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"price":"65000.5","qty":"0.25","empty":"0"}`, string(out))
+}
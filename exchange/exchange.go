@@ -0,0 +1,73 @@
+// Package exchange defines exchange-neutral interfaces and types implemented by every connector
+// in the suite, so application code can be written once and run against any supported venue.
+//
+// Symbols are passed in the venue's own format, e.g. BTCUSDT on Bybit and BTC-USD on Coinbase;
+// Instruments reports the base and quote asset of each one. Prices and quantities are decimals
+// so no value is ever rounded through float64.
+package exchange
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrNotSupported is returned by connectors for operations the venue or market does not offer,
+// e.g. positions on a spot market.
+var ErrNotSupported = errors.New("not supported by this exchange")
+
+// ErrOrderNotFound is returned when an order does not exist or is no longer open.
+var ErrOrderNotFound = errors.New("order not found")
+
+// MarketData provides public market information.
+type MarketData interface {
+	// Ticker returns the latest prices of symbol.
+	Ticker(symbol string) (*Ticker, error)
+	// OrderBook returns up to depth levels per side, best price first.
+	OrderBook(symbol string, depth int) (*OrderBook, error)
+	// Candles returns the latest limit candles of the given interval, oldest first.
+	Candles(symbol string, interval time.Duration, limit int) ([]Candle, error)
+	// Instruments returns the tradable instruments of the market.
+	Instruments() ([]Instrument, error)
+}
+
+// Trading places and manages orders.
+type Trading interface {
+	// PlaceOrder submits an order and returns it as acknowledged by the venue.
+	PlaceOrder(req OrderRequest) (*Order, error)
+	// CancelOrder cancels an open order.
+	CancelOrder(symbol, orderID string) error
+	// GetOrder returns an open or recently closed order.
+	GetOrder(symbol, orderID string) (*Order, error)
+	// OpenOrders returns the open orders of symbol, or of every symbol when it is empty.
+	OpenOrders(symbol string) ([]Order, error)
+}
+
+// AccountData provides balances and positions.
+type AccountData interface {
+	// Balances returns the balance of every asset held.
+	Balances() ([]Balance, error)
+	// Positions returns the open positions of symbol, or of every symbol when it is empty.
+	Positions(symbol string) ([]Position, error)
+}
+
+// Streaming delivers real-time updates. Handlers may be registered before or after Connect.
+type Streaming interface {
+	// SubscribeTicker calls handler with every ticker update of symbol.
+	SubscribeTicker(symbol string, handler func(Ticker)) error
+	// SubscribeOrders calls handler with every update of the account's orders.
+	SubscribeOrders(handler func(Order)) error
+	// Connect opens the streaming connections.
+	Connect() error
+	// Close closes the streaming connections.
+	Close() error
+}
+
+// Exchange is a connector to one market of a venue, e.g. Bybit linear perpetuals or Binance spot.
+type Exchange interface {
+	// Name returns the venue name, e.g. "bybit".
+	Name() string
+	MarketData
+	Trading
+	AccountData
+	Streaming
+}
@@ -0,0 +1,224 @@
+package exchange
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cploutarchou/crypto-sdk-suite/decimal"
+)
+
+// Side is the side of an order or position.
+type Side string
+
+const (
+	Buy  Side = "Buy"
+	Sell Side = "Sell"
+)
+
+// OrderType is the execution type of an order.
+type OrderType string
+
+const (
+	Market OrderType = "Market"
+	Limit  OrderType = "Limit"
+)
+
+// TimeInForce controls how long a limit order rests. Connectors default to GTC.
+type TimeInForce string
+
+const (
+	GTC      TimeInForce = "GTC"
+	IOC      TimeInForce = "IOC"
+	FOK      TimeInForce = "FOK"
+	PostOnly TimeInForce = "PostOnly"
+)
+
+// OrderStatus is the normalised lifecycle state of an order.
+type OrderStatus string
+
+const (
+	StatusNew             OrderStatus = "New"
+	StatusPartiallyFilled OrderStatus = "PartiallyFilled"
+	StatusFilled          OrderStatus = "Filled"
+	StatusCancelled       OrderStatus = "Cancelled"
+	StatusRejected        OrderStatus = "Rejected"
+)
+
+// IsFinal reports whether the order can no longer change.
+func (s OrderStatus) IsFinal() bool {
+	return s == StatusFilled || s == StatusCancelled || s == StatusRejected
+}
+
+// Ticker is a snapshot of the latest prices of a symbol.
+type Ticker struct {
+	Symbol    string
+	Last      decimal.Decimal
+	Bid       decimal.Decimal
+	Ask       decimal.Decimal
+	Volume24h decimal.Decimal // in the base asset
+	Time      time.Time
+}
+
+// PriceLevel is one order book level.
+type PriceLevel struct {
+	Price decimal.Decimal
+	Qty   decimal.Decimal
+}
+
+// OrderBook is an order book snapshot. Bids are sorted by price descending and asks ascending.
+type OrderBook struct {
+	Symbol string
+	Bids   []PriceLevel
+	Asks   []PriceLevel
+	Time   time.Time
+}
+
+// Candle is one OHLCV bar.
+type Candle struct {
+	Start  time.Time
+	Open   decimal.Decimal
+	High   decimal.Decimal
+	Low    decimal.Decimal
+	Close  decimal.Decimal
+	Volume decimal.Decimal
+}
+
+// Instrument describes the trading rules of a symbol. Zero values mean the venue sets no limit.
+type Instrument struct {
+	Symbol      string
+	Base        string
+	Quote       string
+	TickSize    decimal.Decimal
+	QtyStep     decimal.Decimal
+	MinQty      decimal.Decimal
+	MinNotional decimal.Decimal
+}
+
+// OrderRequest is a new order.
+type OrderRequest struct {
+	Symbol      string
+	Side        Side
+	Type        OrderType
+	Qty         decimal.Decimal
+	Price       decimal.Decimal // limit orders only
+	TimeInForce TimeInForce     // limit orders only, GTC when empty
+	// ClientOrderID is an optional caller-chosen id, sent as orderLinkId, newClientOrderId or
+	// client_order_id depending on the venue.
+	ClientOrderID string
+	ReduceOnly    bool // derivatives only
+}
+
+// Validate checks the fields every venue requires.
+func (r OrderRequest) Validate() error {
+	switch {
+	case r.Symbol == "":
+		return errors.New("symbol is required")
+	case r.Side != Buy && r.Side != Sell:
+		return fmt.Errorf("invalid side %q", r.Side)
+	case r.Qty.Sign() <= 0:
+		return errors.New("qty must be positive")
+	}
+	switch r.Type {
+	case Limit:
+		if r.Price.Sign() <= 0 {
+			return errors.New("limit orders require a positive price")
+		}
+	case Market:
+		if !r.Price.IsZero() {
+			return errors.New("market orders cannot have a price")
+		}
+		if r.TimeInForce != "" {
+			return errors.New("market orders cannot have a time in force")
+		}
+	default:
+		return fmt.Errorf("invalid order type %q", r.Type)
+	}
+	return nil
+}
+
+// Order is an order as reported by the venue.
+type Order struct {
+	ID            string
+	ClientOrderID string
+	Symbol        string
+	Side          Side
+	Type          OrderType
+	Status        OrderStatus
+	Price         decimal.Decimal
+	Qty           decimal.Decimal
+	FilledQty     decimal.Decimal
+	AvgPrice      decimal.Decimal
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// Balance is the holding of one asset.
+type Balance struct {
+	Asset  string
+	Total  decimal.Decimal
+	Free   decimal.Decimal
+	Locked decimal.Decimal
+}
+
+// Position is an open derivatives position. Size is always positive; Side gives the direction.
+type Position struct {
+	Symbol        string
+	Side          Side
+	Size          decimal.Decimal
+	EntryPrice    decimal.Decimal
+	MarkPrice     decimal.Decimal
+	UnrealizedPnL decimal.Decimal
+	Leverage      decimal.Decimal
+}
+
+// FieldParser parses the string fields of venue responses into decimals and times, keeping the
+// first error so a whole struct can be converted before checking it:
+//
+//	var p exchange.FieldParser
+//	t := exchange.Ticker{Last: p.Decimal("lastPrice", raw.LastPrice)}
+//	if p.Err != nil { ... }
+type FieldParser struct {
+	Err error
+}
+
+// Decimal parses s. Empty strings, which venues use for unset fields, parse as zero.
+func (p *FieldParser) Decimal(field, s string) decimal.Decimal {
+	if p.Err != nil || s == "" {
+		return decimal.Zero
+	}
+	d, err := decimal.NewFromString(s)
+	if err != nil {
+		p.Err = fmt.Errorf("error parsing %s: %w", field, err)
+		return decimal.Zero
+	}
+	return d
+}
+
+// UnixMilli parses a millisecond timestamp. Empty strings parse as the zero time.
+func (p *FieldParser) UnixMilli(field, s string) time.Time {
+	if p.Err != nil || s == "" {
+		return time.Time{}
+	}
+	ms, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		p.Err = fmt.Errorf("error parsing %s: %w", field, err)
+		return time.Time{}
+	}
+	return time.UnixMilli(ms)
+}
+
+// RFC3339 parses an RFC 3339 timestamp, with or without fractional seconds. Empty strings parse
+// as the zero time.
+func (p *FieldParser) RFC3339(field, s string) time.Time {
+	if p.Err != nil || s == "" {
+		return time.Time{}
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		p.Err = fmt.Errorf("error parsing %s: %w", field, err)
+		return time.Time{}
+	}
+	return t
+}
@@ -0,0 +1,42 @@
+package exchange
+
+import (
+	"testing"
+
+	"github.com/cploutarchou/crypto-sdk-suite/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderRequestValidate(t *testing.T) {
+	qty, price := decimal.RequireFromString("0.01"), decimal.RequireFromString("30000")
+	assert.NoError(t, OrderRequest{Symbol: "BTCUSDT", Side: Buy, Type: Limit, Qty: qty, Price: price}.Validate())
+	assert.NoError(t, OrderRequest{Symbol: "BTCUSDT", Side: Sell, Type: Market, Qty: qty}.Validate())
+
+	invalid := map[string]OrderRequest{
+		"missing symbol":      {Side: Buy, Type: Market, Qty: qty},
+		"unknown side":        {Symbol: "BTCUSDT", Side: "Long", Type: Market, Qty: qty},
+		"zero qty":            {Symbol: "BTCUSDT", Side: Buy, Type: Market},
+		"limit without price": {Symbol: "BTCUSDT", Side: Buy, Type: Limit, Qty: qty},
+		"market with price":   {Symbol: "BTCUSDT", Side: Buy, Type: Market, Qty: qty, Price: price},
+		"unknown type":        {Symbol: "BTCUSDT", Side: Buy, Type: "Stop", Qty: qty},
+	}
+	for name, req := range invalid {
+		assert.Error(t, req.Validate(), name)
+	}
+}
+
+func TestFieldParser(t *testing.T) {
+	var p FieldParser
+	assert.True(t, p.Decimal("empty", "").IsZero())
+	assert.Equal(t, "1.5", p.Decimal("price", "1.50").String())
+	assert.Equal(t, int64(1700000000000), p.UnixMilli("time", "1700000000000").UnixMilli())
+	assert.Equal(t, int64(1700000000123), p.RFC3339("created_time", "2023-11-14T22:13:20.123456Z").UnixMilli())
+	assert.True(t, p.RFC3339("last_fill_time", "").IsZero())
+	require.NoError(t, p.Err)
+
+	p.Decimal("qty", "abc")
+	require.Error(t, p.Err)
+	assert.Contains(t, p.Err.Error(), "qty")
+	assert.True(t, p.Decimal("price", "2").IsZero(), "parsing stops after the first error")
+}